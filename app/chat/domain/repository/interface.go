@@ -12,3 +12,7 @@ type MessageRepository interface {
 	GetMessagesByChatID(ctx context.Context, chatID int64, limit, offset int) ([]*model.Message, error)
 	ChatHandler(ctx context.Context, c *app.RequestContext)
 }
+
+type ChatRPC interface {
+	CheckPrivacy(ctx context.Context, viewerID, ownerID int64, action string) (bool, error)
+}
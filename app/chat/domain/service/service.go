@@ -2,19 +2,31 @@ package service
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/LingeringAutumn/Yijie/app/chat/domain/model"
 	"github.com/LingeringAutumn/Yijie/app/chat/domain/repository"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
 )
 
 type ChatService struct {
 	repo repository.MessageRepository
+	rpc  repository.ChatRPC
 }
 
-func NewChatService(repo repository.MessageRepository) *ChatService {
-	return &ChatService{repo: repo}
+func NewChatService(repo repository.MessageRepository, rpc repository.ChatRPC) *ChatService {
+	return &ChatService{repo: repo, rpc: rpc}
 }
 
+// SaveMessage 保存私信，接收者的隐私设置不允许发送者私信时拒绝
 func (s *ChatService) SaveMessage(ctx context.Context, msg *model.Message) error {
+	allowed, err := s.rpc.CheckPrivacy(ctx, msg.SenderID, msg.ReceiverID, constants.PrivacyActionMessage)
+	if err != nil {
+		return fmt.Errorf("check message privacy failed: %w", err)
+	}
+	if !allowed {
+		return errno.NewErrNo(errno.AuthNoOperatePermissionCode, "receiver does not accept messages from you")
+	}
 	return s.repo.SaveMessage(ctx, msg)
 }
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/LingeringAutumn/Yijie/app/chat/domain/model"
+	"github.com/LingeringAutumn/Yijie/app/chat/domain/repository"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// memoryRepo 只实现保存私信
+type memoryRepo struct {
+	repository.MessageRepository
+	saved []*model.Message
+}
+
+func (r *memoryRepo) SaveMessage(ctx context.Context, msg *model.Message) error {
+	r.saved = append(r.saved, msg)
+	return nil
+}
+
+// privacyRPC 只允许 allowed 中的发送者私信
+type privacyRPC struct {
+	allowed map[int64]bool
+	actions []string
+}
+
+func (r *privacyRPC) CheckPrivacy(ctx context.Context, viewerID, ownerID int64, action string) (bool, error) {
+	r.actions = append(r.actions, action)
+	return r.allowed[viewerID], nil
+}
+
+func TestChatService_SaveMessage(t *testing.T) {
+	convey.Convey("SaveMessage", t, func() {
+		ctx := context.Background()
+		repo := &memoryRepo{}
+		rpc := &privacyRPC{allowed: map[int64]bool{1: true}}
+		svc := NewChatService(repo, rpc)
+
+		convey.Convey("allowed senders are saved", func() {
+			convey.So(svc.SaveMessage(ctx, &model.Message{SenderID: 1, ReceiverID: 9, Content: "hi"}), convey.ShouldBeNil)
+			convey.So(repo.saved, convey.ShouldHaveLength, 1)
+			convey.So(rpc.actions, convey.ShouldResemble, []string{constants.PrivacyActionMessage})
+		})
+
+		convey.Convey("blocked senders are rejected", func() {
+			err := svc.SaveMessage(ctx, &model.Message{SenderID: 2, ReceiverID: 9, Content: "hi"})
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.AuthNoOperatePermissionCode)
+			convey.So(repo.saved, convey.ShouldBeEmpty)
+		})
+	})
+}
@@ -0,0 +1,31 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/LingeringAutumn/Yijie/app/chat/domain/repository"
+	userrpc "github.com/LingeringAutumn/Yijie/kitex_gen/user"
+	"github.com/LingeringAutumn/Yijie/kitex_gen/user/userservice"
+	"github.com/LingeringAutumn/Yijie/pkg/utils"
+)
+
+type chatRPC struct {
+	user userservice.Client
+}
+
+func NewChatRPC(user userservice.Client) repository.ChatRPC {
+	return &chatRPC{user: user}
+}
+
+func (rpc *chatRPC) CheckPrivacy(ctx context.Context, viewerID, ownerID int64, action string) (bool, error) {
+	req := &userrpc.CheckPrivacyRequest{
+		ViewerId: viewerID,
+		OwnerId:  ownerID,
+		Action:   action,
+	}
+	resp, err := rpc.user.CheckPrivacy(ctx, req)
+	if err = utils.ProcessRpcError("user.CheckPrivacy", resp, err); err != nil {
+		return false, err
+	}
+	return resp.Allowed, nil
+}
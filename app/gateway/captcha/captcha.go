@@ -0,0 +1,124 @@
+package captcha
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/LingeringAutumn/Yijie/pkg/base/client"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+)
+
+var rdb *redis.Client
+
+// Init 初始化验证码使用的 Redis，需要在 config.Init 之后调用
+func Init() {
+	c, err := client.InitRedis(constants.RedisDBGateway)
+	if err != nil {
+		logger.Fatalf("captcha.Init: init redis failed: %v", err)
+	}
+	rdb = c
+}
+
+// Generate 生成一个新的验证码，返回验证码 ID 和 PNG 图片的 data URL
+func Generate(ctx context.Context) (id string, image string, err error) {
+	id, err = randomID()
+	if err != nil {
+		return "", "", err
+	}
+	answer, err := randomText(constants.CaptchaLength)
+	if err != nil {
+		return "", "", err
+	}
+	png, err := renderPNG(answer, constants.CaptchaWidth, constants.CaptchaHeight)
+	if err != nil {
+		return "", "", err
+	}
+	if err = rdb.Set(ctx, constants.CaptchaKeyPrefix+id, answer, constants.CaptchaTTL).Err(); err != nil {
+		return "", "", errno.Errorf(errno.InternalRedisErrorCode, "captcha: store answer failed: %v", err)
+	}
+	return id, "data:image/png;base64," + base64.StdEncoding.EncodeToString(png), nil
+}
+
+// Verify 校验验证码，不区分大小写
+// 无论答案是否正确，验证码都会被删除，一个验证码只能尝试一次
+func Verify(ctx context.Context, id, answer string) error {
+	if id == "" || answer == "" {
+		return errno.AuthCaptchaRequired
+	}
+	expected, err := rdb.GetDel(ctx, constants.CaptchaKeyPrefix+id).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return errno.AuthCaptchaInvalid
+		}
+		return errno.Errorf(errno.InternalRedisErrorCode, "captcha: get answer failed: %v", err)
+	}
+	if !strings.EqualFold(expected, strings.TrimSpace(answer)) {
+		return errno.AuthCaptchaInvalid
+	}
+	return nil
+}
+
+// LoginFailures 返回该 IP 在统计窗口内的登录失败次数
+func LoginFailures(ctx context.Context, ip string) (int64, error) {
+	n, err := rdb.Get(ctx, constants.LoginFailureKeyPrefix+ip).Int64()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return 0, nil
+		}
+		return 0, errno.Errorf(errno.InternalRedisErrorCode, "captcha: get login failures failed: %v", err)
+	}
+	return n, nil
+}
+
+// RecordLoginFailure 记录一次登录失败，统计窗口从第一次失败开始计算
+func RecordLoginFailure(ctx context.Context, ip string) {
+	key := constants.LoginFailureKeyPrefix + ip
+	n, err := rdb.Incr(ctx, key).Result()
+	if err != nil {
+		logger.Errorf("captcha: record login failure for %s failed: %v", ip, err)
+		return
+	}
+	if n == 1 {
+		if err = rdb.Expire(ctx, key, constants.LoginFailureWindow).Err(); err != nil {
+			logger.Errorf("captcha: set login failure window for %s failed: %v", ip, err)
+		}
+	}
+}
+
+// ResetLoginFailures 登录成功后清空失败次数
+func ResetLoginFailures(ctx context.Context, ip string) {
+	if err := rdb.Del(ctx, constants.LoginFailureKeyPrefix+ip).Err(); err != nil {
+		logger.Errorf("captcha: reset login failures for %s failed: %v", ip, err)
+	}
+}
+
+func randomID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", errno.Errorf(errno.InternalServiceErrorCode, "captcha: generate id failed: %v", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+func randomText(n int) (string, error) {
+	alphabet := constants.CaptchaAlphabet
+	size := big.NewInt(int64(len(alphabet)))
+	text := make([]byte, n)
+	for i := range text {
+		idx, err := rand.Int(rand.Reader, size)
+		if err != nil {
+			return "", errno.Errorf(errno.InternalServiceErrorCode, "captcha: generate text failed: %v", err)
+		}
+		text[i] = alphabet[idx.Int64()]
+	}
+	return string(text), nil
+}
@@ -0,0 +1,161 @@
+package captcha
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"math/rand"
+
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// 扭曲参数，数值是按 120x40 的图片调出来的
+const (
+	glyphScaleMin   = 3.0  // 字符最小放大倍数
+	glyphScaleMax   = 3.8  // 字符最大放大倍数
+	glyphMaxRotate  = 0.35 // 字符最大旋转角度，弧度
+	waveAmplitude   = 2.5  // 正弦扭曲的振幅，像素
+	wavePeriodMin   = 20.0 // 正弦扭曲的最小周期，像素
+	wavePeriodRange = 20.0
+	noiseLines      = 4
+	noiseDotsRatio  = 25 // 每多少个像素撒一个噪点
+)
+
+// renderPNG 把验证码文本渲染成一张带扭曲和噪点的 PNG 图片
+// 随机数只用于干扰图形，验证码文本本身由调用方使用 crypto/rand 生成
+func renderPNG(text string, width, height int) ([]byte, error) {
+	r := rand.New(rand.NewSource(rand.Int63())) //nolint:gosec
+	bg := color.NRGBA{
+		R: uint8(220 + r.Intn(36)),
+		G: uint8(220 + r.Intn(36)),
+		B: uint8(220 + r.Intn(36)),
+		A: 0xff,
+	}
+
+	src := image.NewNRGBA(image.Rect(0, 0, width, height))
+	fill(src, bg)
+	cell := float64(width) / float64(len(text))
+	for i := 0; i < len(text); i++ {
+		cx := cell*float64(i) + cell/2 + (r.Float64()-0.5)*cell*0.3
+		cy := float64(height)/2 + (r.Float64()-0.5)*float64(height)*0.2
+		drawGlyph(src, text[i], cx, cy, randomScale(r), (r.Float64()*2-1)*glyphMaxRotate, randomInk(r))
+	}
+
+	dst := wave(src, bg, r)
+	for i := 0; i < noiseLines; i++ {
+		drawLine(dst, r.Intn(width), r.Intn(height), r.Intn(width), r.Intn(height), randomInk(r))
+	}
+	for i := 0; i < width*height/noiseDotsRatio; i++ {
+		dst.SetNRGBA(r.Intn(width), r.Intn(height), randomInk(r))
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, dst); err != nil {
+		return nil, errno.Errorf(errno.InternalServiceErrorCode, "captcha: encode png failed: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// drawGlyph 以 (cx, cy) 为中心绘制一个经过缩放和旋转的字符
+// 对目标区域的每个像素做逆变换，回到点阵坐标上取样，这样旋转后不会出现空洞
+func drawGlyph(img *image.NRGBA, ch byte, cx, cy, scale, angle float64, ink color.NRGBA) {
+	sin, cos := math.Sincos(angle)
+	half := math.Hypot(glyphWidth, glyphHeight) * scale / 2
+	bounds := img.Bounds()
+	for y := int(cy - half); y <= int(cy+half); y++ {
+		for x := int(cx - half); x <= int(cx+half); x++ {
+			if !(image.Point{X: x, Y: y}).In(bounds) {
+				continue
+			}
+			dx, dy := float64(x)-cx, float64(y)-cy
+			u := (dx*cos+dy*sin)/scale + glyphWidth/2.0
+			v := (-dx*sin+dy*cos)/scale + glyphHeight/2.0
+			if u < 0 || v < 0 {
+				continue
+			}
+			if glyphPixel(ch, int(u), int(v)) {
+				img.SetNRGBA(x, y, ink)
+			}
+		}
+	}
+}
+
+// wave 对整张图做一次水平方向的正弦扭曲
+func wave(src *image.NRGBA, bg color.NRGBA, r *rand.Rand) *image.NRGBA {
+	bounds := src.Bounds()
+	dst := image.NewNRGBA(bounds)
+	period := wavePeriodMin + r.Float64()*wavePeriodRange
+	phase := r.Float64() * 2 * math.Pi
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		offset := int(math.Round(waveAmplitude * math.Sin(2*math.Pi*float64(y)/period+phase)))
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			sx := x + offset
+			if sx < bounds.Min.X || sx >= bounds.Max.X {
+				dst.SetNRGBA(x, y, bg)
+				continue
+			}
+			dst.SetNRGBA(x, y, src.NRGBAAt(sx, y))
+		}
+	}
+	return dst
+}
+
+// drawLine 使用 Bresenham 算法画一条干扰线
+func drawLine(img *image.NRGBA, x0, y0, x1, y1 int, c color.NRGBA) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+	e := dx + dy
+	for {
+		img.SetNRGBA(x0, y0, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * e
+		if e2 >= dy {
+			e += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			e += dx
+			y0 += sy
+		}
+	}
+}
+
+func fill(img *image.NRGBA, c color.NRGBA) {
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			img.SetNRGBA(x, y, c)
+		}
+	}
+}
+
+func randomScale(r *rand.Rand) float64 {
+	return glyphScaleMin + r.Float64()*(glyphScaleMax-glyphScaleMin)
+}
+
+// randomInk 返回一个偏暗的颜色，保证和浅色背景有足够的对比度
+func randomInk(r *rand.Rand) color.NRGBA {
+	return color.NRGBA{
+		R: uint8(r.Intn(140)),
+		G: uint8(r.Intn(140)),
+		B: uint8(r.Intn(140)),
+		A: 0xff,
+	}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
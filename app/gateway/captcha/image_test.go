@@ -0,0 +1,48 @@
+package captcha
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+)
+
+func TestGlyphs_CoverAlphabet(t *testing.T) {
+	convey.Convey("every captcha character has a glyph", t, func() {
+		for i := 0; i < len(constants.CaptchaAlphabet); i++ {
+			ch := constants.CaptchaAlphabet[i]
+			g, ok := glyphs[ch]
+			convey.So(ok, convey.ShouldBeTrue)
+			for _, row := range g {
+				convey.So(len(row), convey.ShouldEqual, glyphWidth)
+			}
+		}
+	})
+}
+
+func TestRenderPNG(t *testing.T) {
+	testCases := []struct {
+		Name   string
+		Text   string
+		Width  int
+		Height int
+	}{
+		{Name: "Default", Text: "AB3XZ", Width: constants.CaptchaWidth, Height: constants.CaptchaHeight},
+		{Name: "Wide", Text: "2345678", Width: 200, Height: 60},
+	}
+
+	for _, tc := range testCases {
+		convey.Convey(tc.Name, t, func() {
+			data, err := renderPNG(tc.Text, tc.Width, tc.Height)
+			convey.So(err, convey.ShouldBeNil)
+
+			img, err := png.Decode(bytes.NewReader(data))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(img.Bounds().Dx(), convey.ShouldEqual, tc.Width)
+			convey.So(img.Bounds().Dy(), convey.ShouldEqual, tc.Height)
+		})
+	}
+}
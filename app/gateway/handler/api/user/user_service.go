@@ -10,6 +10,7 @@ import (
 	// hmodel "github.com/LingeringAutumn/Yijie/app/gateway/model/model"
 	"github.com/cloudwego/hertz/pkg/app"
 
+	"github.com/LingeringAutumn/Yijie/app/gateway/captcha"
 	"github.com/LingeringAutumn/Yijie/app/gateway/pack"
 	"github.com/LingeringAutumn/Yijie/app/gateway/rpc"
 	kmodel "github.com/LingeringAutumn/Yijie/kitex_gen/model"
@@ -31,10 +32,11 @@ func Register(ctx context.Context, c *app.RequestContext) {
 	}
 
 	resp, err := rpc.RegisterRPC(ctx, &user.RegisterRequest{
-		Username: req.Name,
-		Password: req.Password,
-		Email:    req.Email,
-		Phone:    req.Phone,
+		Username:   req.Name,
+		Password:   req.Password,
+		Email:      req.Email,
+		Phone:      req.Phone,
+		InviteCode: req.InviteCode,
 	})
 	if err != nil {
 		pack.RespError(c, err)
@@ -58,15 +60,23 @@ func Login(ctx context.Context, c *app.RequestContext) {
 	}
 	// 调用 rpc.LoginRPC 函数进行远程过程调用，尝试登录
 	// 将请求中的用户名和密码传递给 LoginRequest 结构体
+	// 客户端 IP 和 UA 用于记录安全事件以及新设备识别
+	ip := c.ClientIP()
+	userAgent := string(c.UserAgent())
 	resp, err := rpc.LoginRPC(ctx, &user.LoginRequest{
-		Username: req.Name,
-		Password: req.Password,
+		Username:  req.Name,
+		Password:  req.Password,
+		Ip:        &ip,
+		UserAgent: &userAgent,
 	})
 	if err != nil {
+		// 登录失败次数达到阈值后，后续登录需要图形验证码
+		captcha.RecordLoginFailure(ctx, ip)
 		// 如果 RPC 调用过程中出现错误，调用 pack.RespError 函数返回错误响应
 		pack.RespError(c, err)
 		return
 	}
+	captcha.ResetLoginFailures(ctx, ip)
 	// 调用 utils.CreateAllToken 函数为登录成功的用户创建访问令牌和刷新令牌
 	// resp.User.UserId 是登录成功后返回的用户 ID
 	accessToken, refreshToken, err := utils.CreateAllToken(resp.User.UserId)
@@ -152,3 +162,158 @@ func GetProfile(ctx context.Context, c *app.RequestContext) {
 	}
 	pack.RespData(c, resp)
 }
+
+// ListSecurityEvents .
+// @router api/v1/user/security/events [GET]
+func ListSecurityEvents(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ListSecurityEventsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.ListSecurityEventsRPC(ctx, &user.ListSecurityEventsRequest{
+		PageNum:  req.PageNum,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// MintInviteCodes .
+// @router api/v1/user/invite/mint [POST]
+func MintInviteCodes(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.MintInviteCodesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.MintInviteCodesRPC(ctx, &user.MintInviteCodesRequest{
+		Count:         req.Count,
+		MaxUses:       req.MaxUses,
+		ExpireSeconds: req.ExpireSeconds,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// ListInviteCodes .
+// @router api/v1/user/invite/list [GET]
+func ListInviteCodes(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.ListInviteCodesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.ListInviteCodesRPC(ctx, &user.ListInviteCodesRequest{
+		PageNum:  req.PageNum,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// CreateInviteCode .
+// @router api/v1/user/invite/create [POST]
+func CreateInviteCode(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.CreateInviteCodeRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.CreateInviteCodeRPC(ctx, &user.CreateInviteCodeRequest{})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// GetCaptcha .
+// @router api/v1/user/captcha [GET]
+func GetCaptcha(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetCaptchaRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	id, image, err := captcha.Generate(ctx)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, &api.GetCaptchaResponse{
+		CaptchaId: id,
+		Image:     image,
+	})
+}
+
+// GetPrivacySettings .
+// @router api/v1/user/privacy/get [GET]
+func GetPrivacySettings(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetPrivacySettingsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.GetPrivacySettingsRPC(ctx, &user.GetPrivacySettingsRequest{})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// UpdatePrivacySettings .
+// @router api/v1/user/privacy/update [PUT]
+func UpdatePrivacySettings(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.UpdatePrivacySettingsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+
+	resp, err := rpc.UpdatePrivacySettingsRPC(ctx, &user.UpdatePrivacySettingsRequest{
+		Settings: &kmodel.PrivacySettings{
+			ProfileVisibility: req.ProfileVisibility,
+			HideLikedVideos:   req.HideLikedVideos,
+			WhoCanMessage:     req.WhoCanMessage,
+			WhoCanFollow:      req.WhoCanFollow,
+
+			DisableSearchHistory: req.DisableSearchHistory,
+		},
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
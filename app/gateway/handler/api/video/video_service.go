@@ -4,12 +4,16 @@ package video
 
 import (
 	"context"
+	"fmt"
 
 	api "github.com/LingeringAutumn/Yijie/app/gateway/model/api/video"
 	"github.com/LingeringAutumn/Yijie/app/gateway/pack"
 	"github.com/LingeringAutumn/Yijie/app/gateway/rpc"
+	"github.com/LingeringAutumn/Yijie/app/gateway/stream"
 
 	"github.com/LingeringAutumn/Yijie/kitex_gen/video"
+	metainfoContext "github.com/LingeringAutumn/Yijie/pkg/base/context"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
 	"github.com/LingeringAutumn/Yijie/pkg/errno"
 	"github.com/LingeringAutumn/Yijie/pkg/utils"
 
@@ -55,6 +59,9 @@ func SubmitVideo(ctx context.Context, c *app.RequestContext) {
 		CoverUrl:        req.CoverURL,
 		DurationSeconds: req.DurationSeconds,
 		Video:           videoData,
+		IsDraft:         req.IsDraft,
+		PublishAt:       req.PublishAt,
+		Tags:            req.Tags,
 	})
 	if err != nil {
 		pack.RespError(c, err)
@@ -97,8 +104,11 @@ func SearchVideo(ctx context.Context, c *app.RequestContext) {
 	resp, err := rpc.SearchVideoRPC(ctx, &video.VideoSearchRequest{
 		Keyword:  req.Keyword,
 		Tags:     req.Tags,
+		TagMode:  req.TagMode,
+		Sort:     req.Sort,
 		PageNum:  req.PageNum,
 		PageSize: req.PageSize,
+		Cursor:   req.Cursor,
 	})
 	if err != nil {
 		pack.RespError(c, err)
@@ -120,6 +130,506 @@ func TrendVideo(ctx context.Context, c *app.RequestContext) {
 	resp, err := rpc.TrendVideoRPC(ctx, &video.VideoTrendingRequest{
 		PageNum:  req.PageNum,
 		PageSize: req.PageSize,
+		Cursor:   req.Cursor,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// UpdateVideo .
+// @router api/v1/video/update [PUT]
+func UpdateVideo(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.VideoUpdateRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.UpdateVideoRPC(ctx, &video.VideoUpdateRequest{
+		VideoId:     req.VideoID,
+		Title:       req.Title,
+		Description: req.Description,
+		CoverUrl:    req.CoverURL,
+		Tags:        req.Tags,
+		Visibility:  req.Visibility,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// DeleteVideo .
+// @router api/v1/video/delete [DELETE]
+func DeleteVideo(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.VideoDeleteRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	_, err = rpc.DeleteVideoRPC(ctx, &video.VideoDeleteRequest{
+		VideoId: req.VideoID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// ListDrafts .
+// @router api/v1/video/draft/list [GET]
+func ListDrafts(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.VideoDraftListRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.ListDraftsRPC(ctx, &video.VideoDraftListRequest{
+		PageNum:  req.PageNum,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// ScheduleVideo .
+// @router api/v1/video/draft/schedule [PUT]
+func ScheduleVideo(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.VideoScheduleRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.ScheduleVideoRPC(ctx, &video.VideoScheduleRequest{
+		VideoId:   req.VideoID,
+		PublishAt: req.PublishAt,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// ListVideosByTag .
+// @router api/v1/video/tag/list [GET]
+func ListVideosByTag(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.VideoTagListRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.ListVideosByTagRPC(ctx, &video.VideoTagListRequest{
+		Tag:      req.Tag,
+		PageNum:  req.PageNum,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// PopularTags .
+// @router api/v1/video/tag/popular [GET]
+func PopularTags(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.PopularTagsRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.PopularTagsRPC(ctx, &video.PopularTagsRequest{
+		Limit: req.Limit,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// SuggestSearch .
+// @router api/v1/video/search/suggest [GET]
+func SuggestSearch(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.SearchSuggestRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.SuggestSearchRPC(ctx, &video.SearchSuggestRequest{
+		Prefix: req.Prefix,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// TrendingSearches .
+// @router api/v1/video/search/trending [GET]
+func TrendingSearches(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.TrendingSearchesRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.TrendingSearchesRPC(ctx, &video.TrendingSearchesRequest{
+		Limit: req.Limit,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// ListSearchHistory .
+// @router api/v1/video/search/history/list [GET]
+func ListSearchHistory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.SearchHistoryListRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.ListSearchHistoryRPC(ctx, &video.SearchHistoryListRequest{})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// DeleteSearchHistoryItem .
+// @router api/v1/video/search/history/delete [DELETE]
+func DeleteSearchHistoryItem(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.SearchHistoryDeleteRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	_, err = rpc.DeleteSearchHistoryItemRPC(ctx, &video.SearchHistoryDeleteRequest{
+		Keyword: req.Keyword,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// ClearSearchHistory .
+// @router api/v1/video/search/history/clear [DELETE]
+func ClearSearchHistory(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.SearchHistoryClearRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	_, err = rpc.ClearSearchHistoryRPC(ctx, &video.SearchHistoryClearRequest{})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// GetVideoProcessingStatus .
+// @router api/v1/video/processing/status [GET]
+func GetVideoProcessingStatus(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.VideoProcessingStatusRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.GetVideoProcessingStatusRPC(ctx, &video.VideoProcessingStatusRequest{
+		VideoId: req.VideoID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// InitVideoUpload .
+// @router api/v1/video/upload/init [POST]
+func InitVideoUpload(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.InitVideoUploadRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.InitVideoUploadRPC(ctx, &video.InitVideoUploadRequest{
+		Title:           req.Title,
+		Description:     req.Description,
+		CoverUrl:        req.CoverURL,
+		DurationSeconds: req.DurationSeconds,
+		IsDraft:         req.IsDraft,
+		PublishAt:       req.PublishAt,
+		Tags:            req.Tags,
+		FileSize:        req.FileSize,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// UploadVideoChunk .
+// @router api/v1/video/upload/chunk [PUT]
+func UploadVideoChunk(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.UploadVideoChunkRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	// 分片内容就是请求体
+	data := c.Request.Body()
+	if len(data) == 0 || len(data) > constants.VideoUploadChunkSize {
+		pack.RespError(c, errno.ParamVerifyError.WithMessage(fmt.Sprintf("chunk should be between 1 and %d bytes", constants.VideoUploadChunkSize)))
+		return
+	}
+	_, err = rpc.UploadVideoChunkRPC(ctx, &video.UploadVideoChunkRequest{
+		UploadId:   req.UploadID,
+		PartNumber: req.PartNumber,
+		Checksum:   req.Checksum,
+		Data:       data,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// GetVideoUpload .
+// @router api/v1/video/upload/status [GET]
+func GetVideoUpload(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.GetVideoUploadRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.GetVideoUploadRPC(ctx, &video.GetVideoUploadRequest{
+		UploadId: req.UploadID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// CompleteVideoUpload .
+// @router api/v1/video/upload/complete [POST]
+func CompleteVideoUpload(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.CompleteVideoUploadRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.CompleteVideoUploadRPC(ctx, &video.CompleteVideoUploadRequest{
+		UploadId: req.UploadID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// AbortVideoUpload .
+// @router api/v1/video/upload/abort [DELETE]
+func AbortVideoUpload(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.AbortVideoUploadRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	_, err = rpc.AbortVideoUploadRPC(ctx, &video.AbortVideoUploadRequest{
+		UploadId: req.UploadID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespSuccess(c)
+}
+
+// InitDirectUpload .
+// @router api/v1/video/upload/direct/init [POST]
+func InitDirectUpload(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.InitDirectUploadRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.InitDirectUploadRPC(ctx, &video.InitDirectUploadRequest{
+		Title:           req.Title,
+		Description:     req.Description,
+		CoverUrl:        req.CoverURL,
+		DurationSeconds: req.DurationSeconds,
+		IsDraft:         req.IsDraft,
+		PublishAt:       req.PublishAt,
+		Tags:            req.Tags,
+		FileSize:        req.FileSize,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// CompleteDirectUpload .
+// @router api/v1/video/upload/direct/complete [POST]
+func CompleteDirectUpload(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.CompleteDirectUploadRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.CompleteDirectUploadRPC(ctx, &video.CompleteDirectUploadRequest{
+		UploadId: req.UploadID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// StreamVideo .
+// @router api/v1/video/stream/:id [GET]
+func StreamVideo(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.VideoStreamRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	uid, err := metainfoContext.GetLoginData(ctx)
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	resp, err := rpc.GetVideoObjectRPC(ctx, &video.GetVideoObjectRequest{
+		VideoId: req.VideoID,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	stream.Serve(c, uid, resp.Bucket, resp.ObjectKey)
+}
+
+// ListUserVideos .
+// @router api/v1/video/user/list [GET]
+func ListUserVideos(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.UserVideoListRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.ListUserVideosRPC(ctx, &video.UserVideoListRequest{
+		UserId: req.UserID,
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+		Sort:   req.Sort,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// GetFeed .
+// @router api/v1/video/feed [GET]
+func GetFeed(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.FeedRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.GetFeedRPC(ctx, &video.FeedRequest{
+		Cursor: req.Cursor,
+		Limit:  req.Limit,
+	})
+	if err != nil {
+		pack.RespError(c, err)
+		return
+	}
+	pack.RespData(c, resp)
+}
+
+// RelatedVideos .
+// @router api/v1/video/related [GET]
+func RelatedVideos(ctx context.Context, c *app.RequestContext) {
+	var err error
+	var req api.RelatedVideosRequest
+	err = c.BindAndValidate(&req)
+	if err != nil {
+		pack.RespError(c, errno.ParamVerifyError.WithError(err))
+		return
+	}
+	resp, err := rpc.RelatedVideosRPC(ctx, &video.RelatedVideosRequest{
+		VideoId: req.VideoID,
+		Limit:   req.Limit,
 	})
 	if err != nil {
 		pack.RespError(c, err)
@@ -0,0 +1,51 @@
+package mw
+
+import (
+	"context"
+
+	"github.com/cloudwego/hertz/pkg/app"
+
+	"github.com/LingeringAutumn/Yijie/app/gateway/captcha"
+	"github.com/LingeringAutumn/Yijie/app/gateway/pack"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+)
+
+// Captcha 要求请求必须携带正确的图形验证码
+// 验证码 ID 和答案分别放在 constants.CaptchaIdHeader 和 constants.CaptchaCodeHeader 请求头中
+func Captcha() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		if !verifyCaptcha(ctx, c) {
+			return
+		}
+		c.Next(ctx)
+	}
+}
+
+// LoginCaptcha 同一个 IP 登录失败次数达到阈值后才要求图形验证码
+func LoginCaptcha() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		failures, err := captcha.LoginFailures(ctx, c.ClientIP())
+		if err != nil {
+			// Redis 出问题时按需要验证码处理，避免限制被绕过
+			logger.Errorf("mw.LoginCaptcha: get login failures failed: %v", err)
+			failures = constants.LoginFailureThreshold
+		}
+		if failures >= constants.LoginFailureThreshold && !verifyCaptcha(ctx, c) {
+			return
+		}
+		c.Next(ctx)
+	}
+}
+
+// verifyCaptcha 校验失败时直接写回错误响应并中止请求
+func verifyCaptcha(ctx context.Context, c *app.RequestContext) bool {
+	id := string(c.GetHeader(constants.CaptchaIdHeader))
+	code := string(c.GetHeader(constants.CaptchaCodeHeader))
+	if err := captcha.Verify(ctx, id, code); err != nil {
+		pack.RespError(c, err)
+		c.Abort()
+		return false
+	}
+	return true
+}
@@ -30,12 +30,16 @@ func _userMw() []app.HandlerFunc {
 
 func _loginMw() []app.HandlerFunc {
 	// your code...
-	return nil
+	return []app.HandlerFunc{
+		mw.LoginCaptcha(),
+	}
 }
 
 func _registerMw() []app.HandlerFunc {
 	// your code...
-	return nil
+	return []app.HandlerFunc{
+		mw.Captcha(),
+	}
 }
 
 func _profileMw() []app.HandlerFunc {
@@ -65,3 +69,59 @@ func _updateprofileMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _securityMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+	}
+}
+
+func _listsecurityeventsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _inviteMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+	}
+}
+
+func _createinvitecodeMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listinvitecodesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _mintinvitecodesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getcaptchaMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _privacyMw() []app.HandlerFunc {
+	// your code...
+	return []app.HandlerFunc{
+		mw.Auth(),
+	}
+}
+
+func _getprivacysettingsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _updateprivacysettingsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -24,6 +24,7 @@ func Register(r *server.Hertz) {
 			_v1 := _api.Group("/v1", _v1Mw()...)
 			{
 				_user := _v1.Group("/user", _userMw()...)
+				_user.GET("/captcha", append(_getcaptchaMw(), user.GetCaptcha)...)
 				_user.POST("/login", append(_loginMw(), user.Login)...)
 				_user.POST("/register", append(_registerMw(), user.Register)...)
 				{
@@ -31,6 +32,21 @@ func Register(r *server.Hertz) {
 					_profile.GET("/get", append(_getprofileMw(), user.GetProfile)...)
 					_profile.PUT("/update", append(_updateprofileMw(), user.UpdateProfile)...)
 				}
+				{
+					_invite := _user.Group("/invite", _inviteMw()...)
+					_invite.POST("/create", append(_createinvitecodeMw(), user.CreateInviteCode)...)
+					_invite.GET("/list", append(_listinvitecodesMw(), user.ListInviteCodes)...)
+					_invite.POST("/mint", append(_mintinvitecodesMw(), user.MintInviteCodes)...)
+				}
+				{
+					_privacy := _user.Group("/privacy", _privacyMw()...)
+					_privacy.GET("/get", append(_getprivacysettingsMw(), user.GetPrivacySettings)...)
+					_privacy.PUT("/update", append(_updateprivacysettingsMw(), user.UpdatePrivacySettings)...)
+				}
+				{
+					_security := _user.Group("/security", _securityMw()...)
+					_security.GET("/events", append(_listsecurityeventsMw(), user.ListSecurityEvents)...)
+				}
 			}
 		}
 	}
@@ -55,3 +55,163 @@ func _trendvideoMw() []app.HandlerFunc {
 	// your code...
 	return nil
 }
+
+func _updatevideoMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deletevideoMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _draftMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listdraftsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _schedulevideoMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _tagMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listvideosbytagMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _populartagsMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _searchMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _suggestsearchMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _trendingsearchesMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _historyMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _clearsearchhistoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _deletesearchhistoryitemMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listsearchhistoryMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _processingMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getvideoprocessingstatusMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _uploadMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _initvideouploadMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _uploadvideochunkMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getvideouploadMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _completevideouploadMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _abortvideouploadMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _directMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _initdirectuploadMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _completedirectuploadMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _streamMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _streamvideoMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _userMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _listuservideosMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _getfeedMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
+
+func _relatedvideosMw() []app.HandlerFunc {
+	// your code...
+	return nil
+}
@@ -24,10 +24,60 @@ func Register(r *server.Hertz) {
 			_v1 := _api.Group("/v1", _v1Mw()...)
 			{
 				_video := _v1.Group("/video", _videoMw()...)
+				_video.DELETE("/delete", append(_deletevideoMw(), video.DeleteVideo)...)
+				_video.GET("/feed", append(_getfeedMw(), video.GetFeed)...)
 				_video.GET("/get", append(_getvideoMw(), video.GetVideo)...)
+				_video.GET("/related", append(_relatedvideosMw(), video.RelatedVideos)...)
 				_video.GET("/search", append(_searchvideoMw(), video.SearchVideo)...)
 				_video.POST("/submit", append(_submitvideoMw(), video.SubmitVideo)...)
 				_video.GET("/trending", append(_trendvideoMw(), video.TrendVideo)...)
+				_video.PUT("/update", append(_updatevideoMw(), video.UpdateVideo)...)
+				{
+					_draft := _video.Group("/draft", _draftMw()...)
+					_draft.GET("/list", append(_listdraftsMw(), video.ListDrafts)...)
+					_draft.PUT("/schedule", append(_schedulevideoMw(), video.ScheduleVideo)...)
+				}
+				{
+					_processing := _video.Group("/processing", _processingMw()...)
+					_processing.GET("/status", append(_getvideoprocessingstatusMw(), video.GetVideoProcessingStatus)...)
+				}
+				{
+					_search := _video.Group("/search", _searchMw()...)
+					_search.GET("/suggest", append(_suggestsearchMw(), video.SuggestSearch)...)
+					_search.GET("/trending", append(_trendingsearchesMw(), video.TrendingSearches)...)
+					{
+						_history := _search.Group("/history", _historyMw()...)
+						_history.DELETE("/clear", append(_clearsearchhistoryMw(), video.ClearSearchHistory)...)
+						_history.DELETE("/delete", append(_deletesearchhistoryitemMw(), video.DeleteSearchHistoryItem)...)
+						_history.GET("/list", append(_listsearchhistoryMw(), video.ListSearchHistory)...)
+					}
+				}
+				{
+					_stream := _video.Group("/stream", _streamMw()...)
+					_stream.GET("/:id", append(_streamvideoMw(), video.StreamVideo)...)
+				}
+				{
+					_tag := _video.Group("/tag", _tagMw()...)
+					_tag.GET("/list", append(_listvideosbytagMw(), video.ListVideosByTag)...)
+					_tag.GET("/popular", append(_populartagsMw(), video.PopularTags)...)
+				}
+				{
+					_upload := _video.Group("/upload", _uploadMw()...)
+					_upload.DELETE("/abort", append(_abortvideouploadMw(), video.AbortVideoUpload)...)
+					_upload.PUT("/chunk", append(_uploadvideochunkMw(), video.UploadVideoChunk)...)
+					_upload.POST("/complete", append(_completevideouploadMw(), video.CompleteVideoUpload)...)
+					_upload.POST("/init", append(_initvideouploadMw(), video.InitVideoUpload)...)
+					_upload.GET("/status", append(_getvideouploadMw(), video.GetVideoUpload)...)
+					{
+						_direct := _upload.Group("/direct", _directMw()...)
+						_direct.POST("/complete", append(_completedirectuploadMw(), video.CompleteDirectUpload)...)
+						_direct.POST("/init", append(_initdirectuploadMw(), video.InitDirectUpload)...)
+					}
+				}
+				{
+					_user := _video.Group("/user", _userMw()...)
+					_user.GET("/list", append(_listuservideosMw(), video.ListUserVideos)...)
+				}
 			}
 		}
 	}
@@ -7,6 +7,7 @@ import (
 
 	api "github.com/LingeringAutumn/Yijie/app/gateway/model/api/user"
 	"github.com/LingeringAutumn/Yijie/app/gateway/model/model"
+	kmodel "github.com/LingeringAutumn/Yijie/kitex_gen/model"
 	"github.com/LingeringAutumn/Yijie/kitex_gen/user"
 	"github.com/LingeringAutumn/Yijie/pkg/base/client"
 	"github.com/LingeringAutumn/Yijie/pkg/errno"
@@ -52,6 +53,7 @@ func LoginRPC(ctx context.Context, req *user.LoginRequest) (response *api.LoginR
 			UserId: resp.User.UserId,
 			Name:   resp.User.Name,
 		},
+		IsNewDevice: resp.IsNewDevice,
 	}
 	return response, nil
 }
@@ -103,3 +105,145 @@ func GetUserProfileRPC(ctx context.Context, req *user.GetUserProfileRequest) (re
 	}
 	return response, nil
 }
+
+func ListSecurityEventsRPC(ctx context.Context, req *user.ListSecurityEventsRequest) (response *api.ListSecurityEventsResponse, err error) {
+	resp, err := userClient.ListSecurityEvents(ctx, req)
+	if err != nil {
+		logger.Errorf("ListSecurityEventsRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	events := make([]*model.SecurityEvent, 0, len(resp.Events))
+	for _, event := range resp.Events {
+		events = append(events, &model.SecurityEvent{
+			ID:          event.Id,
+			Action:      event.Action,
+			IP:          event.Ip,
+			UserAgent:   event.UserAgent,
+			IsNewDevice: event.IsNewDevice,
+			CreatedAt:   event.CreatedAt,
+		})
+	}
+	response = &api.ListSecurityEventsResponse{
+		Events: events,
+		Total:  resp.Total,
+	}
+	return response, nil
+}
+
+func MintInviteCodesRPC(ctx context.Context, req *user.MintInviteCodesRequest) (response *api.MintInviteCodesResponse, err error) {
+	resp, err := userClient.MintInviteCodes(ctx, req)
+	if err != nil {
+		logger.Errorf("MintInviteCodesRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	response = &api.MintInviteCodesResponse{
+		Codes: buildInviteCodes(resp.Codes),
+	}
+	return response, nil
+}
+
+func ListInviteCodesRPC(ctx context.Context, req *user.ListInviteCodesRequest) (response *api.ListInviteCodesResponse, err error) {
+	resp, err := userClient.ListInviteCodes(ctx, req)
+	if err != nil {
+		logger.Errorf("ListInviteCodesRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	response = &api.ListInviteCodesResponse{
+		Codes: buildInviteCodes(resp.Codes),
+		Total: resp.Total,
+	}
+	return response, nil
+}
+
+func CreateInviteCodeRPC(ctx context.Context, req *user.CreateInviteCodeRequest) (response *api.CreateInviteCodeResponse, err error) {
+	resp, err := userClient.CreateInviteCode(ctx, req)
+	if err != nil {
+		logger.Errorf("CreateInviteCodeRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	response = &api.CreateInviteCodeResponse{
+		Code:           buildInviteCode(resp.Code),
+		RemainingQuota: resp.RemainingQuota,
+	}
+	return response, nil
+}
+
+func buildInviteCode(code *kmodel.InviteCode) *model.InviteCode {
+	if code == nil {
+		return nil
+	}
+	return &model.InviteCode{
+		ID:        code.Id,
+		Code:      code.Code,
+		CreatorId: code.CreatorId,
+		BatchId:   code.BatchId,
+		MaxUses:   code.MaxUses,
+		UsedCount: code.UsedCount,
+		ExpiresAt: code.ExpiresAt,
+		CreatedAt: code.CreatedAt,
+	}
+}
+
+func buildInviteCodes(codes []*kmodel.InviteCode) []*model.InviteCode {
+	result := make([]*model.InviteCode, 0, len(codes))
+	for _, code := range codes {
+		result = append(result, buildInviteCode(code))
+	}
+	return result
+}
+
+func GetPrivacySettingsRPC(ctx context.Context, req *user.GetPrivacySettingsRequest) (response *api.GetPrivacySettingsResponse, err error) {
+	resp, err := userClient.GetPrivacySettings(ctx, req)
+	if err != nil {
+		logger.Errorf("GetPrivacySettingsRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	response = &api.GetPrivacySettingsResponse{
+		Settings: buildPrivacySettings(resp.Settings),
+	}
+	return response, nil
+}
+
+func UpdatePrivacySettingsRPC(ctx context.Context, req *user.UpdatePrivacySettingsRequest) (response *api.UpdatePrivacySettingsResponse, err error) {
+	resp, err := userClient.UpdatePrivacySettings(ctx, req)
+	if err != nil {
+		logger.Errorf("UpdatePrivacySettingsRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.Base) {
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	response = &api.UpdatePrivacySettingsResponse{
+		Settings: buildPrivacySettings(resp.Settings),
+	}
+	return response, nil
+}
+
+func buildPrivacySettings(settings *kmodel.PrivacySettings) *model.PrivacySettings {
+	if settings == nil {
+		return nil
+	}
+	return &model.PrivacySettings{
+		ProfileVisibility: settings.ProfileVisibility,
+		HideLikedVideos:   settings.HideLikedVideos,
+		WhoCanMessage:     settings.WhoCanMessage,
+		WhoCanFollow:      settings.WhoCanFollow,
+
+		DisableSearchHistory: settings.DisableSearchHistory,
+	}
+}
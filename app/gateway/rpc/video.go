@@ -66,3 +66,279 @@ func TrendVideoRPC(ctx context.Context, req *video.VideoTrendingRequest) (*video
 	}
 	return resp, nil
 }
+
+func UpdateVideoRPC(ctx context.Context, req *video.VideoUpdateRequest) (*video.VideoUpdateResponse, error) {
+	resp, err := videoClient.UpdateVideo(ctx, req)
+	if err != nil {
+		logger.Errorf("UpdateVideoRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func DeleteVideoRPC(ctx context.Context, req *video.VideoDeleteRequest) (*video.VideoDeleteResponse, error) {
+	resp, err := videoClient.DeleteVideo(ctx, req)
+	if err != nil {
+		logger.Errorf("DeleteVideoRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func ListDraftsRPC(ctx context.Context, req *video.VideoDraftListRequest) (*video.VideoDraftListResponse, error) {
+	resp, err := videoClient.ListDrafts(ctx, req)
+	if err != nil {
+		logger.Errorf("ListDraftsRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func ScheduleVideoRPC(ctx context.Context, req *video.VideoScheduleRequest) (*video.VideoScheduleResponse, error) {
+	resp, err := videoClient.ScheduleVideo(ctx, req)
+	if err != nil {
+		logger.Errorf("ScheduleVideoRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func ListVideosByTagRPC(ctx context.Context, req *video.VideoTagListRequest) (*video.VideoTagListResponse, error) {
+	resp, err := videoClient.ListVideosByTag(ctx, req)
+	if err != nil {
+		logger.Errorf("ListVideosByTagRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func PopularTagsRPC(ctx context.Context, req *video.PopularTagsRequest) (*video.PopularTagsResponse, error) {
+	resp, err := videoClient.PopularTags(ctx, req)
+	if err != nil {
+		logger.Errorf("PopularTagsRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func SuggestSearchRPC(ctx context.Context, req *video.SearchSuggestRequest) (*video.SearchSuggestResponse, error) {
+	resp, err := videoClient.SuggestSearch(ctx, req)
+	if err != nil {
+		logger.Errorf("SuggestSearchRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func TrendingSearchesRPC(ctx context.Context, req *video.TrendingSearchesRequest) (*video.TrendingSearchesResponse, error) {
+	resp, err := videoClient.TrendingSearches(ctx, req)
+	if err != nil {
+		logger.Errorf("TrendingSearchesRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func ListSearchHistoryRPC(ctx context.Context, req *video.SearchHistoryListRequest) (*video.SearchHistoryListResponse, error) {
+	resp, err := videoClient.ListSearchHistory(ctx, req)
+	if err != nil {
+		logger.Errorf("ListSearchHistoryRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func DeleteSearchHistoryItemRPC(ctx context.Context, req *video.SearchHistoryDeleteRequest) (*video.SearchHistoryDeleteResponse, error) {
+	resp, err := videoClient.DeleteSearchHistoryItem(ctx, req)
+	if err != nil {
+		logger.Errorf("DeleteSearchHistoryItemRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func ClearSearchHistoryRPC(ctx context.Context, req *video.SearchHistoryClearRequest) (*video.SearchHistoryClearResponse, error) {
+	resp, err := videoClient.ClearSearchHistory(ctx, req)
+	if err != nil {
+		logger.Errorf("ClearSearchHistoryRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func GetVideoProcessingStatusRPC(ctx context.Context, req *video.VideoProcessingStatusRequest) (*video.VideoProcessingStatusResponse, error) {
+	resp, err := videoClient.GetVideoProcessingStatus(ctx, req)
+	if err != nil {
+		logger.Errorf("GetVideoProcessingStatusRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func InitVideoUploadRPC(ctx context.Context, req *video.InitVideoUploadRequest) (*video.InitVideoUploadResponse, error) {
+	resp, err := videoClient.InitVideoUpload(ctx, req)
+	if err != nil {
+		logger.Errorf("InitVideoUploadRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func UploadVideoChunkRPC(ctx context.Context, req *video.UploadVideoChunkRequest) (*video.UploadVideoChunkResponse, error) {
+	resp, err := videoClient.UploadVideoChunk(ctx, req)
+	if err != nil {
+		logger.Errorf("UploadVideoChunkRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func GetVideoUploadRPC(ctx context.Context, req *video.GetVideoUploadRequest) (*video.GetVideoUploadResponse, error) {
+	resp, err := videoClient.GetVideoUpload(ctx, req)
+	if err != nil {
+		logger.Errorf("GetVideoUploadRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func CompleteVideoUploadRPC(ctx context.Context, req *video.CompleteVideoUploadRequest) (*video.CompleteVideoUploadResponse, error) {
+	resp, err := videoClient.CompleteVideoUpload(ctx, req)
+	if err != nil {
+		logger.Errorf("CompleteVideoUploadRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func AbortVideoUploadRPC(ctx context.Context, req *video.AbortVideoUploadRequest) (*video.AbortVideoUploadResponse, error) {
+	resp, err := videoClient.AbortVideoUpload(ctx, req)
+	if err != nil {
+		logger.Errorf("AbortVideoUploadRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func InitDirectUploadRPC(ctx context.Context, req *video.InitDirectUploadRequest) (*video.InitDirectUploadResponse, error) {
+	resp, err := videoClient.InitDirectUpload(ctx, req)
+	if err != nil {
+		logger.Errorf("InitDirectUploadRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func CompleteDirectUploadRPC(ctx context.Context, req *video.CompleteDirectUploadRequest) (*video.CompleteDirectUploadResponse, error) {
+	resp, err := videoClient.CompleteDirectUpload(ctx, req)
+	if err != nil {
+		logger.Errorf("CompleteDirectUploadRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func GetVideoObjectRPC(ctx context.Context, req *video.GetVideoObjectRequest) (*video.GetVideoObjectResponse, error) {
+	resp, err := videoClient.GetVideoObject(ctx, req)
+	if err != nil {
+		logger.Errorf("GetVideoObjectRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func ListUserVideosRPC(ctx context.Context, req *video.UserVideoListRequest) (*video.UserVideoListResponse, error) {
+	resp, err := videoClient.ListUserVideos(ctx, req)
+	if err != nil {
+		logger.Errorf("ListUserVideosRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func GetFeedRPC(ctx context.Context, req *video.FeedRequest) (*video.FeedResponse, error) {
+	resp, err := videoClient.GetFeed(ctx, req)
+	if err != nil {
+		logger.Errorf("GetFeedRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
+
+func RelatedVideosRPC(ctx context.Context, req *video.RelatedVideosRequest) (*video.RelatedVideosResponse, error) {
+	resp, err := videoClient.RelatedVideos(ctx, req)
+	if err != nil {
+		logger.Errorf("RelatedVideosRPC: RPC called failed: %v", err.Error())
+		return nil, errno.InternalServiceError.WithError(err)
+	}
+	if !utils.IsSuccess(resp.BaseResp) {
+		return nil, errno.InternalServiceError.WithMessage(resp.BaseResp.Msg)
+	}
+	return resp, nil
+}
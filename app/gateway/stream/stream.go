@@ -0,0 +1,120 @@
+package stream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/LingeringAutumn/Yijie/app/gateway/pack"
+	"github.com/LingeringAutumn/Yijie/pkg/base/client"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+	"github.com/LingeringAutumn/Yijie/pkg/httprange"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+	"github.com/LingeringAutumn/Yijie/pkg/utils"
+)
+
+var rdb *redis.Client
+
+// Init 初始化统计播放流量使用的 Redis，需要在 config.Init 和 MinIO 初始化之后调用
+func Init() {
+	c, err := client.InitRedis(constants.RedisDBGateway)
+	if err != nil {
+		logger.Fatalf("stream.Init: init redis failed: %v", err)
+	}
+	rdb = c
+}
+
+// Serve 把对象存储中的文件写入响应，支持 Range、If-Range、If-None-Match 等请求头
+// 内容从 MinIO 边读边写，不会整个读入内存；读出的字节数在响应结束后计入 uid 当天的播放流量
+func Serve(c *app.RequestContext, uid int64, bucket, object string) {
+	info, err := utils.MinioClientGlobal.StatFile(bucket, object)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			pack.RespError(c, errno.Errorf(errno.DBNotFound, "video file %s not found", object))
+			return
+		}
+		pack.RespError(c, errno.InternalServiceError.WithError(err))
+		return
+	}
+
+	res := httprange.Resource{
+		Size:         info.Size,
+		ETag:         `"` + info.ETag + `"`,
+		LastModified: info.LastModified,
+	}
+	result := httprange.Evaluate(httprange.Request{
+		Range:           string(c.GetHeader("Range")),
+		IfRange:         string(c.GetHeader("If-Range")),
+		IfMatch:         string(c.GetHeader("If-Match")),
+		IfNoneMatch:     string(c.GetHeader("If-None-Match")),
+		IfModifiedSince: string(c.GetHeader("If-Modified-Since")),
+	}, res)
+
+	var body io.ReadCloser
+	if result.Length > 0 {
+		if body, err = utils.MinioClientGlobal.GetFileRange(bucket, object, result.Start, result.Length, info.ETag); err != nil {
+			pack.RespError(c, errno.InternalServiceError.WithError(err))
+			return
+		}
+	}
+
+	c.Header("Accept-Ranges", "bytes")
+	c.Header("ETag", res.ETag)
+	c.Header("Last-Modified", res.LastModified.UTC().Format(http.TimeFormat))
+	// 需要鉴权的内容不能被共享缓存保存
+	c.Header("Cache-Control", "private")
+	if contentRange := result.ContentRange(res.Size); contentRange != "" {
+		c.Header("Content-Range", contentRange)
+	}
+	c.SetStatusCode(result.Status)
+	if body == nil {
+		return
+	}
+	contentType := info.ContentType
+	if contentType == "" {
+		contentType = constants.StreamDefaultContentType
+	}
+	c.SetContentType(contentType)
+	c.SetBodyStream(&meteredBody{ReadCloser: body, uid: uid}, int(result.Length))
+}
+
+// meteredBody 统计从 MinIO 读出的字节数，Hertz 写完响应后关闭它，这时把字节数计入用户的播放流量
+type meteredBody struct {
+	io.ReadCloser
+	uid  int64
+	read int64
+}
+
+func (b *meteredBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.read += int64(n)
+	return n, err
+}
+
+func (b *meteredBody) Close() error {
+	err := b.ReadCloser.Close()
+	if b.read > 0 {
+		recordBandwidth(b.uid, b.read)
+	}
+	return err
+}
+
+// recordBandwidth 把 n 个字节计入用户当天的播放流量，失败只记录日志
+func recordBandwidth(uid, n int64) {
+	key := fmt.Sprintf("%s%d:%s", constants.StreamBandwidthKeyPrefix, uid, time.Now().Format("20060102"))
+	ctx := context.Background()
+	pipe := rdb.TxPipeline()
+	pipe.IncrBy(ctx, key, n)
+	pipe.Expire(ctx, key, constants.StreamBandwidthTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		logger.Errorf("stream: record %d bytes for user %d failed: %v", n, uid, err)
+	}
+}
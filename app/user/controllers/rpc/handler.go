@@ -10,6 +10,7 @@ import (
 	"github.com/LingeringAutumn/Yijie/app/user/usecase"
 	"github.com/LingeringAutumn/Yijie/kitex_gen/user"
 	"github.com/LingeringAutumn/Yijie/pkg/base"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
 )
 
 type UserHandler struct {
@@ -31,7 +32,7 @@ func (handler *UserHandler) Register(ctx context.Context, req *user.RegisterRequ
 	}
 
 	var uid int64
-	if uid, err = handler.useCase.RegisterUser(ctx, u); err != nil {
+	if uid, err = handler.useCase.RegisterUser(ctx, u, req.GetInviteCode()); err != nil {
 		r.Base = base.BuildBaseResp(err)
 		return
 	}
@@ -47,13 +48,18 @@ func (handler *UserHandler) Login(ctx context.Context, req *user.LoginRequest) (
 		Username: req.Username,
 		Password: req.Password,
 	}
-	ans, err := handler.useCase.LoginUser(ctx, u)
+	client := &model.ClientInfo{
+		IP:        req.GetIp(),
+		UserAgent: req.GetUserAgent(),
+	}
+	ans, isNewDevice, err := handler.useCase.LoginUser(ctx, u, client)
 	if err != nil {
 		r.Base = base.BuildBaseResp(err)
 		return
 	}
 	r.Base = base.BuildBaseResp(err)
 	r.User = pack.BuildUser(ans)
+	r.IsNewDevice = isNewDevice
 	return
 }
 
@@ -88,3 +94,131 @@ func (handler *UserHandler) GetProfile(ctx context.Context, req *user.GetUserPro
 	r.UserProfileResp = pack.BuildGetUserProfileResponse(userProfile)
 	return
 }
+
+func (handler *UserHandler) ListSecurityEvents(ctx context.Context, req *user.ListSecurityEventsRequest) (r *user.ListSecurityEventsResponse, err error) {
+	r = new(user.ListSecurityEventsResponse)
+	events, total, err := handler.useCase.ListSecurityEvents(ctx, req.PageNum, req.PageSize)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	r.Events = pack.BuildSecurityEvents(events)
+	r.Total = total
+	return
+}
+
+func (handler *UserHandler) MintInviteCodes(ctx context.Context, req *user.MintInviteCodesRequest) (r *user.MintInviteCodesResponse, err error) {
+	r = new(user.MintInviteCodesResponse)
+	codes, err := handler.useCase.MintInviteCodes(ctx, &model.InviteMintRequest{
+		Count:         req.Count,
+		MaxUses:       req.MaxUses,
+		ExpireSeconds: req.ExpireSeconds,
+	})
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	r.Codes = pack.BuildInviteCodes(codes)
+	return
+}
+
+func (handler *UserHandler) ListInviteCodes(ctx context.Context, req *user.ListInviteCodesRequest) (r *user.ListInviteCodesResponse, err error) {
+	r = new(user.ListInviteCodesResponse)
+	codes, total, err := handler.useCase.ListInviteCodes(ctx, req.PageNum, req.PageSize)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	r.Codes = pack.BuildInviteCodes(codes)
+	r.Total = total
+	return
+}
+
+func (handler *UserHandler) CreateInviteCode(ctx context.Context, req *user.CreateInviteCodeRequest) (r *user.CreateInviteCodeResponse, err error) {
+	r = new(user.CreateInviteCodeResponse)
+	code, remaining, err := handler.useCase.CreateInviteCode(ctx)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	r.Code = pack.BuildInviteCode(code)
+	r.RemainingQuota = remaining
+	return
+}
+
+func (handler *UserHandler) GetPrivacySettings(ctx context.Context, req *user.GetPrivacySettingsRequest) (r *user.GetPrivacySettingsResponse, err error) {
+	r = new(user.GetPrivacySettingsResponse)
+	settings, err := handler.useCase.GetPrivacySettings(ctx)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	r.Settings = pack.BuildPrivacySettings(settings)
+	return
+}
+
+func (handler *UserHandler) UpdatePrivacySettings(ctx context.Context, req *user.UpdatePrivacySettingsRequest) (r *user.UpdatePrivacySettingsResponse, err error) {
+	r = new(user.UpdatePrivacySettingsResponse)
+	if req.Settings == nil {
+		r.Base = base.BuildBaseResp(errno.NewErrNo(errno.ParamVerifyErrorCode, "privacy settings should not be empty"))
+		return
+	}
+	settings, err := handler.useCase.UpdatePrivacySettings(ctx, &model.PrivacySettings{
+		ProfileVisibility: req.Settings.ProfileVisibility,
+		HideLikedVideos:   req.Settings.HideLikedVideos,
+		WhoCanMessage:     req.Settings.WhoCanMessage,
+		WhoCanFollow:      req.Settings.WhoCanFollow,
+	}, req.Settings.DisableSearchHistory)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	r.Settings = pack.BuildPrivacySettings(settings)
+	return
+}
+
+func (handler *UserHandler) CheckPrivacy(ctx context.Context, req *user.CheckPrivacyRequest) (r *user.CheckPrivacyResponse, err error) {
+	r = new(user.CheckPrivacyResponse)
+	allowed, err := handler.useCase.CheckPrivacy(ctx, req.ViewerId, req.OwnerId, req.Action)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	r.Allowed = allowed
+	return
+}
+
+func (handler *UserHandler) ListFollowers(ctx context.Context, req *user.ListFollowersRequest) (r *user.ListFollowersResponse, err error) {
+	r = new(user.ListFollowersResponse)
+	followers, next, total, err := handler.useCase.ListFollowers(ctx, req.UserId, req.GetCursor(), req.Limit)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	r.FollowerIds = followers
+	r.Total = total
+	if next != 0 {
+		r.NextCursor = &next
+	}
+	return
+}
+
+func (handler *UserHandler) ListFollowing(ctx context.Context, req *user.ListFollowingRequest) (r *user.ListFollowingResponse, err error) {
+	r = new(user.ListFollowingResponse)
+	following, err := handler.useCase.ListFollowing(ctx, req.UserId)
+	if err != nil {
+		r.Base = base.BuildBaseResp(err)
+		return
+	}
+	r.Base = base.BuildBaseResp(err)
+	r.FollowingIds = following
+	return
+}
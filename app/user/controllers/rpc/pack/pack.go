@@ -54,3 +54,54 @@ func BuildImage(image *dmodel.Image) *kmodel.Image {
 		ImageUrl: image.Url,
 	}
 }
+
+func BuildSecurityEvent(event *dmodel.SecurityEvent) *kmodel.SecurityEvent {
+	return &kmodel.SecurityEvent{
+		Id:          event.ID,
+		Action:      event.Action,
+		Ip:          event.IP,
+		UserAgent:   event.UserAgent,
+		IsNewDevice: event.IsNewDevice,
+		CreatedAt:   event.CreatedAt,
+	}
+}
+
+func BuildSecurityEvents(events []*dmodel.SecurityEvent) []*kmodel.SecurityEvent {
+	result := make([]*kmodel.SecurityEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, BuildSecurityEvent(event))
+	}
+	return result
+}
+
+func BuildInviteCode(code *dmodel.InviteCode) *kmodel.InviteCode {
+	return &kmodel.InviteCode{
+		Id:        code.ID,
+		Code:      code.Code,
+		CreatorId: code.CreatorID,
+		BatchId:   code.BatchID,
+		MaxUses:   code.MaxUses,
+		UsedCount: code.UsedCount,
+		ExpiresAt: code.ExpiresAt,
+		CreatedAt: code.CreatedAt,
+	}
+}
+
+func BuildInviteCodes(codes []*dmodel.InviteCode) []*kmodel.InviteCode {
+	result := make([]*kmodel.InviteCode, 0, len(codes))
+	for _, code := range codes {
+		result = append(result, BuildInviteCode(code))
+	}
+	return result
+}
+
+func BuildPrivacySettings(settings *dmodel.PrivacySettings) *kmodel.PrivacySettings {
+	return &kmodel.PrivacySettings{
+		ProfileVisibility: settings.ProfileVisibility,
+		HideLikedVideos:   settings.HideLikedVideos,
+		WhoCanMessage:     settings.WhoCanMessage,
+		WhoCanFollow:      settings.WhoCanFollow,
+
+		DisableSearchHistory: &settings.DisableSearchHistory,
+	}
+}
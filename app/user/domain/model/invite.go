@@ -0,0 +1,20 @@
+package model
+
+// InviteCode 是一条邀请码
+type InviteCode struct {
+	ID        int64  `json:"id"`
+	Code      string `json:"code"`
+	CreatorID int64  `json:"creator_id"` // 生成者
+	BatchID   int64  `json:"batch_id"`   // 批次ID，用户自己生成的为 0
+	MaxUses   int64  `json:"max_uses"`   // 最大可用次数
+	UsedCount int64  `json:"used_count"` // 已使用次数
+	ExpiresAt int64  `json:"expires_at"` // 过期时间，秒级时间戳，0 表示永不过期
+	CreatedAt int64  `json:"created_at"`
+}
+
+// InviteMintRequest 是管理员批量生成邀请码的参数
+type InviteMintRequest struct {
+	Count         int64 // 生成数量
+	MaxUses       int64 // 每个邀请码最大可用次数
+	ExpireSeconds int64 // 有效期，0 表示永不过期
+}
@@ -0,0 +1,12 @@
+package model
+
+// PrivacySettings 是用户的隐私设置，取值见 constants.ProfileVisibility* 和 constants.PrivacyAudience*
+type PrivacySettings struct {
+	Uid               int64  `json:"uid"`
+	ProfileVisibility string `json:"profile_visibility"` // 个人资料可见范围
+	HideLikedVideos   bool   `json:"hide_liked_videos"`  // 是否对他人隐藏点赞过的视频
+	WhoCanMessage     string `json:"who_can_message"`    // 谁可以给我发私信
+	WhoCanFollow      string `json:"who_can_follow"`     // 谁可以关注我
+
+	DisableSearchHistory bool `json:"disable_search_history"` // 不记录搜索历史
+}
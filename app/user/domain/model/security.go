@@ -0,0 +1,18 @@
+package model
+
+// ClientInfo 是发起请求的客户端信息，由 gateway 透传
+type ClientInfo struct {
+	IP        string `json:"ip"`
+	UserAgent string `json:"user_agent"`
+}
+
+// SecurityEvent 是一条安全相关的用户事件，如登录成功/失败、修改密码、吊销令牌、两步验证变更
+type SecurityEvent struct {
+	ID          int64  `json:"id"`
+	Uid         int64  `json:"uid"`
+	Action      string `json:"action"`        // 事件类型，见 constants.SecurityAction*
+	IP          string `json:"ip"`            // 来源 IP
+	UserAgent   string `json:"user_agent"`    // 来源 User-Agent
+	IsNewDevice bool   `json:"is_new_device"` // 是否来自从未见过的设备/IP 组合
+	CreatedAt   int64  `json:"created_at"`    // 发生时间，秒级时间戳
+}
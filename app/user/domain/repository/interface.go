@@ -10,10 +10,26 @@ type UserDB interface {
 	IsUserExist(ctx context.Context, username string) (bool, error)
 	CreateUser(ctx context.Context, u *model.User) (int64, error)
 	GetUserByName(ctx context.Context, name string) (*model.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
+	IsContactExist(ctx context.Context, email, phone string) (bool, error)
 	GetUserProfileInfoById(ctx context.Context, uid int64) (*model.UserProfileResponse, error)
 	GetUserById(ctx context.Context, uid int64) (*model.User, error)
 	StoreUserAvatar(ctx context.Context, image *model.Image) error
 	StoreUserProfile(ctx context.Context, userProfileRequest *model.UserProfileRequest, uid int64, image *model.Image) (*model.UserProfileResponse, error)
+	CreateSecurityEvent(ctx context.Context, event *model.SecurityEvent) error
+	ListSecurityEvents(ctx context.Context, uid int64, pageNum, pageSize int64) ([]*model.SecurityEvent, int64, error)
+	TouchLoginDevice(ctx context.Context, uid int64, deviceHash string, client *model.ClientInfo) (isNew bool, err error)
+	CreateUserWithInvite(ctx context.Context, u *model.User, code string) (int64, error)
+	CreateInviteCodes(ctx context.Context, codes []*model.InviteCode) error
+	ListInviteCodes(ctx context.Context, creatorID int64, pageNum, pageSize int64) ([]*model.InviteCode, int64, error)
+	CreateUserInviteCode(ctx context.Context, invite *model.InviteCode, quota int64) (remaining int64, err error)
+	GetMembershipLevel(ctx context.Context, uid int64) (string, error)
+	GetPrivacySettings(ctx context.Context, uid int64) (*model.PrivacySettings, error)
+	UpsertPrivacySettings(ctx context.Context, settings *model.PrivacySettings) error
+	IsFollowing(ctx context.Context, followerID, followeeID int64) (bool, error)
+	ListFollowers(ctx context.Context, uid, afterID int64, limit int) (followers []int64, lastID int64, err error)
+	CountFollowers(ctx context.Context, uid int64) (int64, error)
+	ListFollowing(ctx context.Context, uid int64) ([]int64, error)
 }
 
 type UserRedis interface{}
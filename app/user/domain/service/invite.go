@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/LingeringAutumn/Yijie/app/user/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// GenerateInviteCode 生成一个随机邀请码，使用 crypto/rand 避免被猜出来
+func (svc *UserService) GenerateInviteCode() (string, error) {
+	alphabet := constants.InviteCodeAlphabet
+	size := big.NewInt(int64(len(alphabet)))
+	code := make([]byte, constants.InviteCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, size)
+		if err != nil {
+			return "", errno.NewErrNo(errno.InternalServiceErrorCode, fmt.Sprintf("generate invite code failed: %v", err))
+		}
+		code[i] = alphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
+// InviteQuota 返回不同会员等级对应的邀请额度
+func (svc *UserService) InviteQuota(level string) int64 {
+	switch level {
+	case constants.MembershipLevelSilver:
+		return constants.InviteQuotaSilver
+	case constants.MembershipLevelGold:
+		return constants.InviteQuotaGold
+	case constants.MembershipLevelPlatinum:
+		return constants.InviteQuotaPlatinum
+	default:
+		return constants.InviteQuotaFree
+	}
+}
+
+// MintInviteCodes 批量生成邀请码，同一批的邀请码共用一个雪花 ID 作为批次号
+func (svc *UserService) MintInviteCodes(ctx context.Context, creatorID int64, req *model.InviteMintRequest) ([]*model.InviteCode, error) {
+	batchID, err := svc.sf.NextVal()
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalServiceErrorCode, fmt.Sprintf("generate invite batch id failed: %v", err))
+	}
+	var expiresAt int64 = constants.InviteCodeNeverExpires
+	if req.ExpireSeconds > 0 {
+		expiresAt = time.Now().Add(time.Duration(req.ExpireSeconds) * time.Second).Unix()
+	}
+	codes := make([]*model.InviteCode, 0, req.Count)
+	for i := int64(0); i < req.Count; i++ {
+		code, err := svc.GenerateInviteCode()
+		if err != nil {
+			return nil, err
+		}
+		codes = append(codes, &model.InviteCode{
+			Code:      code,
+			CreatorID: creatorID,
+			BatchID:   batchID,
+			MaxUses:   req.MaxUses,
+			ExpiresAt: expiresAt,
+		})
+	}
+	if err = svc.db.CreateInviteCodes(ctx, codes); err != nil {
+		return nil, fmt.Errorf("create invite codes failed: %w", err)
+	}
+	return codes, nil
+}
+
+// CreateUserInviteCode 用用户自己的邀请额度生成一个一次性邀请码，返回邀请码和剩余额度
+func (svc *UserService) CreateUserInviteCode(ctx context.Context, uid int64) (*model.InviteCode, int64, error) {
+	level, err := svc.db.GetMembershipLevel(ctx, uid)
+	if err != nil {
+		return nil, 0, fmt.Errorf("get membership level failed: %w", err)
+	}
+	code, err := svc.GenerateInviteCode()
+	if err != nil {
+		return nil, 0, err
+	}
+	invite := &model.InviteCode{
+		Code:      code,
+		CreatorID: uid,
+		BatchID:   constants.InviteCodeUserBatchID,
+		MaxUses:   constants.InviteCodeUserMaxUses,
+		ExpiresAt: constants.InviteCodeNeverExpires,
+	}
+	// 额度检查和插入放在同一个事务里，避免并发请求都通过检查
+	remaining, err := svc.db.CreateUserInviteCode(ctx, invite, svc.InviteQuota(level))
+	if err != nil {
+		return nil, 0, fmt.Errorf("create invite code failed: %w", err)
+	}
+	return invite, remaining, nil
+}
+
+func (svc *UserService) CreateUserWithInvite(ctx context.Context, u *model.User, code string) (int64, error) {
+	uid, err := svc.db.CreateUserWithInvite(ctx, u, code)
+	if err != nil {
+		return 0, fmt.Errorf("create user with invite failed: %w", err)
+	}
+	return uid, nil
+}
+
+func (svc *UserService) ListInviteCodes(ctx context.Context, creatorID int64, pageNum, pageSize int64) ([]*model.InviteCode, int64, error) {
+	codes, total, err := svc.db.ListInviteCodes(ctx, creatorID, pageNum, pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list invite codes failed: %w", err)
+	}
+	return codes, total, nil
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/LingeringAutumn/Yijie/app/user/domain/model"
+	"github.com/LingeringAutumn/Yijie/app/user/domain/repository"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// inviteDB 用互斥锁模拟 mysql 里对用户行加的写锁
+type inviteDB struct {
+	repository.UserDB
+	mu    sync.Mutex
+	level string
+	codes map[int64][]*model.InviteCode
+}
+
+func (db *inviteDB) GetMembershipLevel(ctx context.Context, uid int64) (string, error) {
+	return db.level, nil
+}
+
+func (db *inviteDB) CreateUserInviteCode(ctx context.Context, invite *model.InviteCode, quota int64) (int64, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	used := int64(len(db.codes[invite.CreatorID]))
+	if used >= quota {
+		return 0, errno.NewErrNo(errno.ServiceInviteQuotaExceeded, "invite quota exceeded")
+	}
+	db.codes[invite.CreatorID] = append(db.codes[invite.CreatorID], invite)
+	return quota - used - 1, nil
+}
+
+func TestUserService_CreateUserInviteCode(t *testing.T) {
+	convey.Convey("CreateUserInviteCode", t, func() {
+		ctx := context.Background()
+		const uid = 1
+		db := &inviteDB{level: constants.MembershipLevelSilver, codes: map[int64][]*model.InviteCode{}}
+		svc := &UserService{db: db}
+
+		convey.Convey("concurrent requests never exceed the quota", func() {
+			const workers = 20
+			var (
+				wg        sync.WaitGroup
+				mu        sync.Mutex
+				succeeded int
+				exceeded  int
+				remaining []int64
+			)
+			for i := 0; i < workers; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, left, err := svc.CreateUserInviteCode(ctx, uid)
+					mu.Lock()
+					defer mu.Unlock()
+					switch {
+					case err == nil:
+						succeeded++
+						remaining = append(remaining, left)
+					case errno.ConvertErr(err).ErrorCode == errno.ServiceInviteQuotaExceeded:
+						exceeded++
+					}
+				}()
+			}
+			wg.Wait()
+
+			convey.So(succeeded, convey.ShouldEqual, constants.InviteQuotaSilver)
+			convey.So(exceeded, convey.ShouldEqual, workers-constants.InviteQuotaSilver)
+			convey.So(db.codes[uid], convey.ShouldHaveLength, constants.InviteQuotaSilver)
+			convey.So(remaining, convey.ShouldContain, int64(0))
+		})
+
+		convey.Convey("user codes are single use and never expire", func() {
+			code, left, err := svc.CreateUserInviteCode(ctx, uid)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(left, convey.ShouldEqual, constants.InviteQuotaSilver-1)
+			convey.So(code.Code, convey.ShouldHaveLength, constants.InviteCodeLength)
+			convey.So(code.BatchID, convey.ShouldEqual, constants.InviteCodeUserBatchID)
+			convey.So(code.MaxUses, convey.ShouldEqual, constants.InviteCodeUserMaxUses)
+			convey.So(code.ExpiresAt, convey.ShouldEqual, constants.InviteCodeNeverExpires)
+		})
+	})
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LingeringAutumn/Yijie/app/user/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+func (svc *UserService) GetPrivacySettings(ctx context.Context, uid int64) (*model.PrivacySettings, error) {
+	settings, err := svc.db.GetPrivacySettings(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("get privacy settings failed: %w", err)
+	}
+	return settings, nil
+}
+
+// UpdatePrivacySettings 保存隐私设置，disableSearchHistory 为 nil 时保留原来的设置，兼容不传这个字段的旧客户端
+func (svc *UserService) UpdatePrivacySettings(ctx context.Context, settings *model.PrivacySettings, disableSearchHistory *bool) error {
+	if disableSearchHistory != nil {
+		settings.DisableSearchHistory = *disableSearchHistory
+	} else {
+		current, err := svc.GetPrivacySettings(ctx, settings.Uid)
+		if err != nil {
+			return err
+		}
+		settings.DisableSearchHistory = current.DisableSearchHistory
+	}
+	if err := svc.db.UpsertPrivacySettings(ctx, settings); err != nil {
+		return fmt.Errorf("update privacy settings failed: %w", err)
+	}
+	return nil
+}
+
+// ValidatePrivacySettings 检查各个设置项的取值是否合法
+func (svc *UserService) ValidatePrivacySettings(settings *model.PrivacySettings) error {
+	switch settings.ProfileVisibility {
+	case constants.ProfileVisibilityPublic, constants.ProfileVisibilityFollowers, constants.ProfileVisibilityPrivate:
+	default:
+		return errno.Errorf(errno.ParamVerifyErrorCode, "invalid profile visibility: %s", settings.ProfileVisibility)
+	}
+	switch settings.WhoCanMessage {
+	case constants.PrivacyAudienceEveryone, constants.PrivacyAudienceFollowers, constants.PrivacyAudienceNobody:
+	default:
+		return errno.Errorf(errno.ParamVerifyErrorCode, "invalid who can message: %s", settings.WhoCanMessage)
+	}
+	switch settings.WhoCanFollow {
+	case constants.PrivacyAudienceEveryone, constants.PrivacyAudienceNobody:
+	default:
+		return errno.Errorf(errno.ParamVerifyErrorCode, "invalid who can follow: %s", settings.WhoCanFollow)
+	}
+	return nil
+}
+
+// CheckPrivacy 判断 viewer 能否对 owner 执行 action，viewer 为 0 表示未登录用户
+// 本人总是有权限，其余情况按 owner 的隐私设置判断；记录搜索历史只针对本人，按本人的设置判断
+// 仅粉丝可见的视频由作者单独设置，只看 viewer 是否关注了 owner
+func (svc *UserService) CheckPrivacy(ctx context.Context, viewer, owner int64, action string) (bool, error) {
+	if action == constants.PrivacyActionRecordSearch {
+		if viewer == 0 || viewer != owner {
+			return false, nil
+		}
+		settings, err := svc.GetPrivacySettings(ctx, owner)
+		if err != nil {
+			return false, err
+		}
+		return !settings.DisableSearchHistory, nil
+	}
+	if viewer != 0 && viewer == owner {
+		return true, nil
+	}
+	settings, err := svc.GetPrivacySettings(ctx, owner)
+	if err != nil {
+		return false, err
+	}
+
+	var audience string
+	switch action {
+	case constants.PrivacyActionViewProfile:
+		audience = profileAudience(settings.ProfileVisibility)
+	case constants.PrivacyActionViewLikes:
+		if settings.HideLikedVideos {
+			return false, nil
+		}
+		// 点赞列表至少要能看到个人资料
+		audience = profileAudience(settings.ProfileVisibility)
+	case constants.PrivacyActionMessage:
+		audience = settings.WhoCanMessage
+	case constants.PrivacyActionFollow:
+		audience = settings.WhoCanFollow
+	case constants.PrivacyActionViewFollowed:
+		audience = constants.PrivacyAudienceFollowers
+	default:
+		return false, errno.Errorf(errno.ParamVerifyErrorCode, "unknown privacy action: %s", action)
+	}
+	return svc.allowAudience(ctx, viewer, owner, audience)
+}
+
+func (svc *UserService) allowAudience(ctx context.Context, viewer, owner int64, audience string) (bool, error) {
+	switch audience {
+	case constants.PrivacyAudienceEveryone:
+		return true, nil
+	case constants.PrivacyAudienceFollowers:
+		if viewer == 0 {
+			return false, nil
+		}
+		following, err := svc.db.IsFollowing(ctx, viewer, owner)
+		if err != nil {
+			return false, fmt.Errorf("check following failed: %w", err)
+		}
+		return following, nil
+	default:
+		return false, nil
+	}
+}
+
+// profileAudience 把个人资料的可见范围映射成统一的受众取值
+func profileAudience(visibility string) string {
+	switch visibility {
+	case constants.ProfileVisibilityPublic:
+		return constants.PrivacyAudienceEveryone
+	case constants.ProfileVisibilityFollowers:
+		return constants.PrivacyAudienceFollowers
+	default:
+		return constants.PrivacyAudienceNobody
+	}
+}
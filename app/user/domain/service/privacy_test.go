@@ -0,0 +1,125 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/LingeringAutumn/Yijie/app/user/domain/model"
+	"github.com/LingeringAutumn/Yijie/app/user/domain/repository"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+)
+
+// privacyDB 只实现隐私设置的读写，其他方法不会被调用
+type privacyDB struct {
+	repository.UserDB
+	settings map[int64]*model.PrivacySettings
+	follows  map[[2]int64]bool // {follower, followee}
+}
+
+func (db *privacyDB) GetPrivacySettings(ctx context.Context, uid int64) (*model.PrivacySettings, error) {
+	if s, ok := db.settings[uid]; ok {
+		copied := *s
+		return &copied, nil
+	}
+	return &model.PrivacySettings{Uid: uid, ProfileVisibility: constants.ProfileVisibilityPublic}, nil
+}
+
+func (db *privacyDB) UpsertPrivacySettings(ctx context.Context, settings *model.PrivacySettings) error {
+	copied := *settings
+	db.settings[settings.Uid] = &copied
+	return nil
+}
+
+func (db *privacyDB) IsFollowing(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	return db.follows[[2]int64{followerID, followeeID}], nil
+}
+
+func TestUserService_CheckPrivacy(t *testing.T) {
+	convey.Convey("CheckPrivacy", t, func() {
+		ctx := context.Background()
+		const owner, follower, stranger = 1, 2, 3
+		db := &privacyDB{
+			settings: map[int64]*model.PrivacySettings{},
+			follows:  map[[2]int64]bool{{follower, owner}: true},
+		}
+		svc := &UserService{db: db}
+
+		tests := []struct {
+			name     string
+			settings model.PrivacySettings
+			viewer   int64
+			action   string
+			expected bool
+		}{
+			{"public profile is visible to guests", model.PrivacySettings{ProfileVisibility: constants.ProfileVisibilityPublic}, 0, constants.PrivacyActionViewProfile, true},
+			{"followers profile hidden from strangers", model.PrivacySettings{ProfileVisibility: constants.ProfileVisibilityFollowers}, stranger, constants.PrivacyActionViewProfile, false},
+			{"followers profile visible to followers", model.PrivacySettings{ProfileVisibility: constants.ProfileVisibilityFollowers}, follower, constants.PrivacyActionViewProfile, true},
+			{"followers profile hidden from guests", model.PrivacySettings{ProfileVisibility: constants.ProfileVisibilityFollowers}, 0, constants.PrivacyActionViewProfile, false},
+			{"private profile hidden from followers", model.PrivacySettings{ProfileVisibility: constants.ProfileVisibilityPrivate}, follower, constants.PrivacyActionViewProfile, false},
+			{"owner always sees private profile", model.PrivacySettings{ProfileVisibility: constants.ProfileVisibilityPrivate}, owner, constants.PrivacyActionViewProfile, true},
+			{"hidden likes override public profile", model.PrivacySettings{ProfileVisibility: constants.ProfileVisibilityPublic, HideLikedVideos: true}, follower, constants.PrivacyActionViewLikes, false},
+			{"likes follow profile visibility", model.PrivacySettings{ProfileVisibility: constants.ProfileVisibilityPrivate}, follower, constants.PrivacyActionViewLikes, false},
+			{"message from followers only", model.PrivacySettings{WhoCanMessage: constants.PrivacyAudienceFollowers}, stranger, constants.PrivacyActionMessage, false},
+			{"nobody can follow", model.PrivacySettings{WhoCanFollow: constants.PrivacyAudienceNobody}, stranger, constants.PrivacyActionFollow, false},
+			{"everyone can follow", model.PrivacySettings{WhoCanFollow: constants.PrivacyAudienceEveryone}, stranger, constants.PrivacyActionFollow, true},
+			{"followers-only videos need a follow", model.PrivacySettings{ProfileVisibility: constants.ProfileVisibilityPublic}, stranger, constants.PrivacyActionViewFollowed, false},
+			{"followers-only videos visible to followers", model.PrivacySettings{ProfileVisibility: constants.ProfileVisibilityPrivate}, follower, constants.PrivacyActionViewFollowed, true},
+			{"search history recorded for owner", model.PrivacySettings{}, owner, constants.PrivacyActionRecordSearch, true},
+			{"search history opt-out", model.PrivacySettings{DisableSearchHistory: true}, owner, constants.PrivacyActionRecordSearch, false},
+			{"search history never recorded for others", model.PrivacySettings{}, stranger, constants.PrivacyActionRecordSearch, false},
+		}
+		for _, tt := range tests {
+			convey.Convey(tt.name, func() {
+				settings := tt.settings
+				settings.Uid = owner
+				db.settings[owner] = &settings
+				ok, err := svc.CheckPrivacy(ctx, tt.viewer, owner, tt.action)
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(ok, convey.ShouldEqual, tt.expected)
+			})
+		}
+
+		convey.Convey("unknown action", func() {
+			_, err := svc.CheckPrivacy(ctx, stranger, owner, "unknown")
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
+
+func TestUserService_UpdatePrivacySettings(t *testing.T) {
+	convey.Convey("UpdatePrivacySettings", t, func() {
+		ctx := context.Background()
+		db := &privacyDB{settings: map[int64]*model.PrivacySettings{
+			1: {Uid: 1, ProfileVisibility: constants.ProfileVisibilityPublic, DisableSearchHistory: true},
+		}}
+		svc := &UserService{db: db}
+		update := func() *model.PrivacySettings {
+			return &model.PrivacySettings{
+				Uid:               1,
+				ProfileVisibility: constants.ProfileVisibilityPrivate,
+				WhoCanMessage:     constants.PrivacyAudienceEveryone,
+				WhoCanFollow:      constants.PrivacyAudienceEveryone,
+			}
+		}
+
+		tests := []struct {
+			name                 string
+			disableSearchHistory *bool
+			expected             bool
+		}{
+			{"omitted keeps the current value", nil, true},
+			{"explicit false turns history back on", new(bool), false},
+		}
+		for _, tt := range tests {
+			convey.Convey(tt.name, func() {
+				settings := update()
+				convey.So(svc.UpdatePrivacySettings(ctx, settings, tt.disableSearchHistory), convey.ShouldBeNil)
+				convey.So(settings.DisableSearchHistory, convey.ShouldEqual, tt.expected)
+				convey.So(db.settings[1].DisableSearchHistory, convey.ShouldEqual, tt.expected)
+				convey.So(db.settings[1].ProfileVisibility, convey.ShouldEqual, constants.ProfileVisibilityPrivate)
+			})
+		}
+	})
+}
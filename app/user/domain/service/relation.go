@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// ListFollowers 列出 uid 在 cursor 之后的一页粉丝，返回粉丝 ID、下一页的游标和粉丝总数，没有更多粉丝时游标为 0
+// 只在第一页统计粉丝总数
+func (svc *UserService) ListFollowers(ctx context.Context, uid, cursor, limit int64) ([]int64, int64, int64, error) {
+	if limit <= 0 {
+		return nil, 0, 0, errno.ParamVerifyError.WithMessage("limit should be positive")
+	}
+	if limit > constants.FollowerListMaxLimit {
+		limit = constants.FollowerListMaxLimit
+	}
+	var total int64
+	if cursor == 0 {
+		count, err := svc.db.CountFollowers(ctx, uid)
+		if err != nil {
+			return nil, 0, 0, fmt.Errorf("count followers failed: %w", err)
+		}
+		total = count
+	}
+	followers, last, err := svc.db.ListFollowers(ctx, uid, cursor, int(limit))
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("list followers failed: %w", err)
+	}
+	if int64(len(followers)) < limit {
+		last = 0
+	}
+	return followers, last, total, nil
+}
+
+func (svc *UserService) ListFollowing(ctx context.Context, uid int64) ([]int64, error) {
+	following, err := svc.db.ListFollowing(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("list following failed: %w", err)
+	}
+	return following, nil
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/LingeringAutumn/Yijie/app/user/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+)
+
+// DeviceFingerprint 根据 User-Agent 和 IP 计算设备指纹，同一个 UA 换了 IP 也视为新的组合
+func (svc *UserService) DeviceFingerprint(client *model.ClientInfo) string {
+	sum := sha256.Sum256([]byte(client.UserAgent + "|" + client.IP))
+	return hex.EncodeToString(sum[:])
+}
+
+// DetectNewDevice 记录本次登录的设备，返回该设备/IP 组合是否是第一次出现
+// 指纹使用完整的 UA 和 IP，写入数据库时按列宽截断
+func (svc *UserService) DetectNewDevice(ctx context.Context, uid int64, client *model.ClientInfo) (bool, error) {
+	isNew, err := svc.db.TouchLoginDevice(ctx, uid, svc.DeviceFingerprint(client), truncateClient(client))
+	if err != nil {
+		return false, fmt.Errorf("detect new device failed: %w", err)
+	}
+	return isNew, nil
+}
+
+// RecordSecurityEvent 写入一条安全事件
+// 安全事件属于旁路记录，写入失败只打日志，不影响主流程，所以这里不返回 error
+func (svc *UserService) RecordSecurityEvent(ctx context.Context, uid int64, action string, client *model.ClientInfo, isNewDevice bool) {
+	event := &model.SecurityEvent{
+		Uid:         uid,
+		Action:      action,
+		IsNewDevice: isNewDevice,
+	}
+	if client != nil {
+		client = truncateClient(client)
+		event.IP = client.IP
+		event.UserAgent = client.UserAgent
+	}
+	if err := svc.db.CreateSecurityEvent(ctx, event); err != nil {
+		logger.Errorf("record security event %s for user %d failed: %v", action, uid, err)
+	}
+}
+
+func (svc *UserService) ListSecurityEvents(ctx context.Context, uid int64, pageNum, pageSize int64) ([]*model.SecurityEvent, int64, error) {
+	events, total, err := svc.db.ListSecurityEvents(ctx, uid, pageNum, pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list security events failed: %w", err)
+	}
+	return events, total, nil
+}
+
+// truncateClient 按列宽截断 UA 和 IP，请求头可能超长
+func truncateClient(client *model.ClientInfo) *model.ClientInfo {
+	truncated := *client
+	truncated.UserAgent = truncate(client.UserAgent, constants.UserAgentMaxLength)
+	truncated.IP = truncate(client.IP, constants.ClientIPMaxLength)
+	return &truncated
+}
+
+// truncate 最多保留 n 个字符，列宽按字符计算，不能截断在多字节字符的中间
+func truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/LingeringAutumn/Yijie/app/user/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+)
+
+func TestTruncateClient(t *testing.T) {
+	convey.Convey("truncateClient", t, func() {
+		convey.Convey("short values are kept", func() {
+			client := &model.ClientInfo{IP: "127.0.0.1", UserAgent: "Mozilla/5.0"}
+			convey.So(truncateClient(client), convey.ShouldResemble, client)
+		})
+
+		convey.Convey("oversized values are cut to the column width", func() {
+			client := &model.ClientInfo{IP: strings.Repeat("1", 100), UserAgent: strings.Repeat("a", 1000)}
+			truncated := truncateClient(client)
+			convey.So(len(truncated.IP), convey.ShouldEqual, constants.ClientIPMaxLength)
+			convey.So(len(truncated.UserAgent), convey.ShouldEqual, constants.UserAgentMaxLength)
+			convey.So(len(client.UserAgent), convey.ShouldEqual, 1000)
+		})
+
+		convey.Convey("multi-byte characters are not split", func() {
+			ua := strings.Repeat("设", constants.UserAgentMaxLength+1)
+			truncated := truncateClient(&model.ClientInfo{UserAgent: ua})
+			convey.So(utf8.ValidString(truncated.UserAgent), convey.ShouldBeTrue)
+			convey.So(utf8.RuneCountInString(truncated.UserAgent), convey.ShouldEqual, constants.UserAgentMaxLength)
+		})
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 
 	"golang.org/x/crypto/bcrypt"
 
@@ -65,6 +66,31 @@ func (svc *UserService) GetUserProfileInfoById(ctx context.Context, uid int64) (
 	return userInfo, nil
 }
 
+// GetUserByAccount 通过用户名登录，找不到且看起来像邮箱时再按邮箱查询
+func (svc *UserService) GetUserByAccount(ctx context.Context, account string) (*model.User, error) {
+	u, err := svc.db.GetUserByName(ctx, account)
+	if err == nil {
+		return u, nil
+	}
+	if !strings.Contains(account, "@") || errno.ConvertErr(err).ErrorCode != errno.ServiceUserNotExist {
+		return nil, fmt.Errorf("get user failed: %w", err)
+	}
+	u, err = svc.db.GetUserByEmail(ctx, account)
+	if err != nil {
+		return nil, fmt.Errorf("get user by email failed: %w", err)
+	}
+	return u, nil
+}
+
+// IsContactExist 检查邮箱或手机号是否已被注册，库里存的是密文，这里实际比较的是盲索引
+func (svc *UserService) IsContactExist(ctx context.Context, email, phone string) (bool, error) {
+	exist, err := svc.db.IsContactExist(ctx, email, phone)
+	if err != nil {
+		return false, fmt.Errorf("check contact exist failed: %w", err)
+	}
+	return exist, nil
+}
+
 func (svc *UserService) IsUserExist(ctx context.Context, username string) (bool, error) {
 	exist, err := svc.db.IsUserExist(ctx, username)
 	if err != nil {
@@ -10,27 +10,35 @@ import (
 	"github.com/LingeringAutumn/Yijie/app/user/domain/repository"
 	"github.com/LingeringAutumn/Yijie/pkg/constants"
 	"github.com/LingeringAutumn/Yijie/pkg/errno"
+	"github.com/LingeringAutumn/Yijie/pkg/utils"
 )
 
 // userDB impl domain.UserDB defined domain
 type userDB struct {
 	client *gorm.DB
+	pii    *utils.PIICipher // 邮箱、手机号落库前加密
 }
 
-func NewUserDB(client *gorm.DB) repository.UserDB {
-	return &userDB{client: client}
+func NewUserDB(client *gorm.DB, pii *utils.PIICipher) repository.UserDB {
+	return &userDB{client: client, pii: pii}
 }
 
 func (db *userDB) CreateUser(ctx context.Context, u *model.User) (int64, error) {
+	c, err := db.encryptContact(u.Email, u.Phone)
+	if err != nil {
+		return -1, err
+	}
 	// 将 entity 转换成 mysql 这边的 model
 	user := User{
-		Username: u.Username,
-		Password: u.Password,
-		Email:    u.Email,
-		Phone:    u.Phone,
+		Username:  u.Username,
+		Password:  u.Password,
+		Email:     c.Email,
+		Phone:     c.Phone,
+		EmailBidx: c.EmailBidx,
+		PhoneBidx: c.PhoneBidx,
 	}
 	// TODO 我不确定我们是否要主动生成雪花ID
-	if err := db.client.Create(&user).Error; err != nil {
+	if err = db.client.Create(&user).Error; err != nil {
 		return -1, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to create user: %v", err)
 	}
 	return user.Uid, nil
@@ -75,14 +83,49 @@ func (db *userDB) GetUserByName(ctx context.Context, name string) (*model.User,
 		}
 		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query user: %v", err)
 	}
-	resp := &model.User{
+	return db.buildUser(&user)
+}
+
+// GetUserByEmail 通过邮箱盲索引查询用户，邮箱密文本身无法直接比较
+func (db *userDB) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	var user User
+	bidx := db.pii.BlindIndex(constants.PIIFieldEmail, email)
+	err := db.client.WithContext(ctx).Table(constants.UserTableName).Where("email_bidx = ?", bidx).First(&user).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.Errorf(errno.ServiceUserNotExist, "mysql: user with email %s not exist", email)
+		}
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query user: %v", err)
+	}
+	return db.buildUser(&user)
+}
+
+// IsContactExist 检查邮箱或手机号是否已经被注册
+func (db *userDB) IsContactExist(ctx context.Context, email, phone string) (bool, error) {
+	query := db.client.WithContext(ctx).Table(constants.UserTableName).
+		Where("email_bidx = ?", db.pii.BlindIndex(constants.PIIFieldEmail, email))
+	if phoneBidx := db.pii.BlindIndex(constants.PIIFieldPhone, phone); phoneBidx != "" {
+		query = query.Or("phone_bidx = ?", phoneBidx)
+	}
+	var count int64
+	if err := query.Count(&count).Error; err != nil {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query user contact: %v", err)
+	}
+	return count > 0, nil
+}
+
+func (db *userDB) buildUser(user *User) (*model.User, error) {
+	email, phone, err := db.decryptContact(user.Email, user.Phone)
+	if err != nil {
+		return nil, err
+	}
+	return &model.User{
 		Uid:      user.Uid,
 		Username: user.Username,
 		Password: user.Password,
-		Email:    user.Email,
-		Phone:    user.Phone,
-	}
-	return resp, nil
+		Email:    email,
+		Phone:    phone,
+	}, nil
 }
 
 
@@ -95,11 +138,15 @@ func (db *userDB) GetUserProfileInfoById(ctx context.Context, uid int64) (*model
 		}
 		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query user: %v", err)
 	}
+	email, phone, err := db.decryptContact(userProfileResp.Email, userProfileResp.Phone)
+	if err != nil {
+		return nil, err
+	}
 	resp := &model.UserProfileResponse{
 		Uid:             userProfileResp.Uid,
 		Username:        userProfileResp.Username,
-		Email:           userProfileResp.Email,
-		Phone:           userProfileResp.Phone,
+		Email:           email,
+		Phone:           phone,
 		Avatar:          userProfileResp.Avatar,
 		Bio:             userProfileResp.Bio,
 		MembershipLevel: userProfileResp.MembershipLevel,
@@ -170,7 +217,13 @@ func (db *userDB) StoreUserProfile(ctx context.Context, userProfileRequest *mode
 			return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to convert user profile: %v", err)
 		}
 		r.Uid = uid
-		err = db.client.WithContext(ctx).Table(constants.UserTableName).Create(r).Error
+		c, err := db.encryptContact(r.Email, r.Phone)
+		if err != nil {
+			return nil, err
+		}
+		row := *r
+		row.Email, row.Phone, row.EmailBidx, row.PhoneBidx = c.Email, c.Phone, c.EmailBidx, c.PhoneBidx
+		err = db.client.WithContext(ctx).Table(constants.UserTableName).Create(&row).Error
 		if err != nil {
 			return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to create user profile: %v", err)
 		}
@@ -200,7 +253,13 @@ func (db *userDB) StoreUserProfile(ctx context.Context, userProfileRequest *mode
 	userProfileResponse.MembershipLevel = constants.MembershipLevelFreeCode
 	userProfileResponse.Point = 0
 	userProfileResponse.Team = ""
-	err = db.client.WithContext(ctx).Table(constants.UserTableName).Where("id = ?", uid).Updates(userProfileResponse).Error
+	c, err := db.encryptContact(userProfileRequest.Email, userProfileRequest.Phone)
+	if err != nil {
+		return nil, err
+	}
+	row := userProfileResponse
+	row.Email, row.Phone, row.EmailBidx, row.PhoneBidx = c.Email, c.Phone, c.EmailBidx, c.PhoneBidx
+	err = db.client.WithContext(ctx).Table(constants.UserTableName).Where("id = ?", uid).Updates(row).Error
 	if err != nil {
 		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to update user profile: %v", err)
 	}
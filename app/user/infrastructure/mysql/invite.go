@@ -0,0 +1,199 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/LingeringAutumn/Yijie/app/user/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// CreateUserWithInvite 在一个事务里核销邀请码、创建用户并记录邀请关系
+// 邀请码行会被加锁，保证并发注册时使用次数不会超过上限
+func (db *userDB) CreateUserWithInvite(ctx context.Context, u *model.User, code string) (int64, error) {
+	var uid int64
+	err := db.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var invite InviteCode
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("code = ?", code).
+			First(&invite).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errno.NewErrNo(errno.ServiceInviteCodeInvalid, "invite code not exist")
+			}
+			return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query invite code: %v", err)
+		}
+		if invite.ExpiresAt != nil && invite.ExpiresAt.Before(time.Now()) {
+			return errno.NewErrNo(errno.ServiceInviteCodeInvalid, "invite code expired")
+		}
+		if invite.UsedCount >= invite.MaxUses {
+			return errno.NewErrNo(errno.ServiceInviteCodeInvalid, "invite code has been used up")
+		}
+
+		c, err := db.encryptContact(u.Email, u.Phone)
+		if err != nil {
+			return err
+		}
+		user := User{
+			Username:  u.Username,
+			Password:  u.Password,
+			Email:     c.Email,
+			Phone:     c.Phone,
+			EmailBidx: c.EmailBidx,
+			PhoneBidx: c.PhoneBidx,
+		}
+		if err = tx.Create(&user).Error; err != nil {
+			return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to create user: %v", err)
+		}
+		err = tx.Model(&InviteCode{}).Where("id = ?", invite.ID).
+			Update("used_count", gorm.Expr("used_count + 1")).Error
+		if err != nil {
+			return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to consume invite code: %v", err)
+		}
+		record := InviteRecord{
+			CodeID:    invite.ID,
+			InviterID: invite.CreatorID,
+			InviteeID: user.Uid,
+		}
+		if err = tx.Create(&record).Error; err != nil {
+			return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to create invite record: %v", err)
+		}
+		uid = user.Uid
+		return nil
+	})
+	if err != nil {
+		return -1, err
+	}
+	return uid, nil
+}
+
+func (db *userDB) CreateInviteCodes(ctx context.Context, codes []*model.InviteCode) error {
+	rows := make([]*InviteCode, 0, len(codes))
+	for _, c := range codes {
+		rows = append(rows, inviteCodeRow(c))
+	}
+	if err := db.client.WithContext(ctx).Create(&rows).Error; err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to create invite codes: %v", err)
+	}
+	for i, row := range rows {
+		codes[i].ID = row.ID
+		codes[i].CreatedAt = row.CreatedAt.Unix()
+	}
+	return nil
+}
+
+// CreateUserInviteCode 在一个事务里检查用户的邀请额度并插入邀请码，返回插入后剩余的额度
+// 用户行会被加锁，同一个用户并发生成邀请码时会排队执行，不会超出额度
+func (db *userDB) CreateUserInviteCode(ctx context.Context, invite *model.InviteCode, quota int64) (int64, error) {
+	var remaining int64
+	err := db.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var user User
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id").
+			Where("id = ?", invite.CreatorID).
+			First(&user).Error
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errno.NewErrNo(errno.ServiceUserNotExist, "user not exist")
+			}
+			return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to lock user: %v", err)
+		}
+		var used int64
+		err = tx.Model(&InviteCode{}).
+			Where("creator_id = ? AND batch_id = ?", invite.CreatorID, constants.InviteCodeUserBatchID).
+			Count(&used).Error
+		if err != nil {
+			return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to count invite codes: %v", err)
+		}
+		if used >= quota {
+			return errno.NewErrNo(errno.ServiceInviteQuotaExceeded, "invite quota exceeded")
+		}
+		row := inviteCodeRow(invite)
+		if err = tx.Create(row).Error; err != nil {
+			return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to create invite code: %v", err)
+		}
+		invite.ID = row.ID
+		invite.CreatedAt = row.CreatedAt.Unix()
+		remaining = quota - used - 1
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return remaining, nil
+}
+
+func inviteCodeRow(c *model.InviteCode) *InviteCode {
+	row := &InviteCode{
+		Code:      c.Code,
+		CreatorID: c.CreatorID,
+		BatchID:   c.BatchID,
+		MaxUses:   c.MaxUses,
+	}
+	if c.ExpiresAt != constants.InviteCodeNeverExpires {
+		expiresAt := time.Unix(c.ExpiresAt, 0)
+		row.ExpiresAt = &expiresAt
+	}
+	return row
+}
+
+// ListInviteCodes 分页查询邀请码，creatorID 为 0 时查询全部
+func (db *userDB) ListInviteCodes(ctx context.Context, creatorID int64, pageNum, pageSize int64) ([]*model.InviteCode, int64, error) {
+	var (
+		rows  []InviteCode
+		total int64
+	)
+	query := db.client.WithContext(ctx).Model(&InviteCode{})
+	if creatorID != 0 {
+		query = query.Where("creator_id = ?", creatorID)
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to count invite codes: %v", err)
+	}
+	err := query.Order("id DESC").
+		Offset(int((pageNum - 1) * pageSize)).
+		Limit(int(pageSize)).
+		Find(&rows).Error
+	if err != nil {
+		return nil, 0, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to list invite codes: %v", err)
+	}
+
+	codes := make([]*model.InviteCode, 0, len(rows))
+	for _, row := range rows {
+		c := &model.InviteCode{
+			ID:        row.ID,
+			Code:      row.Code,
+			CreatorID: row.CreatorID,
+			BatchID:   row.BatchID,
+			MaxUses:   row.MaxUses,
+			UsedCount: row.UsedCount,
+			CreatedAt: row.CreatedAt.Unix(),
+		}
+		if row.ExpiresAt != nil {
+			c.ExpiresAt = row.ExpiresAt.Unix()
+		}
+		codes = append(codes, c)
+	}
+	return codes, total, nil
+}
+
+// GetMembershipLevel 查询用户当前有效的会员等级，没有会员记录时视为 free
+func (db *userDB) GetMembershipLevel(ctx context.Context, uid int64) (string, error) {
+	var membership Membership
+	err := db.client.WithContext(ctx).
+		Where("user_id = ? AND status = ?", uid, constants.MembershipStatusActive).
+		Order("id DESC").
+		First(&membership).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return constants.MembershipLevelFree, nil
+		}
+		return "", errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query membership: %v", err)
+	}
+	return membership.MembershipLevel, nil
+}
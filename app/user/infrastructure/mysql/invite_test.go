@@ -0,0 +1,71 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/smartystreets/goconvey/convey"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"github.com/LingeringAutumn/Yijie/app/user/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+const (
+	lockUserSQL         = "SELECT `id` FROM `users` WHERE id = \\? .* FOR UPDATE"
+	countInviteCodesSQL = "SELECT count\\(\\*\\) FROM `invite_codes` WHERE creator_id = \\? AND batch_id = \\?"
+	insertInviteCodeSQL = "INSERT INTO `invite_codes`"
+)
+
+func TestUserDB_CreateUserInviteCode(t *testing.T) {
+	convey.Convey("CreateUserInviteCode", t, func() {
+		sqlDB, mock, err := sqlmock.New()
+		convey.So(err, convey.ShouldBeNil)
+		defer sqlDB.Close()
+		client, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}),
+			&gorm.Config{SkipDefaultTransaction: true})
+		convey.So(err, convey.ShouldBeNil)
+		db := &userDB{client: client}
+
+		const uid, quota = 7, 3
+		invite := &model.InviteCode{
+			Code:      "ABCDEFGH",
+			CreatorID: uid,
+			BatchID:   constants.InviteCodeUserBatchID,
+			MaxUses:   constants.InviteCodeUserMaxUses,
+			ExpiresAt: constants.InviteCodeNeverExpires,
+		}
+
+		convey.Convey("the user row is locked before counting and inserting", func() {
+			mock.ExpectBegin()
+			mock.ExpectQuery(lockUserSQL).WithArgs(uid, 1).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uid))
+			mock.ExpectQuery(countInviteCodesSQL).WithArgs(uid, constants.InviteCodeUserBatchID).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+			mock.ExpectExec(insertInviteCodeSQL).WillReturnResult(sqlmock.NewResult(42, 1))
+			mock.ExpectCommit()
+
+			remaining, err := db.CreateUserInviteCode(context.Background(), invite, quota)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(remaining, convey.ShouldEqual, quota-2)
+			convey.So(invite.ID, convey.ShouldEqual, 42)
+			convey.So(mock.ExpectationsWereMet(), convey.ShouldBeNil)
+		})
+
+		convey.Convey("nothing is inserted once the quota is used up", func() {
+			mock.ExpectBegin()
+			mock.ExpectQuery(lockUserSQL).WithArgs(uid, 1).
+				WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(uid))
+			mock.ExpectQuery(countInviteCodesSQL).WithArgs(uid, constants.InviteCodeUserBatchID).
+				WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(quota))
+			mock.ExpectRollback()
+
+			_, err := db.CreateUserInviteCode(context.Background(), invite, quota)
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.ServiceInviteQuotaExceeded)
+			convey.So(mock.ExpectationsWereMet(), convey.ShouldBeNil)
+		})
+	})
+}
@@ -1,6 +1,8 @@
 package mysql
 
 import (
+	"time"
+
 	"github.com/LingeringAutumn/Yijie/pkg/constants"
 )
 
@@ -8,11 +10,13 @@ import (
 
 // User 表示 users 表中的用户基本信息。
 type User struct {
-	Uid      int64  `gorm:"column:id;primaryKey" json:"uid"`      // 用户ID，对应数据库中的 id 字段
-	Username string `gorm:"column:username" json:"username"`      // 用户名
-	Password string `gorm:"column:password_hash" json:"password"` // 密码哈希值
-	Email    string `gorm:"column:email" json:"email"`            // 邮箱
-	Phone    string `gorm:"column:phone" json:"phone"`            // 手机号
+	Uid       int64   `gorm:"column:id;primaryKey" json:"uid"`      // 用户ID，对应数据库中的 id 字段
+	Username  string  `gorm:"column:username" json:"username"`      // 用户名
+	Password  string  `gorm:"column:password_hash" json:"password"` // 密码哈希值
+	Email     string  `gorm:"column:email" json:"email"`            // 邮箱密文
+	Phone     string  `gorm:"column:phone" json:"phone"`            // 手机号密文
+	EmailBidx *string `gorm:"column:email_bidx" json:"-"`           // 邮箱盲索引
+	PhoneBidx *string `gorm:"column:phone_bidx" json:"-"`           // 手机号盲索引，没有手机号时为 NULL
 }
 
 // UserInfo 是用户的基础信息响应
@@ -41,6 +45,9 @@ type UserProfileResponse struct {
 	MembershipLevel int64  `json:"member"`                          // 会员等级
 	Point           int64  `json:"point"`                           // 当前积分
 	Team            string `json:"team"`                            // 团队信息
+
+	EmailBidx *string `json:"-" gorm:"column:email_bidx"` // 邮箱盲索引
+	PhoneBidx *string `json:"-" gorm:"column:phone_bidx"` // 手机号盲索引
 }
 
 // UpdateUserProfileResponse 是更新用户资料后的响应
@@ -56,6 +63,78 @@ type Image struct {
 	Url     string `json:"url" gorm:"column:url;type:varchar(255);not null"`
 }
 
+// ActivityLog 对应 user_activity_logs 表，安全事件以 target_type = security 写入
+type ActivityLog struct {
+	ID          int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID      int64     `gorm:"column:user_id"`
+	Action      string    `gorm:"column:action"`
+	TargetID    *int64    `gorm:"column:target_id"`
+	TargetType  string    `gorm:"column:target_type"`
+	IP          string    `gorm:"column:ip"`
+	UserAgent   string    `gorm:"column:user_agent"`
+	IsNewDevice bool      `gorm:"column:is_new_device"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// LoginDevice 对应 user_login_devices 表，记录用户登录过的设备/IP 组合
+type LoginDevice struct {
+	ID          int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	UserID      int64     `gorm:"column:user_id"`
+	DeviceHash  string    `gorm:"column:device_hash"`
+	IP          string    `gorm:"column:ip"`
+	UserAgent   string    `gorm:"column:user_agent"`
+	FirstSeenAt time.Time `gorm:"column:first_seen_at;autoCreateTime"`
+	LastSeenAt  time.Time `gorm:"column:last_seen_at;autoCreateTime"`
+}
+
+// InviteCode 对应 invite_codes 表
+type InviteCode struct {
+	ID        int64      `gorm:"column:id;primaryKey;autoIncrement"`
+	Code      string     `gorm:"column:code"`
+	CreatorID int64      `gorm:"column:creator_id"`
+	BatchID   int64      `gorm:"column:batch_id"`
+	MaxUses   int64      `gorm:"column:max_uses"`
+	UsedCount int64      `gorm:"column:used_count"`
+	ExpiresAt *time.Time `gorm:"column:expires_at"` // 为 nil 表示永不过期
+	CreatedAt time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+// InviteRecord 对应 invite_records 表，记录谁邀请了谁
+type InviteRecord struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement"`
+	CodeID    int64     `gorm:"column:code_id"`
+	InviterID int64     `gorm:"column:inviter_id"`
+	InviteeID int64     `gorm:"column:invitee_id"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// Membership 对应 memberships 表，这里只用到会员等级
+type Membership struct {
+	ID              int64  `gorm:"column:id;primaryKey"`
+	UserID          int64  `gorm:"column:user_id"`
+	MembershipLevel string `gorm:"column:membership_level"`
+	Status          string `gorm:"column:status"`
+}
+
+// PrivacySetting 对应 user_privacy_settings 表
+type PrivacySetting struct {
+	UserID            int64  `gorm:"column:user_id;primaryKey"`
+	ProfileVisibility string `gorm:"column:profile_visibility"`
+	HideLikedVideos   bool   `gorm:"column:hide_liked_videos"`
+	WhoCanMessage     string `gorm:"column:who_can_message"`
+	WhoCanFollow      string `gorm:"column:who_can_follow"`
+
+	DisableSearchHistory bool `gorm:"column:disable_search_history"`
+}
+
+// Relationship 对应 relationships 表
+type Relationship struct {
+	ID       int64  `gorm:"column:id;primaryKey"`
+	UserID   int64  `gorm:"column:user_id"`
+	TargetID int64  `gorm:"column:target_id"`
+	Status   string `gorm:"column:status"`
+}
+
 func (User) TableName() string {
 	return constants.UserTableName
 }
@@ -63,3 +142,31 @@ func (User) TableName() string {
 func (Image) TableName() string {
 	return constants.ImageTableName
 }
+
+func (ActivityLog) TableName() string {
+	return constants.UserActivityLogTableName
+}
+
+func (LoginDevice) TableName() string {
+	return constants.UserLoginDeviceTableName
+}
+
+func (InviteCode) TableName() string {
+	return constants.InviteCodeTableName
+}
+
+func (InviteRecord) TableName() string {
+	return constants.InviteRecordTableName
+}
+
+func (Membership) TableName() string {
+	return constants.MembershipTableName
+}
+
+func (PrivacySetting) TableName() string {
+	return constants.PrivacySettingTableName
+}
+
+func (Relationship) TableName() string {
+	return constants.RelationshipTableName
+}
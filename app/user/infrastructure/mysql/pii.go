@@ -0,0 +1,52 @@
+package mysql
+
+import (
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+)
+
+// contact 是加密后的邮箱和手机号，以及对应的盲索引
+type contact struct {
+	Email     string
+	Phone     string
+	EmailBidx *string
+	PhoneBidx *string
+}
+
+// encryptContact 加密邮箱和手机号并计算盲索引，写入 users 表之前都要经过这里
+func (db *userDB) encryptContact(email, phone string) (*contact, error) {
+	encEmail, err := db.pii.Encrypt(constants.PIIFieldEmail, email)
+	if err != nil {
+		return nil, err
+	}
+	encPhone, err := db.pii.Encrypt(constants.PIIFieldPhone, phone)
+	if err != nil {
+		return nil, err
+	}
+	return &contact{
+		Email:     encEmail,
+		Phone:     encPhone,
+		EmailBidx: nullable(db.pii.BlindIndex(constants.PIIFieldEmail, email)),
+		PhoneBidx: nullable(db.pii.BlindIndex(constants.PIIFieldPhone, phone)),
+	}, nil
+}
+
+// decryptContact 解密从 users 表读出来的邮箱和手机号
+func (db *userDB) decryptContact(email, phone string) (string, string, error) {
+	plainEmail, err := db.pii.Decrypt(constants.PIIFieldEmail, email)
+	if err != nil {
+		return "", "", err
+	}
+	plainPhone, err := db.pii.Decrypt(constants.PIIFieldPhone, phone)
+	if err != nil {
+		return "", "", err
+	}
+	return plainEmail, plainPhone, nil
+}
+
+// nullable 空字符串转成 NULL，避免多个空值撞上唯一索引
+func nullable(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
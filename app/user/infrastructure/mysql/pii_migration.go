@@ -0,0 +1,124 @@
+package mysql
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+	"github.com/LingeringAutumn/Yijie/pkg/utils"
+)
+
+// PIIMigrator 把 users 表中的明文邮箱、手机号加密，同时也用于密钥轮换后的重新加密
+type PIIMigrator struct {
+	db *userDB
+}
+
+func NewPIIMigrator(client *gorm.DB, pii *utils.PIICipher) *PIIMigrator {
+	return &PIIMigrator{db: &userDB{client: client, pii: pii}}
+}
+
+// EnsureSchema 把老版本的 users 表结构升级到支持加密的结构，可以重复执行
+// 密文比明文长，需要放宽列宽；唯一约束从明文列移到盲索引列上
+func (m *PIIMigrator) EnsureSchema(ctx context.Context) error {
+	client := m.db.client.WithContext(ctx)
+	migrator := client.Migrator()
+	statements := make([]string, 0)
+	if !migrator.HasColumn(&User{}, "email_bidx") {
+		statements = append(statements, "ALTER TABLE users ADD COLUMN email_bidx CHAR(64) NULL COMMENT '邮箱盲索引' AFTER email")
+	}
+	if !migrator.HasColumn(&User{}, "phone_bidx") {
+		statements = append(statements, "ALTER TABLE users ADD COLUMN phone_bidx CHAR(64) NULL COMMENT '手机号盲索引' AFTER phone")
+	}
+	statements = append(statements,
+		"ALTER TABLE users MODIFY email VARCHAR(512) NOT NULL COMMENT '用户邮箱密文', MODIFY phone VARCHAR(512) NULL COMMENT '手机号密文，可为空'")
+	if migrator.HasIndex(&User{}, "email") {
+		statements = append(statements, "ALTER TABLE users DROP INDEX email")
+	}
+	if migrator.HasIndex(&User{}, "phone") {
+		statements = append(statements, "ALTER TABLE users DROP INDEX phone")
+	}
+	if !migrator.HasIndex(&User{}, "uniq_users_email_bidx") {
+		statements = append(statements, "CREATE UNIQUE INDEX uniq_users_email_bidx ON users(email_bidx)")
+	}
+	if !migrator.HasIndex(&User{}, "uniq_users_phone_bidx") {
+		statements = append(statements, "CREATE UNIQUE INDEX uniq_users_phone_bidx ON users(phone_bidx)")
+	}
+	for _, stmt := range statements {
+		if err := client.Exec(stmt).Error; err != nil {
+			return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to migrate users schema: %v, sql: %s", err, stmt)
+		}
+	}
+	return nil
+}
+
+// Run 按主键顺序分批处理所有用户，返回实际重新加密的行数
+// 只有明文、旧版本密钥加密或缺少盲索引的行才会被改写，所以中断后可以直接重跑
+func (m *PIIMigrator) Run(ctx context.Context, batchSize int) (int64, error) {
+	if batchSize <= 0 {
+		batchSize = constants.PIIMigrateBatchSize
+	}
+	var (
+		lastID   int64
+		migrated int64
+	)
+	for {
+		var users []User
+		err := m.db.client.WithContext(ctx).
+			Where("id > ?", lastID).
+			Order("id ASC").
+			Limit(batchSize).
+			Find(&users).Error
+		if err != nil {
+			return migrated, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to scan users: %v", err)
+		}
+		if len(users) == 0 {
+			return migrated, nil
+		}
+		for i := range users {
+			updated, err := m.migrateUser(ctx, &users[i])
+			if err != nil {
+				return migrated, err
+			}
+			if updated {
+				migrated++
+			}
+		}
+		lastID = users[len(users)-1].Uid
+		logger.Infof("pii migration: scanned up to user %d, migrated %d rows", lastID, migrated)
+	}
+}
+
+func (m *PIIMigrator) migrateUser(ctx context.Context, user *User) (bool, error) {
+	pii := m.db.pii
+	if !pii.NeedsReEncrypt(user.Email) && !pii.NeedsReEncrypt(user.Phone) && user.EmailBidx != nil {
+		return false, nil
+	}
+	email, phone, err := m.db.decryptContact(user.Email, user.Phone)
+	if err != nil {
+		return false, err
+	}
+	c, err := m.db.encryptContact(email, phone)
+	if err != nil {
+		return false, err
+	}
+	// 带上旧值作为条件，迁移期间如果用户刚好修改了资料，就跳过这一行，不覆盖新数据
+	result := m.db.client.WithContext(ctx).Model(&User{}).
+		Where("id = ? AND email = ? AND COALESCE(phone, '') = ?", user.Uid, user.Email, user.Phone).
+		Updates(map[string]interface{}{
+			"email":      c.Email,
+			"phone":      c.Phone,
+			"email_bidx": c.EmailBidx,
+			"phone_bidx": c.PhoneBidx,
+		})
+	if result.Error != nil {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to encrypt user %d: %v", user.Uid, result.Error)
+	}
+	if result.RowsAffected == 0 {
+		logger.Warnf("pii migration: user %d changed during migration, skipped", user.Uid)
+		return false, nil
+	}
+	return true, nil
+}
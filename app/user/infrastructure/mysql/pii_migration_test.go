@@ -0,0 +1,79 @@
+package mysql
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/smartystreets/goconvey/convey"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/utils"
+)
+
+const (
+	scanUsersSQL  = "SELECT \\* FROM `users` WHERE id > \\?"
+	updateUserSQL = "UPDATE `users` SET"
+)
+
+func TestPIIMigrator_Run(t *testing.T) {
+	convey.Convey("PIIMigrator.Run", t, func() {
+		key := func(c string) string {
+			return base64.StdEncoding.EncodeToString([]byte(strings.Repeat(c, constants.PIIKeySize)))
+		}
+		pii, err := utils.NewPIICipherWithKeys(1, map[int]string{1: key("a")}, key("b"))
+		convey.So(err, convey.ShouldBeNil)
+
+		sqlDB, mock, err := sqlmock.New()
+		convey.So(err, convey.ShouldBeNil)
+		defer sqlDB.Close()
+		client, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}),
+			&gorm.Config{SkipDefaultTransaction: true})
+		convey.So(err, convey.ShouldBeNil)
+		m := NewPIIMigrator(client, pii)
+
+		columns := []string{"id", "username", "password_hash", "email", "phone", "email_bidx", "phone_bidx"}
+		email, _ := pii.Encrypt(constants.PIIFieldEmail, "bob@example.com")
+		emailBidx := pii.BlindIndex(constants.PIIFieldEmail, "bob@example.com")
+		migrated := sqlmock.NewRows(columns).AddRow(2, "bob", "hash", email, "", emailBidx, nil)
+
+		convey.Convey("only plaintext rows are rewritten", func() {
+			mock.ExpectQuery(scanUsersSQL).WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(1, "alice", "hash", "alice@example.com", "13800000000", nil, nil).
+				AddRow(2, "bob", "hash", email, "", emailBidx, nil))
+			mock.ExpectExec(updateUserSQL).WillReturnResult(sqlmock.NewResult(0, 1))
+			mock.ExpectQuery(scanUsersSQL).WillReturnRows(sqlmock.NewRows(columns))
+
+			count, err := m.Run(context.Background(), 10)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(count, convey.ShouldEqual, 1)
+			convey.So(mock.ExpectationsWereMet(), convey.ShouldBeNil)
+		})
+
+		convey.Convey("running again on migrated rows changes nothing", func() {
+			mock.ExpectQuery(scanUsersSQL).WillReturnRows(migrated)
+			mock.ExpectQuery(scanUsersSQL).WillReturnRows(sqlmock.NewRows(columns))
+
+			count, err := m.Run(context.Background(), 10)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(count, convey.ShouldEqual, 0)
+			convey.So(mock.ExpectationsWereMet(), convey.ShouldBeNil)
+		})
+
+		convey.Convey("rows changed during migration are skipped", func() {
+			mock.ExpectQuery(scanUsersSQL).WillReturnRows(sqlmock.NewRows(columns).
+				AddRow(1, "alice", "hash", "alice@example.com", "", nil, nil))
+			mock.ExpectExec(updateUserSQL).WillReturnResult(sqlmock.NewResult(0, 0))
+			mock.ExpectQuery(scanUsersSQL).WillReturnRows(sqlmock.NewRows(columns))
+
+			count, err := m.Run(context.Background(), 10)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(count, convey.ShouldEqual, 0)
+			convey.So(mock.ExpectationsWereMet(), convey.ShouldBeNil)
+		})
+	})
+}
@@ -0,0 +1,72 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/LingeringAutumn/Yijie/app/user/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// GetPrivacySettings 查询用户的隐私设置，用户没有设置过时返回默认设置
+func (db *userDB) GetPrivacySettings(ctx context.Context, uid int64) (*model.PrivacySettings, error) {
+	var setting PrivacySetting
+	err := db.client.WithContext(ctx).Where("user_id = ?", uid).First(&setting).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &model.PrivacySettings{
+				Uid:               uid,
+				ProfileVisibility: constants.ProfileVisibilityPublic,
+				HideLikedVideos:   false,
+				WhoCanMessage:     constants.PrivacyAudienceEveryone,
+				WhoCanFollow:      constants.PrivacyAudienceEveryone,
+			}, nil
+		}
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query privacy settings: %v", err)
+	}
+	return &model.PrivacySettings{
+		Uid:               setting.UserID,
+		ProfileVisibility: setting.ProfileVisibility,
+		HideLikedVideos:   setting.HideLikedVideos,
+		WhoCanMessage:     setting.WhoCanMessage,
+		WhoCanFollow:      setting.WhoCanFollow,
+
+		DisableSearchHistory: setting.DisableSearchHistory,
+	}, nil
+}
+
+func (db *userDB) UpsertPrivacySettings(ctx context.Context, settings *model.PrivacySettings) error {
+	setting := PrivacySetting{
+		UserID:            settings.Uid,
+		ProfileVisibility: settings.ProfileVisibility,
+		HideLikedVideos:   settings.HideLikedVideos,
+		WhoCanMessage:     settings.WhoCanMessage,
+		WhoCanFollow:      settings.WhoCanFollow,
+
+		DisableSearchHistory: settings.DisableSearchHistory,
+	}
+	err := db.client.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{"profile_visibility", "hide_liked_videos", "who_can_message", "who_can_follow", "disable_search_history"}),
+	}).Create(&setting).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to save privacy settings: %v", err)
+	}
+	return nil
+}
+
+// IsFollowing 判断 followerID 是否关注了 followeeID
+func (db *userDB) IsFollowing(ctx context.Context, followerID, followeeID int64) (bool, error) {
+	var count int64
+	err := db.client.WithContext(ctx).Model(&Relationship{}).
+		Where("user_id = ? AND target_id = ? AND status = ?", followerID, followeeID, constants.RelationshipStatusFollow).
+		Count(&count).Error
+	if err != nil {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query relationship: %v", err)
+	}
+	return count > 0, nil
+}
@@ -0,0 +1,53 @@
+package mysql
+
+import (
+	"context"
+
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// ListFollowers 按关系 ID 升序列出 afterID 之后关注了 uid 的用户，返回粉丝 ID 和最后一条关系的 ID
+// 通过 idx_relationships_target 定位，二级索引中的主键有序，不需要额外排序
+func (db *userDB) ListFollowers(ctx context.Context, uid, afterID int64, limit int) ([]int64, int64, error) {
+	var relations []*Relationship
+	err := db.client.WithContext(ctx).
+		Select("id", "user_id").
+		Where("target_id = ? AND status = ? AND id > ?", uid, constants.RelationshipStatusFollow, afterID).
+		Order("id").
+		Limit(limit).
+		Find(&relations).Error
+	if err != nil {
+		return nil, 0, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to list followers: %v", err)
+	}
+	followers := make([]int64, 0, len(relations))
+	var last int64
+	for _, r := range relations {
+		followers = append(followers, r.UserID)
+		last = r.ID
+	}
+	return followers, last, nil
+}
+
+func (db *userDB) CountFollowers(ctx context.Context, uid int64) (int64, error) {
+	var count int64
+	err := db.client.WithContext(ctx).Model(&Relationship{}).
+		Where("target_id = ? AND status = ?", uid, constants.RelationshipStatusFollow).
+		Count(&count).Error
+	if err != nil {
+		return 0, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to count followers: %v", err)
+	}
+	return count, nil
+}
+
+// ListFollowing 列出 uid 关注的全部用户
+func (db *userDB) ListFollowing(ctx context.Context, uid int64) ([]int64, error) {
+	var following []int64
+	err := db.client.WithContext(ctx).Model(&Relationship{}).
+		Where("user_id = ? AND status = ?", uid, constants.RelationshipStatusFollow).
+		Pluck("target_id", &following).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to list following: %v", err)
+	}
+	return following, nil
+}
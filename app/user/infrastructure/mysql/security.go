@@ -0,0 +1,99 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/LingeringAutumn/Yijie/app/user/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// CreateSecurityEvent 把一条安全事件写入 user_activity_logs
+func (db *userDB) CreateSecurityEvent(ctx context.Context, event *model.SecurityEvent) error {
+	log := ActivityLog{
+		UserID:      event.Uid,
+		Action:      event.Action,
+		TargetType:  constants.SecurityEventTargetType,
+		IP:          event.IP,
+		UserAgent:   event.UserAgent,
+		IsNewDevice: event.IsNewDevice,
+	}
+	if err := db.client.WithContext(ctx).Create(&log).Error; err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to create security event: %v", err)
+	}
+	event.ID = log.ID
+	event.CreatedAt = log.CreatedAt.Unix()
+	return nil
+}
+
+// ListSecurityEvents 按时间倒序分页查询用户的安全事件，同时返回总条数
+func (db *userDB) ListSecurityEvents(ctx context.Context, uid int64, pageNum, pageSize int64) ([]*model.SecurityEvent, int64, error) {
+	var (
+		logs  []ActivityLog
+		total int64
+	)
+	query := db.client.WithContext(ctx).Model(&ActivityLog{}).
+		Where("user_id = ? AND target_type = ?", uid, constants.SecurityEventTargetType)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to count security events: %v", err)
+	}
+	err := query.Order("created_at DESC, id DESC").
+		Offset(int((pageNum - 1) * pageSize)).
+		Limit(int(pageSize)).
+		Find(&logs).Error
+	if err != nil {
+		return nil, 0, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to list security events: %v", err)
+	}
+
+	events := make([]*model.SecurityEvent, 0, len(logs))
+	for _, l := range logs {
+		events = append(events, &model.SecurityEvent{
+			ID:          l.ID,
+			Uid:         l.UserID,
+			Action:      l.Action,
+			IP:          l.IP,
+			UserAgent:   l.UserAgent,
+			IsNewDevice: l.IsNewDevice,
+			CreatedAt:   l.CreatedAt.Unix(),
+		})
+	}
+	return events, total, nil
+}
+
+// TouchLoginDevice 记录一次设备登录，如果该设备/IP 组合此前从未出现过则返回 true
+func (db *userDB) TouchLoginDevice(ctx context.Context, uid int64, deviceHash string, client *model.ClientInfo) (bool, error) {
+	var device LoginDevice
+	err := db.client.WithContext(ctx).
+		Where("user_id = ? AND device_hash = ?", uid, deviceHash).
+		First(&device).Error
+	if err == nil {
+		err = db.client.WithContext(ctx).Model(&LoginDevice{}).
+			Where("id = ?", device.ID).
+			Update("last_seen_at", time.Now()).Error
+		if err != nil {
+			return false, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to update login device: %v", err)
+		}
+		return false, nil
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to query login device: %v", err)
+	}
+
+	device = LoginDevice{
+		UserID:     uid,
+		DeviceHash: deviceHash,
+		IP:         client.IP,
+		UserAgent:  client.UserAgent,
+	}
+	// 并发登录时可能同时插入同一设备，唯一键冲突时忽略即可
+	result := db.client.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&device)
+	if result.Error != nil {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to create login device: %v", result.Error)
+	}
+	return result.RowsAffected > 0, nil
+}
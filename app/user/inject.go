@@ -37,7 +37,13 @@ func InjectUserHandler() user.UserService {
 		panic(err)
 	}
 
-	db := mysql.NewUserDB(gormDB)
+	// 初始化邮箱、手机号的加密组件
+	piiCipher, err := utils.NewPIICipher()
+	if err != nil {
+		panic(err)
+	}
+
+	db := mysql.NewUserDB(gormDB, piiCipher)
 	svc := service.NewUserService(db, redisRepo, sf)
 	uc := usecase.NewUserUseCase(db, svc, redisRepo)
 
@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LingeringAutumn/Yijie/app/user/domain/model"
+	"github.com/LingeringAutumn/Yijie/config"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// MintInviteCodes 管理员批量生成邀请码
+func (uc *userUseCase) MintInviteCodes(ctx context.Context, req *model.InviteMintRequest) ([]*model.InviteCode, error) {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !config.IsInviteAdmin(uid) {
+		return nil, errno.AuthNoOperatePermission
+	}
+	if req.Count <= 0 || req.Count > constants.InviteMintMaxCount {
+		return nil, errno.Errorf(errno.ParamVerifyErrorCode, "invite code count should be in [1, %d]", constants.InviteMintMaxCount)
+	}
+	if req.MaxUses <= 0 || req.MaxUses > constants.InviteCodeMaxUses {
+		return nil, errno.Errorf(errno.ParamVerifyErrorCode, "invite code max uses should be in [1, %d]", constants.InviteCodeMaxUses)
+	}
+	if req.ExpireSeconds < 0 {
+		return nil, errno.NewErrNo(errno.ParamVerifyErrorCode, "invite code expire seconds should not be negative")
+	}
+	codes, err := uc.svc.MintInviteCodes(ctx, uid, req)
+	if err != nil {
+		return nil, fmt.Errorf("usecase mint invite codes failed: %w", err)
+	}
+	return codes, nil
+}
+
+// ListInviteCodes 管理员可以看到所有邀请码，普通用户只能看到自己生成的
+func (uc *userUseCase) ListInviteCodes(ctx context.Context, pageNum, pageSize int64) ([]*model.InviteCode, int64, error) {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	creatorID := uid
+	if config.IsInviteAdmin(uid) {
+		creatorID = 0
+	}
+	if pageNum <= 0 {
+		pageNum = 1
+	}
+	if pageSize <= 0 {
+		pageSize = constants.InviteDefaultPageSize
+	}
+	if pageSize > constants.InviteMaxPageSize {
+		pageSize = constants.InviteMaxPageSize
+	}
+	codes, total, err := uc.svc.ListInviteCodes(ctx, creatorID, pageNum, pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("usecase list invite codes failed: %w", err)
+	}
+	return codes, total, nil
+}
+
+// CreateInviteCode 用户消耗一个邀请额度生成邀请码，返回邀请码和剩余额度
+func (uc *userUseCase) CreateInviteCode(ctx context.Context) (*model.InviteCode, int64, error) {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	code, remaining, err := uc.svc.CreateUserInviteCode(ctx, uid)
+	if err != nil {
+		return nil, 0, fmt.Errorf("usecase create invite code failed: %w", err)
+	}
+	return code, remaining, nil
+}
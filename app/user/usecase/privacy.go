@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LingeringAutumn/Yijie/app/user/domain/model"
+)
+
+// GetPrivacySettings 获取当前登录用户自己的隐私设置
+func (uc *userUseCase) GetPrivacySettings(ctx context.Context) (*model.PrivacySettings, error) {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := uc.svc.GetPrivacySettings(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("usecase get privacy settings failed: %w", err)
+	}
+	return settings, nil
+}
+
+// UpdatePrivacySettings 整体覆盖当前登录用户的隐私设置，disableSearchHistory 为 nil 时不修改
+func (uc *userUseCase) UpdatePrivacySettings(ctx context.Context, settings *model.PrivacySettings, disableSearchHistory *bool) (*model.PrivacySettings, error) {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		return nil, err
+	}
+	settings.Uid = uid
+	if err = uc.svc.ValidatePrivacySettings(settings); err != nil {
+		return nil, err
+	}
+	if err = uc.svc.UpdatePrivacySettings(ctx, settings, disableSearchHistory); err != nil {
+		return nil, fmt.Errorf("usecase update privacy settings failed: %w", err)
+	}
+	return settings, nil
+}
+
+// CheckPrivacy 供其他服务判断某个操作是否被对方的隐私设置允许
+// 目前 video 用它判断仅粉丝可见的视频，chat 用它判断私信；user_behaviour 还没有点赞列表接口，上线时需要用 view_likes 判断
+func (uc *userUseCase) CheckPrivacy(ctx context.Context, viewer, owner int64, action string) (bool, error) {
+	allowed, err := uc.svc.CheckPrivacy(ctx, viewer, owner, action)
+	if err != nil {
+		return false, fmt.Errorf("usecase check privacy failed: %w", err)
+	}
+	return allowed, nil
+}
+
+// ListFollowers 供视频服务推送关注流时分批读取作者的粉丝
+func (uc *userUseCase) ListFollowers(ctx context.Context, uid, cursor, limit int64) ([]int64, int64, int64, error) {
+	followers, next, total, err := uc.svc.ListFollowers(ctx, uid, cursor, limit)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("usecase list followers failed: %w", err)
+	}
+	return followers, next, total, nil
+}
+
+// ListFollowing 供视频服务读取关注流时合并关注的作者的发件箱
+func (uc *userUseCase) ListFollowing(ctx context.Context, uid int64) ([]int64, error) {
+	following, err := uc.svc.ListFollowing(ctx, uid)
+	if err != nil {
+		return nil, fmt.Errorf("usecase list following failed: %w", err)
+	}
+	return following, nil
+}
@@ -5,10 +5,17 @@ import (
 	"fmt"
 
 	"github.com/LingeringAutumn/Yijie/app/user/domain/model"
+	"github.com/LingeringAutumn/Yijie/config"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
 	"github.com/LingeringAutumn/Yijie/pkg/errno"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
 )
 
-func (uc *userUseCase) RegisterUser(ctx context.Context, u *model.User) (uid int64, err error) {
+func (uc *userUseCase) RegisterUser(ctx context.Context, u *model.User, inviteCode string) (uid int64, err error) {
+	// 邀请码开关在运行时可能被修改，每次注册都重新读取
+	if config.IsInviteRequired() && inviteCode == "" {
+		return 0, errno.NewErrNo(errno.ServiceInviteCodeRequired, "invite code is required")
+	}
 	// 注意: 这里使用 uc 调用了 DB, 但显然这个方法其他地方也可能会用的上, 所以可以考虑包装在 service 里面
 	exist, err := uc.svc.IsUserExist(ctx, u.Username)
 	if err != nil {
@@ -19,10 +26,21 @@ func (uc *userUseCase) RegisterUser(ctx context.Context, u *model.User) (uid int
 	if exist {
 		return 0, errno.NewErrNo(errno.ServiceUserExist, "user already exist")
 	}
+	exist, err = uc.svc.IsContactExist(ctx, u.Email, u.Phone)
+	if err != nil {
+		return 0, fmt.Errorf("check contact exist failed: %w", err)
+	}
+	if exist {
+		return 0, errno.NewErrNo(errno.ServiceUserExist, "email or phone already registered")
+	}
 	u.Password, err = uc.svc.EncryptPassword(u.Password)
 	if err != nil {
 		return 0, err
 	}
+	// 没开启邀请码注册时也允许填写邀请码，这样邀请关系依然会被记录
+	if inviteCode != "" {
+		return uc.svc.CreateUserWithInvite(ctx, u, inviteCode)
+	}
 	// 这里没有直接调用 db.CreateUser 是因为 svc.CreateUser 包含了一点业务逻辑, 这些细节不需要被 useCase 知道
 	uid, err = uc.svc.CreateUser(ctx, u)
 	if err != nil {
@@ -31,15 +49,44 @@ func (uc *userUseCase) RegisterUser(ctx context.Context, u *model.User) (uid int
 	return uid, nil
 }
 
-func (uc *userUseCase) LoginUser(ctx context.Context, user *model.User) (*model.User, error) {
-	userData, err := uc.svc.GetUserByName(ctx, user.Username)
+func (uc *userUseCase) LoginUser(ctx context.Context, user *model.User, client *model.ClientInfo) (*model.User, bool, error) {
+	// 支持用户名或邮箱登录
+	userData, err := uc.svc.GetUserByAccount(ctx, user.Username)
 	if err != nil {
-		return nil, fmt.Errorf("get user info failed: %w", err)
+		return nil, false, fmt.Errorf("get user info failed: %w", err)
 	}
 	if err = uc.svc.CheckPassword(userData.Password, user.Password); err != nil {
-		return nil, err
+		uc.svc.RecordSecurityEvent(ctx, userData.Uid, constants.SecurityActionLoginFailure, client, false)
+		return nil, false, err
+	}
+	// 新设备识别失败不应该阻止登录，按非新设备处理
+	isNewDevice, err := uc.svc.DetectNewDevice(ctx, userData.Uid, client)
+	if err != nil {
+		logger.Errorf("detect new device for user %d failed: %v", userData.Uid, err)
+	}
+	uc.svc.RecordSecurityEvent(ctx, userData.Uid, constants.SecurityActionLoginSuccess, client, isNewDevice)
+	return userData, isNewDevice, nil
+}
+
+func (uc *userUseCase) ListSecurityEvents(ctx context.Context, pageNum, pageSize int64) ([]*model.SecurityEvent, int64, error) {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	if pageNum <= 0 {
+		pageNum = 1
+	}
+	if pageSize <= 0 {
+		pageSize = constants.SecurityEventDefaultPageSize
+	}
+	if pageSize > constants.SecurityEventMaxPageSize {
+		pageSize = constants.SecurityEventMaxPageSize
+	}
+	events, total, err := uc.svc.ListSecurityEvents(ctx, uid, pageNum, pageSize)
+	if err != nil {
+		return nil, 0, fmt.Errorf("usecase list security events failed: %w", err)
 	}
-	return userData, nil
+	return events, total, nil
 }
 
 // Todo
@@ -52,6 +99,18 @@ func (uc *userUseCase) UpdateUserProfile(ctx context.Context, user *model.UserPr
 }
 
 func (uc *userUseCase) GetUserProfile(ctx context.Context, uid int64) (*model.GetUserProfileResponse, error) {
+	// 查看别人的资料时需要遵守对方的隐私设置，拿不到登录信息时按未登录用户处理
+	viewer, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		viewer = 0
+	}
+	allowed, err := uc.svc.CheckPrivacy(ctx, viewer, uid, constants.PrivacyActionViewProfile)
+	if err != nil {
+		return nil, fmt.Errorf("usecase check privacy failed: %w", err)
+	}
+	if !allowed {
+		return nil, errno.NewErrNo(errno.ServicePrivacyRestricted, "profile is not visible")
+	}
 	u, err := uc.svc.GetUserProfileInfoById(ctx, uid)
 	if err != nil {
 		return nil, fmt.Errorf("usecase get user profile info failed: %w", err)
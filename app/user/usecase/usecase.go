@@ -10,10 +10,19 @@ import (
 
 // UserUseCase 接口应该不应该定义在 domain 中，这属于 use case 层
 type UserUseCase interface {
-	RegisterUser(ctx context.Context, user *model.User) (uid int64, err error)
-	LoginUser(ctx context.Context, user *model.User) (*model.User, error)
+	RegisterUser(ctx context.Context, user *model.User, inviteCode string) (uid int64, err error)
+	LoginUser(ctx context.Context, user *model.User, client *model.ClientInfo) (*model.User, bool, error)
 	UpdateUserProfile(ctx context.Context, user *model.UserProfileRequest, avatar []byte) (*model.UpdateUserProfileResponse, error)
 	GetUserProfile(ctx context.Context, uid int64) (*model.GetUserProfileResponse, error)
+	ListSecurityEvents(ctx context.Context, pageNum, pageSize int64) ([]*model.SecurityEvent, int64, error)
+	MintInviteCodes(ctx context.Context, req *model.InviteMintRequest) ([]*model.InviteCode, error)
+	ListInviteCodes(ctx context.Context, pageNum, pageSize int64) ([]*model.InviteCode, int64, error)
+	CreateInviteCode(ctx context.Context) (*model.InviteCode, int64, error)
+	GetPrivacySettings(ctx context.Context) (*model.PrivacySettings, error)
+	UpdatePrivacySettings(ctx context.Context, settings *model.PrivacySettings, disableSearchHistory *bool) (*model.PrivacySettings, error)
+	CheckPrivacy(ctx context.Context, viewer, owner int64, action string) (bool, error)
+	ListFollowers(ctx context.Context, uid, cursor, limit int64) (followers []int64, nextCursor int64, total int64, err error)
+	ListFollowing(ctx context.Context, uid int64) ([]int64, error)
 }
 
 type userUseCase struct {
@@ -0,0 +1,89 @@
+package mq
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/IBM/sarama"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/app/video/domain/service"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+)
+
+// UploadTaskHandler 消费投稿时发送的处理任务，实现 sarama.ConsumerGroupHandler
+// 每个分区的消息按顺序处理，sem 限制所有分区同时处理的视频数
+type UploadTaskHandler struct {
+	svc *service.ProcessingService
+	sem chan struct{}
+}
+
+func NewUploadTaskHandler(svc *service.ProcessingService, concurrency int) *UploadTaskHandler {
+	return &UploadTaskHandler{
+		svc: svc,
+		sem: make(chan struct{}, concurrency),
+	}
+}
+
+func (h *UploadTaskHandler) Setup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h *UploadTaskHandler) Cleanup(sarama.ConsumerGroupSession) error { return nil }
+
+func (h *UploadTaskHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	ctx := session.Context()
+	for {
+		select {
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			if !h.handle(ctx, msg) {
+				return nil
+			}
+			session.MarkMessage(msg, "")
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// handle 处理一条消息，返回 false 表示会话已经结束，消息不能提交
+func (h *UploadTaskHandler) handle(ctx context.Context, msg *sarama.ConsumerMessage) bool {
+	var task model.ProcessingTask
+	if err := json.Unmarshal(msg.Value, &task); err != nil || task.VideoID == 0 || task.Object == "" {
+		logger.Errorf("invalid upload task at partition %d offset %d, skipped: %s", msg.Partition, msg.Offset, msg.Value)
+		return true
+	}
+
+	select {
+	case h.sem <- struct{}{}:
+		defer func() { <-h.sem }()
+	case <-ctx.Done():
+		return false
+	}
+
+	var err error
+	for attempt := 1; attempt <= constants.VideoProcessingMaxAttempts; attempt++ {
+		if err = h.svc.Process(ctx, &task); err == nil {
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+		logger.Errorf("process video %d failed, attempt %d: %v", task.VideoID, attempt, err)
+		if attempt < constants.VideoProcessingMaxAttempts {
+			select {
+			case <-time.After(time.Duration(attempt) * constants.VideoProcessingRetryBackoff):
+			case <-ctx.Done():
+				return false
+			}
+		}
+	}
+	// 多次重试仍然失败，标记为失败后提交，不能让一个视频阻塞整个分区
+	if err = h.svc.FailProcessing(ctx, task.VideoID, err.Error()); err != nil {
+		logger.Errorf("mark video %d processing failed: %v", task.VideoID, err)
+	}
+	return true
+}
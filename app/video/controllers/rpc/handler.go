@@ -2,6 +2,7 @@ package rpc
 
 import (
     "context"
+    "time"
 
     "github.com/LingeringAutumn/Yijie/app/video/controllers/rpc/pack"
     "github.com/LingeringAutumn/Yijie/app/video/domain/model"
@@ -32,6 +33,14 @@ func (handler *VideoHandler) SubmitVideo(ctx context.Context, req *video.VideoSu
         Description:     req.Description,
         DurationSeconds: req.DurationSeconds,
         CoverURL:        cover,
+        Tags:            req.Tags,
+    }
+    if req.IsDraft != nil && *req.IsDraft {
+        v.Status = constants.VideoStatusDraft
+    }
+    if req.PublishAt != nil && *req.PublishAt > 0 {
+        publishAt := time.Unix(*req.PublishAt, 0)
+        v.PublishAt = &publishAt
     }
     videoId, videoUrl, err := handler.useCase.SubmitVideo(ctx, v, req.Video)
     if err != nil {
@@ -58,25 +67,40 @@ func (handler *VideoHandler) GetVideo(ctx context.Context, req *video.VideoDetai
 
 func (handler *VideoHandler) SearchVideo(ctx context.Context, req *video.VideoSearchRequest) (resp *video.VideoSearchResponse, err error) {
     resp = new(video.VideoSearchResponse)
-    videoList, err := handler.useCase.SearchVideo(ctx, req.Keyword, req.Tags, req.PageNum, req.PageSize)
+    result, err := handler.useCase.SearchVideo(ctx, &model.VideoSearchQuery{
+        Keyword:  req.Keyword,
+        Tags:     req.Tags,
+        TagMode:  req.GetTagMode(),
+        Sort:     req.GetSort(),
+        PageNum:  req.PageNum,
+        PageSize: req.PageSize,
+        Cursor:   req.GetCursor(),
+    })
     if err != nil {
         resp.BaseResp = base.BuildBaseResp(err)
         return
     }
     resp.BaseResp = base.BuildBaseResp(err)
-    resp.Videos = pack.BuildVideoList(videoList)
+    resp.Videos = pack.BuildVideoList(result.Videos)
+    resp.Total = result.Total
+    if result.NextCursor != "" {
+        resp.NextCursor = &result.NextCursor
+    }
     return
 }
 
 func (handler *VideoHandler) TrendVideo(ctx context.Context, req *video.VideoTrendingRequest) (resp *video.VideoTrendingResponse, err error) {
     resp = new(video.VideoTrendingResponse)
-    videoList, err := handler.useCase.TrendVideo(ctx, req.PageNum, req.PageSize)
+    videoList, nextCursor, err := handler.useCase.TrendVideo(ctx, req.GetCursor(), req.PageNum, req.PageSize)
     if err != nil {
         resp.BaseResp = base.BuildBaseResp(err)
         return
     }
     resp.BaseResp = base.BuildBaseResp(err)
     resp.Videos = pack.BuildVideoList(videoList)
+    if nextCursor != "" {
+        resp.NextCursor = &nextCursor
+    }
     return
 }
 
@@ -92,3 +116,320 @@ func (handler *VideoHandler) UpdateVideoHot(ctx context.Context, req *video.Vide
     resp.BaseResp = base.BuildBaseResp(nil)
     return
 }
+
+func (handler *VideoHandler) UpdateVideo(ctx context.Context, req *video.VideoUpdateRequest) (resp *video.VideoUpdateResponse, err error) {
+    resp = new(video.VideoUpdateResponse)
+    var tags *[]string
+    if req.IsSetTags() {
+        tags = &req.Tags
+    }
+    videoProfile, err := handler.useCase.UpdateVideo(ctx, &model.VideoUpdate{
+        VideoID:     req.VideoId,
+        Title:       req.Title,
+        Description: req.Description,
+        CoverURL:    req.CoverUrl,
+        Tags:        tags,
+        Visibility:  req.Visibility,
+    })
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+    resp.BaseResp = base.BuildBaseResp(err)
+    resp.Video = pack.BuildVideo(videoProfile)
+    return
+}
+
+func (handler *VideoHandler) DeleteVideo(ctx context.Context, req *video.VideoDeleteRequest) (resp *video.VideoDeleteResponse, err error) {
+    resp = new(video.VideoDeleteResponse)
+
+    err = handler.useCase.DeleteVideo(ctx, req.VideoId)
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+
+    resp.BaseResp = base.BuildBaseResp(nil)
+    return
+}
+
+func (handler *VideoHandler) ListDrafts(ctx context.Context, req *video.VideoDraftListRequest) (resp *video.VideoDraftListResponse, err error) {
+    resp = new(video.VideoDraftListResponse)
+    videoList, err := handler.useCase.ListDrafts(ctx, req.PageNum, req.PageSize)
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+    resp.BaseResp = base.BuildBaseResp(err)
+    resp.Videos = pack.BuildVideoList(videoList)
+    return
+}
+
+func (handler *VideoHandler) ScheduleVideo(ctx context.Context, req *video.VideoScheduleRequest) (resp *video.VideoScheduleResponse, err error) {
+    resp = new(video.VideoScheduleResponse)
+    videoProfile, err := handler.useCase.ScheduleVideo(ctx, req.VideoId, req.PublishAt)
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+    resp.BaseResp = base.BuildBaseResp(err)
+    resp.Video = pack.BuildVideo(videoProfile)
+    return
+}
+
+func (handler *VideoHandler) ListVideosByTag(ctx context.Context, req *video.VideoTagListRequest) (resp *video.VideoTagListResponse, err error) {
+    resp = new(video.VideoTagListResponse)
+    videoList, err := handler.useCase.ListVideosByTag(ctx, req.Tag, req.PageNum, req.PageSize)
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+    resp.BaseResp = base.BuildBaseResp(err)
+    resp.Videos = pack.BuildVideoList(videoList)
+    return
+}
+
+func (handler *VideoHandler) PopularTags(ctx context.Context, req *video.PopularTagsRequest) (resp *video.PopularTagsResponse, err error) {
+    resp = new(video.PopularTagsResponse)
+    tags, err := handler.useCase.PopularTags(ctx, req.GetLimit())
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+    resp.BaseResp = base.BuildBaseResp(err)
+    resp.Tags = pack.BuildTagCountList(tags)
+    return
+}
+
+func (handler *VideoHandler) SuggestSearch(ctx context.Context, req *video.SearchSuggestRequest) (resp *video.SearchSuggestResponse, err error) {
+    resp = new(video.SearchSuggestResponse)
+    suggestions, err := handler.useCase.SuggestSearch(ctx, req.Prefix, req.GetLimit())
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+    resp.BaseResp = base.BuildBaseResp(err)
+    resp.Suggestions = suggestions
+    return
+}
+
+func (handler *VideoHandler) TrendingSearches(ctx context.Context, req *video.TrendingSearchesRequest) (resp *video.TrendingSearchesResponse, err error) {
+    resp = new(video.TrendingSearchesResponse)
+    trends, err := handler.useCase.TrendingSearches(ctx, req.GetLimit())
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+    resp.BaseResp = base.BuildBaseResp(err)
+    resp.Searches = pack.BuildSearchTrendList(trends)
+    return
+}
+
+func (handler *VideoHandler) ListSearchHistory(ctx context.Context, req *video.SearchHistoryListRequest) (resp *video.SearchHistoryListResponse, err error) {
+    resp = new(video.SearchHistoryListResponse)
+    keywords, err := handler.useCase.ListSearchHistory(ctx)
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+    resp.BaseResp = base.BuildBaseResp(err)
+    resp.Keywords = keywords
+    return
+}
+
+func (handler *VideoHandler) DeleteSearchHistoryItem(ctx context.Context, req *video.SearchHistoryDeleteRequest) (resp *video.SearchHistoryDeleteResponse, err error) {
+    resp = new(video.SearchHistoryDeleteResponse)
+    err = handler.useCase.DeleteSearchHistoryItem(ctx, req.Keyword)
+    resp.BaseResp = base.BuildBaseResp(err)
+    return
+}
+
+func (handler *VideoHandler) ClearSearchHistory(ctx context.Context, req *video.SearchHistoryClearRequest) (resp *video.SearchHistoryClearResponse, err error) {
+    resp = new(video.SearchHistoryClearResponse)
+    err = handler.useCase.ClearSearchHistory(ctx)
+    resp.BaseResp = base.BuildBaseResp(err)
+    return
+}
+
+func (handler *VideoHandler) GetVideoProcessingStatus(ctx context.Context, req *video.VideoProcessingStatusRequest) (resp *video.VideoProcessingStatusResponse, err error) {
+    resp = new(video.VideoProcessingStatusResponse)
+    processing, err := handler.useCase.GetVideoProcessingStatus(ctx, req.VideoId)
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+    resp.BaseResp = base.BuildBaseResp(err)
+    resp.Status = pack.BuildVideoProcessingStatus(processing)
+    return
+}
+
+func (handler *VideoHandler) InitVideoUpload(ctx context.Context, req *video.InitVideoUploadRequest) (resp *video.InitVideoUploadResponse, err error) {
+    resp = new(video.InitVideoUploadResponse)
+    var cover string
+    if req.CoverUrl != nil {
+        cover = *req.CoverUrl
+    } else {
+        cover = constants.DefaultVideoCoverUrl
+    }
+    v := &model.Video{
+        Title:           req.Title,
+        Description:     req.Description,
+        DurationSeconds: req.DurationSeconds,
+        CoverURL:        cover,
+        Tags:            req.Tags,
+    }
+    if req.IsDraft != nil && *req.IsDraft {
+        v.Status = constants.VideoStatusDraft
+    }
+    if req.PublishAt != nil && *req.PublishAt > 0 {
+        publishAt := time.Unix(*req.PublishAt, 0)
+        v.PublishAt = &publishAt
+    }
+    progress, err := handler.useCase.InitVideoUpload(ctx, v, req.FileSize)
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+    resp.BaseResp = base.BuildBaseResp(err)
+    resp.Upload = pack.BuildVideoUpload(progress)
+    return
+}
+
+func (handler *VideoHandler) UploadVideoChunk(ctx context.Context, req *video.UploadVideoChunkRequest) (resp *video.UploadVideoChunkResponse, err error) {
+    resp = new(video.UploadVideoChunkResponse)
+    err = handler.useCase.UploadVideoChunk(ctx, req.UploadId, req.PartNumber, req.Checksum, req.Data)
+    resp.BaseResp = base.BuildBaseResp(err)
+    return
+}
+
+func (handler *VideoHandler) GetVideoUpload(ctx context.Context, req *video.GetVideoUploadRequest) (resp *video.GetVideoUploadResponse, err error) {
+    resp = new(video.GetVideoUploadResponse)
+    progress, err := handler.useCase.GetVideoUpload(ctx, req.UploadId)
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+    resp.BaseResp = base.BuildBaseResp(err)
+    resp.Upload = pack.BuildVideoUpload(progress)
+    return
+}
+
+func (handler *VideoHandler) CompleteVideoUpload(ctx context.Context, req *video.CompleteVideoUploadRequest) (resp *video.CompleteVideoUploadResponse, err error) {
+    resp = new(video.CompleteVideoUploadResponse)
+    videoId, videoUrl, err := handler.useCase.CompleteVideoUpload(ctx, req.UploadId)
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+    resp.BaseResp = base.BuildBaseResp(err)
+    resp.VideoId = videoId
+    resp.VideoUrl = videoUrl
+    return
+}
+
+func (handler *VideoHandler) AbortVideoUpload(ctx context.Context, req *video.AbortVideoUploadRequest) (resp *video.AbortVideoUploadResponse, err error) {
+    resp = new(video.AbortVideoUploadResponse)
+    err = handler.useCase.AbortVideoUpload(ctx, req.UploadId)
+    resp.BaseResp = base.BuildBaseResp(err)
+    return
+}
+
+func (handler *VideoHandler) InitDirectUpload(ctx context.Context, req *video.InitDirectUploadRequest) (resp *video.InitDirectUploadResponse, err error) {
+    resp = new(video.InitDirectUploadResponse)
+    var cover string
+    if req.CoverUrl != nil {
+        cover = *req.CoverUrl
+    } else {
+        cover = constants.DefaultVideoCoverUrl
+    }
+    v := &model.Video{
+        Title:           req.Title,
+        Description:     req.Description,
+        DurationSeconds: req.DurationSeconds,
+        CoverURL:        cover,
+        Tags:            req.Tags,
+    }
+    if req.IsDraft != nil && *req.IsDraft {
+        v.Status = constants.VideoStatusDraft
+    }
+    if req.PublishAt != nil && *req.PublishAt > 0 {
+        publishAt := time.Unix(*req.PublishAt, 0)
+        v.PublishAt = &publishAt
+    }
+    session, presigned, err := handler.useCase.InitDirectUpload(ctx, v, req.FileSize)
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+    resp.BaseResp = base.BuildBaseResp(err)
+    resp.Upload = pack.BuildDirectUpload(session, presigned)
+    return
+}
+
+func (handler *VideoHandler) CompleteDirectUpload(ctx context.Context, req *video.CompleteDirectUploadRequest) (resp *video.CompleteDirectUploadResponse, err error) {
+    resp = new(video.CompleteDirectUploadResponse)
+    videoId, videoUrl, err := handler.useCase.CompleteDirectUpload(ctx, req.UploadId)
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+    resp.BaseResp = base.BuildBaseResp(err)
+    resp.VideoId = videoId
+    resp.VideoUrl = videoUrl
+    return
+}
+
+func (handler *VideoHandler) GetVideoObject(ctx context.Context, req *video.GetVideoObjectRequest) (resp *video.GetVideoObjectResponse, err error) {
+    resp = new(video.GetVideoObjectResponse)
+    objectKey, err := handler.useCase.GetVideoObject(ctx, req.VideoId)
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+    resp.BaseResp = base.BuildBaseResp(err)
+    resp.Bucket = constants.VideoBucket
+    resp.ObjectKey = objectKey
+    return
+}
+
+func (handler *VideoHandler) ListUserVideos(ctx context.Context, req *video.UserVideoListRequest) (resp *video.UserVideoListResponse, err error) {
+    resp = new(video.UserVideoListResponse)
+    videoList, nextCursor, err := handler.useCase.ListUserVideos(ctx, req.UserId, req.GetCursor(), req.GetLimit(), req.GetSort())
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+    resp.BaseResp = base.BuildBaseResp(err)
+    resp.Videos = pack.BuildVideoList(videoList)
+    if nextCursor != "" {
+        resp.NextCursor = &nextCursor
+    }
+    return
+}
+
+func (handler *VideoHandler) GetFeed(ctx context.Context, req *video.FeedRequest) (resp *video.FeedResponse, err error) {
+    resp = new(video.FeedResponse)
+    videoList, nextCursor, err := handler.useCase.GetFeed(ctx, req.GetCursor(), req.GetLimit())
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+    resp.BaseResp = base.BuildBaseResp(err)
+    resp.Videos = pack.BuildVideoList(videoList)
+    if nextCursor != "" {
+        resp.NextCursor = &nextCursor
+    }
+    return
+}
+
+func (handler *VideoHandler) RelatedVideos(ctx context.Context, req *video.RelatedVideosRequest) (resp *video.RelatedVideosResponse, err error) {
+    resp = new(video.RelatedVideosResponse)
+    videoList, err := handler.useCase.RelatedVideos(ctx, req.VideoId, req.GetLimit())
+    if err != nil {
+        resp.BaseResp = base.BuildBaseResp(err)
+        return
+    }
+    resp.BaseResp = base.BuildBaseResp(err)
+    resp.Videos = pack.BuildVideoList(videoList)
+    return
+}
@@ -6,8 +6,9 @@ import (
 )
 
 // BuildVideo 将 entities 定义的 Video 实体转换成 idl 定义的 RPC 交流实体，类似 dto
+// 数据库中只保存对象名，VideoURL 需要先在 usecase 中换成带签名的播放地址
 func BuildVideo(video *dmodel.VideoProfile) *kmodel.Video {
-	return &kmodel.Video{
+	v := &kmodel.Video{
 		VideoId:         video.VideoID,
 		UserId:          video.UserID,
 		Title:           video.Title,
@@ -20,7 +21,21 @@ func BuildVideo(video *dmodel.VideoProfile) *kmodel.Video {
 		Comments:        video.Comments,
 		HotScore:        video.HotScore,
 		CreatedAt:       video.CreatedAt,
+		Tags:            video.Tags,
 	}
+	if video.Status != "" {
+		v.Status = &video.Status
+	}
+	if video.PublishAt > 0 {
+		v.PublishAt = &video.PublishAt
+	}
+	if video.ProcessingStatus != "" {
+		v.ProcessingStatus = &video.ProcessingStatus
+	}
+	if video.Visibility != "" {
+		v.Visibility = &video.Visibility
+	}
+	return v
 }
 
 // BuildVideoList 构建视频列表
@@ -31,3 +46,64 @@ func BuildVideoList(videoList []*dmodel.VideoProfile) []*kmodel.Video {
 	}
 	return resp
 }
+
+// BuildTagCountList 构建热门标签列表
+func BuildTagCountList(tags []*dmodel.TagCount) []*kmodel.TagCount {
+	resp := make([]*kmodel.TagCount, 0, len(tags))
+	for _, t := range tags {
+		resp = append(resp, &kmodel.TagCount{
+			Tag:   t.Tag,
+			Count: t.Count,
+		})
+	}
+	return resp
+}
+
+// BuildSearchTrendList 构建热搜榜
+func BuildSearchTrendList(trends []*dmodel.SearchTrend) []*kmodel.SearchTrend {
+	resp := make([]*kmodel.SearchTrend, 0, len(trends))
+	for _, t := range trends {
+		resp = append(resp, &kmodel.SearchTrend{
+			Keyword: t.Keyword,
+			Score:   t.Score,
+		})
+	}
+	return resp
+}
+
+// BuildVideoProcessingStatus 构建视频处理状态
+func BuildVideoProcessingStatus(processing *dmodel.VideoProcessing) *kmodel.VideoProcessingStatus {
+	return &kmodel.VideoProcessingStatus{
+		VideoId:    processing.VideoID,
+		Status:     processing.Status,
+		Progress:   processing.Progress,
+		FailReason: processing.FailReason,
+		UpdatedAt:  processing.UpdatedAt.Unix(),
+	}
+}
+
+// BuildVideoUpload 构建分片上传进度
+func BuildVideoUpload(progress *dmodel.UploadProgress) *kmodel.VideoUpload {
+	uploaded := make([]int64, 0, len(progress.Chunks))
+	for _, chunk := range progress.Chunks {
+		uploaded = append(uploaded, chunk.PartNumber)
+	}
+	return &kmodel.VideoUpload{
+		UploadId:       progress.Session.UploadID,
+		FileSize:       progress.Session.FileSize,
+		ChunkSize:      progress.Session.ChunkSize,
+		TotalChunks:    progress.Session.TotalChunks,
+		UploadedChunks: uploaded,
+		ExpiresAt:      progress.Session.ExpiresAt,
+	}
+}
+
+// BuildDirectUpload 构建直传表单
+func BuildDirectUpload(session *dmodel.UploadSession, presigned *dmodel.PresignedUpload) *kmodel.DirectUpload {
+	return &kmodel.DirectUpload{
+		UploadId:  session.UploadID,
+		Url:       presigned.URL,
+		FormData:  presigned.FormData,
+		ExpiresAt: presigned.ExpiresAt.Unix(),
+	}
+}
@@ -0,0 +1,61 @@
+package model
+
+import "time"
+
+// ProcessingTask 是投稿后发送到 Kafka 的处理任务
+type ProcessingTask struct {
+	VideoID int64  `json:"video_id"`
+	UserID  int64  `json:"user_id"`
+	Object  string `json:"object"` // 原始文件在 video 桶中的对象名
+}
+
+// VideoProcessing 对应 video_processing 表的一行
+type VideoProcessing struct {
+	VideoID    int64     `json:"video_id" gorm:"primaryKey;column:video_id"`
+	Status     string    `json:"status" gorm:"column:status"`     // uploaded/processing/ready/failed
+	Progress   int64     `json:"progress" gorm:"column:progress"` // 0-100
+	FailReason string    `json:"fail_reason" gorm:"column:fail_reason"`
+	Attempts   int64     `json:"attempts" gorm:"column:attempts"`
+	CreatedAt  time.Time `json:"created_at" gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt  time.Time `json:"updated_at" gorm:"column:updated_at;autoUpdateTime"`
+}
+
+func (VideoProcessing) TableName() string {
+	return "video_processing"
+}
+
+// VideoRendition 对应 video_renditions 表的一行，是一个清晰度的转码产物
+type VideoRendition struct {
+	VideoID   int64     `json:"video_id" gorm:"primaryKey;column:video_id"`
+	Name      string    `json:"name" gorm:"primaryKey;column:name"`
+	ObjectKey string    `json:"object_key" gorm:"column:object_key"`
+	Width     int64     `json:"width" gorm:"column:width"`
+	Height    int64     `json:"height" gorm:"column:height"`
+	Bitrate   int64     `json:"bitrate" gorm:"column:bitrate"`
+	Size      int64     `json:"size" gorm:"column:size"`
+	CreatedAt time.Time `json:"created_at" gorm:"column:created_at;autoCreateTime"`
+	LocalPath string    `json:"-" gorm:"-"` // 处理过程中产物的本地路径，上传后不再使用
+}
+
+func (VideoRendition) TableName() string {
+	return "video_renditions"
+}
+
+// RenditionProfile 是一种转码规格，宽度按原视频的宽高比计算
+type RenditionProfile struct {
+	Name         string
+	Height       int64
+	VideoBitrate int64 // bit/s
+	AudioBitrate int64 // bit/s
+}
+
+// ProbeResult 是探测视频文件得到的元数据
+type ProbeResult struct {
+	FormatName      string  // 容器格式，如 mov,mp4,m4a,3gp,3g2,mj2
+	DurationSeconds float64 // 时长
+	Bitrate         int64   // 总码率 bit/s
+	Width           int64
+	Height          int64
+	VideoCodec      string // 没有视频流时为空
+	AudioCodec      string // 没有音频流时为空
+}
@@ -0,0 +1,39 @@
+package model
+
+import "time"
+
+// UploadSession 是一次分片上传或直传的会话，保存在 Redis 中
+type UploadSession struct {
+	UploadID    int64  `json:"upload_id"`
+	UserID      int64  `json:"user_id"`
+	MultipartID string `json:"multipart_id"` // MinIO 分片上传的 upload id
+	Object      string `json:"object"`       // 暂存桶中的对象名
+	FileSize    int64  `json:"file_size"`
+	ChunkSize   int64  `json:"chunk_size"`
+	TotalChunks int64  `json:"total_chunks"`
+	Assembled   bool   `json:"assembled"` // 分片已经合并成暂存对象，重试合并时跳过这一步
+	Direct      bool   `json:"direct"`    // 客户端直传整个文件，没有分片
+	Video       *Video `json:"video"`     // 初始化时提交的视频信息，合并完成后据此创建视频
+	ExpiresAt   int64  `json:"expires_at"`
+}
+
+// UploadedChunk 是一个已经上传的分片
+type UploadedChunk struct {
+	PartNumber int64  `json:"part_number"`
+	ETag       string `json:"etag"`
+	Checksum   string `json:"checksum"` // SHA-256，十六进制
+	Size       int64  `json:"size"`
+}
+
+// UploadProgress 是上传会话以及已经上传的分片
+type UploadProgress struct {
+	Session *UploadSession
+	Chunks  []*UploadedChunk // 按分片编号排序
+}
+
+// PresignedUpload 是客户端直传对象存储使用的预签名表单
+type PresignedUpload struct {
+	URL       string
+	FormData  map[string]string // 需要原样放进表单的字段
+	ExpiresAt time.Time
+}
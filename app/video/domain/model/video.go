@@ -4,17 +4,21 @@ import "time"
 
 // Video 定义了用于存取数据库的核心视频结构
 type Video struct {
-	VideoID         int64      `json:"video_id" gorm:"primaryKey;column:video_id"`      // 视频ID
-	UserID          int64      `json:"user_id" gorm:"column:user_id"`                   // 作者用户ID
-	Title           string     `json:"title" gorm:"column:title"`                       // 视频标题
-	Description     string     `json:"description" gorm:"column:description"`           // 视频描述
-	CoverURL        string     `json:"cover_url" gorm:"column:cover_url"`               // 封面图URL
-	VideoURL        string     `json:"video_url" gorm:"column:video_url"`               // 视频播放URL
-	DurationSeconds int64      `json:"duration_seconds" gorm:"column:duration_seconds"` // 视频时长（单位：秒）
-	Status          string     `json:"status" gorm:"column:status;default:published"`   // 状态：published/deleted/draft
-	CreatedAt       time.Time  `json:"created_at" gorm:"column:created_at"`             // 发布时间
-	UpdatedAt       time.Time  `json:"updated_at" gorm:"column:updated_at"`             // 更新时间
-	DeletedAt       *time.Time `json:"deleted_at,omitempty" gorm:"column:deleted_at"`   // 逻辑删除时间，可空
+	VideoID         int64          `json:"video_id" gorm:"primaryKey;column:video_id"`         // 视频ID
+	UserID          int64          `json:"user_id" gorm:"column:user_id"`                      // 作者用户ID
+	Title           string         `json:"title" gorm:"column:title"`                          // 视频标题
+	Description     string         `json:"description" gorm:"column:description"`              // 视频描述
+	CoverURL        string         `json:"cover_url" gorm:"column:cover_url"`                  // 封面图URL
+	VideoURL        string         `json:"video_url" gorm:"column:video_url"`                  // 视频文件的对象名，播放地址按请求签名生成
+	DurationSeconds int64          `json:"duration_seconds" gorm:"column:duration_seconds"`    // 视频时长（单位：秒）
+	Status          string         `json:"status" gorm:"column:status;default:published"`      // 状态：published/deleted/draft
+	Visibility      string         `json:"visibility" gorm:"column:visibility;default:public"` // 可见范围：public/unlisted/private/followers
+	PublishAt       *time.Time     `json:"publish_at,omitempty" gorm:"column:publish_at"`      // 草稿的定时发布时间，可空
+	CreatedAt       time.Time      `json:"created_at" gorm:"column:created_at"`                // 发布时间
+	UpdatedAt       time.Time      `json:"updated_at" gorm:"column:updated_at"`                // 更新时间
+	DeletedAt       *time.Time     `json:"deleted_at,omitempty" gorm:"column:deleted_at"`      // 逻辑删除时间，可空
+	Tags            []string       `json:"tags" gorm:"-"`                                      // 视频标签，存放在 video_tags 表
+	Metadata        *VideoMetadata `json:"metadata,omitempty" gorm:"-"`                        // 投稿时探测的文件元数据，存放在 video_metadata 表
 }
 
 func (Video) TableName() string {
@@ -35,18 +39,126 @@ func (VideoStat) TableName() string {
 	return "video_stats"
 }
 
+// VideoMetadata 是投稿时从 MP4 文件中解析出的元数据
+type VideoMetadata struct {
+	VideoID    int64     `json:"video_id" gorm:"primaryKey;column:video_id"`
+	MajorBrand string    `json:"major_brand" gorm:"column:major_brand"`
+	DurationMs int64     `json:"duration_ms" gorm:"column:duration_ms"`
+	Width      int64     `json:"width" gorm:"column:width"`
+	Height     int64     `json:"height" gorm:"column:height"`
+	VideoCodec string    `json:"video_codec" gorm:"column:video_codec"`
+	AudioCodec string    `json:"audio_codec" gorm:"column:audio_codec"` // 没有音频轨时为空
+	Bitrate    int64     `json:"bitrate" gorm:"column:bitrate"`         // bit/s
+	Size       int64     `json:"size" gorm:"column:size"`               // 字节
+	FastStart  bool      `json:"faststart" gorm:"column:faststart"`     // 存储的文件 moov 是否位于 mdat 之前
+	CreatedAt  time.Time `json:"created_at" gorm:"column:created_at;autoCreateTime"`
+}
+
+func (VideoMetadata) TableName() string {
+	return "video_metadata"
+}
+
 // VideoProfile 聚合了视频主信息 + 统计信息，供展示用
 type VideoProfile struct {
-	VideoID         int64   `json:"video_id"`
-	UserID          int64   `json:"user_id"`
-	Title           string  `json:"title"`
-	Description     string  `json:"description"`
-	CoverURL        string  `json:"cover_url"`
-	VideoURL        string  `json:"video_url"`
-	DurationSeconds int64   `json:"duration_seconds"`
-	Views           int64   `json:"views"`
-	Likes           int64   `json:"likes"`
-	Comments        int64   `json:"comments"`
-	HotScore        float64 `json:"hot_score"`
-	CreatedAt       int64   `json:"created_at"` // 用时间戳方便前端
+	VideoID         int64    `json:"video_id"`
+	UserID          int64    `json:"user_id"`
+	Title           string   `json:"title"`
+	Description     string   `json:"description"`
+	CoverURL        string   `json:"cover_url"`
+	VideoURL        string   `json:"video_url"`
+	DurationSeconds int64    `json:"duration_seconds"`
+	Views           int64    `json:"views"`
+	Likes           int64    `json:"likes"`
+	Comments        int64    `json:"comments"`
+	HotScore        float64  `json:"hot_score"`
+	CreatedAt       int64    `json:"created_at"` // 用时间戳方便前端
+	Status          string   `json:"status"`
+	PublishAt       int64    `json:"publish_at"` // 草稿的定时发布时间戳，0 表示未设置
+	Tags            []string `json:"tags" gorm:"-"`
+
+	ProcessingStatus string `json:"processing_status"` // 处理状态，未就绪的视频只有作者本人能看到
+	Visibility       string `json:"visibility"`        // 可见范围，为空的是可见范围上线之前写入的缓存，视为公开
+}
+
+// VideoUpdate 是作者编辑视频时提交的字段，nil 表示该字段不修改
+type VideoUpdate struct {
+	VideoID     int64
+	Title       *string
+	Description *string
+	CoverURL    *string
+	Tags        *[]string // 非 nil 时整体替换视频的标签，空切片表示清空
+	Visibility  *string
+}
+
+// VideoTag 对应 video_tags 表的一行
+type VideoTag struct {
+	TagID     int64     `json:"tag_id" gorm:"primaryKey;column:tag_id"`
+	VideoID   int64     `json:"video_id" gorm:"column:video_id"`
+	Tag       string    `json:"tag" gorm:"column:tag"`
+	CreatedAt time.Time `json:"created_at" gorm:"column:created_at;autoCreateTime"`
+}
+
+func (VideoTag) TableName() string {
+	return "video_tags"
+}
+
+// TagCount 是标签及使用该标签的已发布视频数
+type TagCount struct {
+	Tag   string `json:"tag"`
+	Count int64  `json:"count"`
+}
+
+// SearchTrend 是热搜榜上的一个搜索词，Score 是按时间衰减后的热度
+type SearchTrend struct {
+	Keyword string  `json:"keyword"`
+	Score   float64 `json:"score"`
+}
+
+// VideoSearchQuery 是视频搜索的条件
+type VideoSearchQuery struct {
+	Keyword  string
+	Tags     []string
+	TagMode  string // any / all
+	Sort     string // relevance / newest / most_viewed
+	PageNum  int64
+	PageSize int64
+	Cursor   string // 上一页返回的游标，不为空时忽略 PageNum
+}
+
+// UserVideoQuery 是作者视频列表的查询条件，按排序字段和视频 ID 降序翻页
+type UserVideoQuery struct {
+	UserID       int64
+	Sort         string // newest / most_viewed / most_liked
+	Limit        int
+	After        *UserVideoCursor // 上一页最后一个视频的位置，nil 表示第一页
+	IncludeAll   bool             // 作者本人查看，包括草稿、未处理完成和不公开的视频
+	Visibilities []string         // IncludeAll 为 false 时能看到的可见范围
+}
+
+// UserVideoCursor 是作者视频列表中一个视频的位置：排序字段的值（发布时间戳、播放量或点赞数）和视频 ID
+type UserVideoCursor struct {
+	Value   int64
+	VideoID int64
+}
+
+// FeedItem 是关注流中的一个视频，也用作关注流的游标
+type FeedItem struct {
+	VideoID     int64 `json:"id"`
+	PublishedAt int64 `json:"t"` // 发布时间(毫秒)
+}
+
+// RelatedCandidate 是一个视频的相关视频候选，以及计算相关度用到的信号
+type RelatedCandidate struct {
+	VideoID    int64
+	SharedTags int64   // 共同标签数
+	SameAuthor bool    // 是否同一作者
+	CoLikes    int64   // 同时点赞了两个视频的人数
+	Score      float64 // 相关度
+}
+
+// VideoSearchResult 是一页搜索结果以及命中总数
+type VideoSearchResult struct {
+	Videos     []*VideoProfile `json:"videos"`
+	Total      int64           `json:"total"`
+	NextCursor string          `json:"next_cursor,omitempty"` // 下一页的游标，缓存中的结果没有游标
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/redis/go-redis/v9"
@@ -12,11 +13,30 @@ import (
 type VideoDB interface {
 	StoreVideo(ctx context.Context, video *dmodel.Video) error
 	GetVideoDB(ctx context.Context, videoId int64) (*dmodel.VideoProfile, error)
-	SearchVideo(ctx context.Context, keyword string, tags []string, num int64, size int64) ([]*dmodel.VideoProfile, error)
+	SearchVideoIDs(ctx context.Context, query *dmodel.VideoSearchQuery, limit int) ([]int64, int64, error)
 	TrendVideo(ctx context.Context, num int64, size int64) ([]*dmodel.VideoProfile, error)
 	StoreVideoStats(ctx context.Context, stat *dmodel.VideoStat) error
 	UpdateViews(ctx context.Context, videoID int64, views int64) error
 	UpdateHotScore(ctx context.Context, videoID int64, score float64) error
+	UpdateVideo(ctx context.Context, update *dmodel.VideoUpdate) error
+	DeleteVideo(ctx context.Context, videoID int64) error
+	ListDrafts(ctx context.Context, uid int64, offset, limit int64) ([]*dmodel.VideoProfile, error)
+	ScheduleDraft(ctx context.Context, videoID int64, publishAt time.Time) error
+	ListDueDrafts(ctx context.Context, now time.Time, limit int) ([]int64, error)
+	PublishDraft(ctx context.Context, videoID int64, publishedAt time.Time, stat *dmodel.VideoStat) (bool, error)
+	ListVideosByTag(ctx context.Context, tag string, pageNum, pageSize int64) ([]*dmodel.VideoProfile, error)
+	PopularTags(ctx context.Context, limit int) ([]*dmodel.TagCount, error)
+	ListIndexableVideos(ctx context.Context, afterID int64, limit int) ([]*dmodel.VideoProfile, error)
+	ListUpdatedVideoIDs(ctx context.Context, since time.Time) ([]int64, error)
+	ListUserVideos(ctx context.Context, query *dmodel.UserVideoQuery) ([]*dmodel.VideoProfile, error)
+	// ListRelatedCandidates 查询和 source 有共同标签、同一作者或共同点赞的公开视频，每种信号最多 limit 个
+	ListRelatedCandidates(ctx context.Context, source *dmodel.VideoProfile, limit int) ([]*dmodel.RelatedCandidate, error)
+	GetProcessing(ctx context.Context, videoID int64) (*dmodel.VideoProcessing, error)
+	StartProcessing(ctx context.Context, videoID int64) error
+	UpdateProcessingProgress(ctx context.Context, videoID int64, progress int64) error
+	FailProcessing(ctx context.Context, videoID int64, reason string) error
+	FinishProcessing(ctx context.Context, videoID int64, renditions []*dmodel.VideoRendition) error
+	ListRenditionObjectKeys(ctx context.Context, videoID int64) ([]string, error)
 }
 
 type VideoRedis interface {
@@ -27,9 +47,105 @@ type VideoRedis interface {
 	ScanViewKeys(ctx context.Context) ([]string, error)
 	UpdateHotRank(ctx context.Context, videoID int64, hotScore float64) error
 	GetHotRankRange(ctx context.Context, start, end int64) ([]redis.Z, error)
-	SetSearchCache(ctx context.Context, key string, data []*dmodel.VideoProfile, ttl time.Duration) error
-	GetSearchCache(ctx context.Context, key string) ([]*dmodel.VideoProfile, error)
+	SetSearchCache(ctx context.Context, key string, data *dmodel.VideoSearchResult, ttl time.Duration) error
+	GetSearchCache(ctx context.Context, key string) (*dmodel.VideoSearchResult, error)
 	GetLikes(ctx context.Context, videoID int64) (int64, error)
+	DeleteVideoRedis(ctx context.Context, videoID int64) error
+	RemoveHotRank(ctx context.Context, videoID int64) error
+	GetSearchCacheVersion(ctx context.Context) (int64, error)
+	BumpSearchCacheVersion(ctx context.Context) error
+	AddPendingPurge(ctx context.Context, objectKey string, purgeAt time.Time) error
+	GetDuePurges(ctx context.Context, now time.Time, limit int64) ([]string, error)
+	RemovePendingPurge(ctx context.Context, objectKey string) error
+	GetPopularTagsCache(ctx context.Context) ([]*dmodel.TagCount, error)
+	SetPopularTagsCache(ctx context.Context, tags []*dmodel.TagCount, ttl time.Duration) error
+	PublishIndexEvent(ctx context.Context, videoID int64) error
+	SubscribeIndexEvents(ctx context.Context) (<-chan int64, error)
+	AddSuggestion(ctx context.Context, prefixes []string, phrase string, weight float64) error
+	GetSuggestions(ctx context.Context, prefix string, limit int64) ([]string, error)
+	IncrTrendingSearch(ctx context.Context, keyword string) error
+	GetTrendingSearches(ctx context.Context, limit int64) ([]*dmodel.SearchTrend, error)
+	DecayTrendingSearches(ctx context.Context, now time.Time) (bool, error)
+	AddSearchHistory(ctx context.Context, uid int64, keyword string) error
+	GetSearchHistory(ctx context.Context, uid int64) ([]string, error)
+	RemoveSearchHistory(ctx context.Context, uid int64, keyword string) error
+	ClearSearchHistory(ctx context.Context, uid int64) error
+	SetUploadSession(ctx context.Context, session *dmodel.UploadSession, ttl time.Duration) error
+	GetUploadSession(ctx context.Context, uploadID int64) (*dmodel.UploadSession, error)
+	AddUploadedChunk(ctx context.Context, uploadID int64, chunk *dmodel.UploadedChunk, ttl time.Duration) error
+	GetUploadedChunks(ctx context.Context, uploadID int64) ([]*dmodel.UploadedChunk, error)
+	LockUpload(ctx context.Context, uploadID int64, ttl time.Duration) (bool, error)
+	UnlockUpload(ctx context.Context, uploadID int64) error
+	IsUploadLocked(ctx context.Context, uploadID int64) (bool, error)
+	DeleteUploadSession(ctx context.Context, uploadID int64) error
+	SetPlaybackURL(ctx context.Context, objectKey, url string, ttl time.Duration) error
+	GetPlaybackURL(ctx context.Context, objectKey string) (string, error)
+	GetHotScores(ctx context.Context, videoIDs []int64) ([]float64, error)
+	SetPageSnapshot(ctx context.Context, snapshotID string, videoIDs []int64, ttl time.Duration) error
+	GetPageSnapshot(ctx context.Context, snapshotID string, start, stop int64) ([]int64, error)
+	PushFeed(ctx context.Context, uids []int64, item *dmodel.FeedItem) error
+	PushOutbox(ctx context.Context, authorID int64, item *dmodel.FeedItem) error
+	FilterBigCreators(ctx context.Context, uids []int64) ([]int64, error)
+	// RangeFeed 按发布时间倒序读取收件箱和这些作者的发件箱中 before 之前的视频，每个来源最多 limit 个
+	RangeFeed(ctx context.Context, uid int64, authors []int64, before *dmodel.FeedItem, limit int64) ([]*dmodel.FeedItem, error)
+	LockRelatedRefresh(ctx context.Context, ttl time.Duration) (bool, error)
+	SetRelatedVideos(ctx context.Context, videoID int64, related []*dmodel.RelatedCandidate, ttl time.Duration) error
+	// GetRelatedVideos 按相关度从高到低返回视频的相关视频，还没有计算过时返回空切片
+	GetRelatedVideos(ctx context.Context, videoID int64) ([]int64, error)
+	AddWatchHistory(ctx context.Context, uid, videoID int64, watchedAt time.Time) error
+	// FilterWatched 返回 videoIDs 中 uid 没有看过的视频，保持原来的顺序
+	FilterWatched(ctx context.Context, uid int64, videoIDs []int64) ([]int64, error)
 }
 
-type VideoRPC interface{}
+// VideoSearchEngine 是进程内的视频搜索引擎，只收录已发布的视频
+type VideoSearchEngine interface {
+	Index(video *dmodel.VideoProfile)
+	Remove(videoID int64)
+	UpdateViews(videoID, views int64)
+	Search(query *dmodel.VideoSearchQuery) ([]int64, int64)
+	Len() int
+	SaveSnapshot(ctx context.Context) error
+	LoadSnapshot(ctx context.Context) (time.Time, error)
+}
+
+// VideoTranscoder 探测和转码视频文件，输入输出都是本地路径
+type VideoTranscoder interface {
+	Probe(ctx context.Context, path string) (*dmodel.ProbeResult, error)
+	// Transcode 转码时通过 progress 报告已经处理的秒数，progress 可以为 nil
+	Transcode(ctx context.Context, input, output string, profile *dmodel.RenditionProfile, progress func(seconds float64)) error
+}
+
+// VideoObjectStore 在对象存储和本地文件之间传输视频，并支持分片上传和直传
+type VideoObjectStore interface {
+	Download(ctx context.Context, bucket, object, path string) error
+	Upload(ctx context.Context, bucket, object, path, contentType string) (size int64, err error)
+	NewMultipartUpload(ctx context.Context, bucket, object, contentType string) (multipartID string, err error)
+	// UploadPart 上传一个分片，sha256Hex 由对象存储再校验一次
+	UploadPart(ctx context.Context, bucket, object, multipartID string, partNumber int64, data []byte, sha256Hex string) (etag string, err error)
+	CompleteMultipartUpload(ctx context.Context, bucket, object, multipartID string, chunks []*dmodel.UploadedChunk) error
+	AbortMultipartUpload(ctx context.Context, bucket, object, multipartID string) error
+	// Open 文件不存在时返回 os.ErrNotExist
+	Open(ctx context.Context, bucket, object string) (VideoObject, error)
+	Put(ctx context.Context, bucket, object string, reader io.Reader, size int64, contentType string) error
+	Copy(ctx context.Context, srcBucket, srcObject, dstBucket, dstObject string) error
+	Remove(ctx context.Context, bucket, object string) error
+	// PresignUpload 生成直传表单，只允许上传 contentType 类型、大小恰好为 size 的文件
+	PresignUpload(ctx context.Context, bucket, object, contentType string, size int64, expiresAt time.Time) (*dmodel.PresignedUpload, error)
+	// PresignGet 生成带签名的下载地址，ttl 之后失效
+	PresignGet(ctx context.Context, bucket, object string, ttl time.Duration) (string, error)
+}
+
+// VideoObject 是对象存储中可以随机读取的对象
+type VideoObject interface {
+	io.ReaderAt
+	io.Closer
+	Size() int64
+	ContentType() string
+}
+
+type VideoRPC interface {
+	CheckPrivacy(ctx context.Context, viewerID, ownerID int64, action string) (bool, error)
+	// ListFollowers 分批列出 uid 的粉丝，cursor 为 0 表示第一页，只有第一页返回粉丝总数
+	ListFollowers(ctx context.Context, uid, cursor, limit int64) (followers []int64, nextCursor int64, total int64, err error)
+	ListFollowing(ctx context.Context, uid int64) ([]int64, error)
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+	"github.com/LingeringAutumn/Yijie/pkg/utils"
+)
+
+// PrepareSubmission 根据投稿时的草稿标记(Status 为 draft)和定时发布时间确定视频状态
+// 发布时间晚于当前时间时保存为定时发布的草稿，否则忽略发布时间
+func (svc *VideoService) PrepareSubmission(video *model.Video) {
+	if video.PublishAt != nil && !video.PublishAt.After(time.Now()) {
+		video.PublishAt = nil
+	}
+	if video.Status == constants.VideoStatusDraft || video.PublishAt != nil {
+		video.Status = constants.VideoStatusDraft
+	} else {
+		video.Status = constants.VideoStatusPublished
+	}
+}
+
+// CheckDraft 校验视频是草稿
+func (svc *VideoService) CheckDraft(profile *model.VideoProfile) error {
+	if profile.Status != constants.VideoStatusDraft {
+		return errno.ParamVerifyError.WithMessage("only drafts can be scheduled")
+	}
+	return nil
+}
+
+// ListDrafts 分页获取用户的草稿，页码和页大小按其他分页接口的规则修正
+func (svc *VideoService) ListDrafts(ctx context.Context, uid int64, pageNum, pageSize int64) ([]*model.VideoProfile, error) {
+	pageSize, err := normalizePageSize(pageSize)
+	if err != nil {
+		return nil, err
+	}
+	return svc.db.ListDrafts(ctx, uid, pageOffset(pageNum, pageSize), pageSize)
+}
+
+func (svc *VideoService) ScheduleDraft(ctx context.Context, videoID int64, publishAt time.Time) error {
+	if err := svc.db.ScheduleDraft(ctx, videoID, publishAt); err != nil {
+		return err
+	}
+	return svc.redis.DeleteVideoRedis(ctx, videoID)
+}
+
+// PublishDraft 发布草稿：初始化统计数据和热度，推送到粉丝的关注流，公开的视频加入热榜
+// 返回 false 表示草稿已经被其他实例发布
+func (svc *VideoService) PublishDraft(ctx context.Context, videoID int64) (bool, error) {
+	publishedAt := time.Now()
+	hot := utils.DefaultComputeHotScore(0, 0, publishedAt)
+	stat := &model.VideoStat{
+		VideoID:  videoID,
+		HotScore: hot,
+	}
+	published, err := svc.db.PublishDraft(ctx, videoID, publishedAt, stat)
+	if err != nil || !published {
+		return published, err
+	}
+	// 缓存里还是草稿状态
+	if err = svc.redis.DeleteVideoRedis(ctx, videoID); err != nil {
+		logger.Errorf("delete cache of published draft %d failed: %v", videoID, err)
+	}
+	if err = svc.RefreshSearchIndex(ctx, videoID); err != nil {
+		logger.Errorf("index published draft %d failed: %v", videoID, err)
+	}
+	profile, err := svc.db.GetVideoDB(ctx, videoID)
+	if err != nil {
+		logger.Errorf("query published draft %d failed: %v", videoID, err)
+		return true, nil
+	}
+	if svc.InFeed(profile.Visibility) {
+		if err = svc.DeliverFeed(ctx, profile.UserID, videoID, publishedAt); err != nil {
+			logger.Errorf("deliver published draft %d to feeds failed: %v", videoID, err)
+		}
+	}
+	if !svc.IsListed(profile) {
+		return true, nil
+	}
+	if err = svc.redis.UpdateHotRank(ctx, videoID, hot); err != nil {
+		logger.Errorf("add published draft %d to hot rank failed: %v", videoID, err)
+	}
+	if err = svc.AddTitleSuggestion(ctx, profile.Title); err != nil {
+		logger.Errorf("add title of published draft %d to suggestions failed: %v", videoID, err)
+	}
+	return true, nil
+}
+
+// PublishDueDrafts 发布所有到期的定时草稿，返回本实例发布的数量
+// 多个实例会扫到同一批草稿，由 PublishDraft 的条件更新保证每个草稿只发布一次
+func (svc *VideoService) PublishDueDrafts(ctx context.Context) (int, error) {
+	ids, err := svc.db.ListDueDrafts(ctx, time.Now(), constants.VideoPublishBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, id := range ids {
+		published, err := svc.PublishDraft(ctx, id)
+		if err != nil {
+			logger.Errorf("publish scheduled draft %d failed: %v", id, err)
+			continue
+		}
+		if published {
+			count++
+		}
+	}
+	return count, nil
+}
@@ -0,0 +1,209 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/app/video/domain/repository"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+)
+
+func TestVideoService_PrepareSubmission(t *testing.T) {
+	convey.Convey("PrepareSubmission", t, func() {
+		svc := &VideoService{}
+		past := time.Now().Add(-time.Minute)
+		future := time.Now().Add(time.Hour)
+
+		tests := []struct {
+			name           string
+			status         string
+			publishAt      *time.Time
+			expectedStatus string
+			keepsPublishAt bool
+		}{
+			{"plain submission is published", "", nil, constants.VideoStatusPublished, false},
+			{"draft flag keeps it a draft", constants.VideoStatusDraft, nil, constants.VideoStatusDraft, false},
+			{"future publish time schedules a draft", "", &future, constants.VideoStatusDraft, true},
+			{"past publish time is ignored", "", &past, constants.VideoStatusPublished, false},
+			{"draft with past publish time stays an unscheduled draft", constants.VideoStatusDraft, &past, constants.VideoStatusDraft, false},
+			{"unknown status is published", "deleted", nil, constants.VideoStatusPublished, false},
+		}
+		for _, tt := range tests {
+			convey.Convey(tt.name, func() {
+				video := &model.Video{Status: tt.status, PublishAt: tt.publishAt}
+				svc.PrepareSubmission(video)
+				convey.So(video.Status, convey.ShouldEqual, tt.expectedStatus)
+				convey.So(video.PublishAt != nil, convey.ShouldEqual, tt.keepsPublishAt)
+			})
+		}
+	})
+}
+
+// draftDB 模拟草稿的条件更新，只有第一次发布成功
+type draftDB struct {
+	repository.VideoDB
+	profile   *model.VideoProfile
+	published bool
+	queried   int
+}
+
+func (db *draftDB) PublishDraft(ctx context.Context, videoID int64, publishedAt time.Time, stat *model.VideoStat) (bool, error) {
+	if db.published {
+		return false, nil
+	}
+	db.published = true
+	db.profile.Status = constants.VideoStatusPublished
+	return true, nil
+}
+
+func (db *draftDB) GetVideoDB(ctx context.Context, videoId int64) (*model.VideoProfile, error) {
+	db.queried++
+	copied := *db.profile
+	return &copied, nil
+}
+
+// draftRedis 记录发布草稿时的副作用
+type draftRedis struct {
+	repository.VideoRedis
+	deleted     []int64
+	hotRank     []int64
+	suggestions []string
+	feeds       map[int64][]int64
+}
+
+func (r *draftRedis) DeleteVideoRedis(ctx context.Context, videoID int64) error {
+	r.deleted = append(r.deleted, videoID)
+	return nil
+}
+
+func (r *draftRedis) UpdateHotRank(ctx context.Context, videoID int64, hotScore float64) error {
+	r.hotRank = append(r.hotRank, videoID)
+	return nil
+}
+
+func (r *draftRedis) AddSuggestion(ctx context.Context, prefixes []string, phrase string, weight float64) error {
+	r.suggestions = append(r.suggestions, phrase)
+	return nil
+}
+
+func (r *draftRedis) PushFeed(ctx context.Context, uids []int64, item *model.FeedItem) error {
+	for _, uid := range uids {
+		r.feeds[uid] = append(r.feeds[uid], item.VideoID)
+	}
+	return nil
+}
+
+// followerRPC 返回固定的粉丝列表
+type followerRPC struct {
+	repository.VideoRPC
+	followers []int64
+}
+
+func (r *followerRPC) ListFollowers(ctx context.Context, uid, cursor, limit int64) ([]int64, int64, int64, error) {
+	return r.followers, 0, int64(len(r.followers)), nil
+}
+
+func TestVideoService_PublishDraft(t *testing.T) {
+	convey.Convey("PublishDraft", t, func() {
+		ctx := context.Background()
+		newService := func(visibility string) (*VideoService, *draftDB, *draftRedis) {
+			db := &draftDB{profile: &model.VideoProfile{
+				VideoID: 1, UserID: 10, Title: "Hello", Status: constants.VideoStatusDraft, Visibility: visibility,
+			}}
+			redis := &draftRedis{feeds: map[int64][]int64{}}
+			return &VideoService{db: db, redis: redis, rpc: &followerRPC{followers: []int64{20, 21}}}, db, redis
+		}
+
+		convey.Convey("public draft is delivered, ranked and suggested", func() {
+			svc, _, redis := newService(constants.VideoVisibilityPublic)
+			published, err := svc.PublishDraft(ctx, 1)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(published, convey.ShouldBeTrue)
+			convey.So(redis.deleted, convey.ShouldResemble, []int64{1})
+			convey.So(redis.feeds, convey.ShouldResemble, map[int64][]int64{20: {1}, 21: {1}})
+			convey.So(redis.hotRank, convey.ShouldResemble, []int64{1})
+			convey.So(redis.suggestions, convey.ShouldResemble, []string{"hello"})
+		})
+
+		convey.Convey("followers-only draft is delivered but not ranked", func() {
+			svc, _, redis := newService(constants.VideoVisibilityFollowers)
+			published, err := svc.PublishDraft(ctx, 1)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(published, convey.ShouldBeTrue)
+			convey.So(len(redis.feeds), convey.ShouldEqual, 2)
+			convey.So(redis.hotRank, convey.ShouldBeEmpty)
+			convey.So(redis.suggestions, convey.ShouldBeEmpty)
+		})
+
+		convey.Convey("unlisted draft is neither delivered nor ranked", func() {
+			svc, _, redis := newService(constants.VideoVisibilityUnlisted)
+			_, err := svc.PublishDraft(ctx, 1)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(redis.feeds, convey.ShouldBeEmpty)
+			convey.So(redis.hotRank, convey.ShouldBeEmpty)
+		})
+
+		convey.Convey("publishing twice has no side effects the second time", func() {
+			svc, db, redis := newService(constants.VideoVisibilityPublic)
+			published, err := svc.PublishDraft(ctx, 1)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(published, convey.ShouldBeTrue)
+
+			published, err = svc.PublishDraft(ctx, 1)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(published, convey.ShouldBeFalse)
+			convey.So(db.queried, convey.ShouldEqual, 1)
+			convey.So(redis.deleted, convey.ShouldResemble, []int64{1})
+			convey.So(redis.feeds[20], convey.ShouldResemble, []int64{1})
+			convey.So(redis.hotRank, convey.ShouldResemble, []int64{1})
+			convey.So(redis.suggestions, convey.ShouldHaveLength, 1)
+		})
+	})
+}
+
+// draftPageDB 记录查询草稿时的 offset 和 limit
+type draftPageDB struct {
+	repository.VideoDB
+	offset, limit int64
+}
+
+func (db *draftPageDB) ListDrafts(ctx context.Context, uid int64, offset, limit int64) ([]*model.VideoProfile, error) {
+	db.offset, db.limit = offset, limit
+	return nil, nil
+}
+
+func TestVideoService_ListDrafts(t *testing.T) {
+	convey.Convey("ListDrafts", t, func() {
+		ctx := context.Background()
+		db := &draftPageDB{}
+		svc := &VideoService{db: db}
+
+		tests := []struct {
+			name             string
+			pageNum          int64
+			pageSize         int64
+			offset, expected int64
+		}{
+			{"regular page", 3, 10, 20, 10},
+			{"page number below 1 starts from the first page", -5, 10, 0, 10},
+			{"oversized page is capped", 2, 1 << 40, constants.VideoPageMaxPageSize, constants.VideoPageMaxPageSize},
+		}
+		for _, tt := range tests {
+			convey.Convey(tt.name, func() {
+				_, err := svc.ListDrafts(ctx, 1, tt.pageNum, tt.pageSize)
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(db.offset, convey.ShouldEqual, tt.offset)
+				convey.So(db.limit, convey.ShouldEqual, tt.expected)
+			})
+		}
+
+		convey.Convey("non-positive page size is rejected", func() {
+			_, err := svc.ListDrafts(ctx, 1, 1, 0)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
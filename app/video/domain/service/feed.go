@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+)
+
+// InFeed 判断视频是否推送到粉丝的关注流：公开和仅粉丝可见的视频
+func (svc *VideoService) InFeed(visibility string) bool {
+	return visibility == "" || visibility == constants.VideoVisibilityPublic || visibility == constants.VideoVisibilityFollowers
+}
+
+// DeliverFeed 分发作者新发布的视频
+// 粉丝数低于 FeedFanOutThreshold 时分批写入每个粉丝的收件箱，否则只写入作者的发件箱，由粉丝读取时合并
+func (svc *VideoService) DeliverFeed(ctx context.Context, authorID, videoID int64, publishedAt time.Time) error {
+	item := &model.FeedItem{VideoID: videoID, PublishedAt: publishedAt.UnixMilli()}
+	followers, cursor, total, err := svc.rpc.ListFollowers(ctx, authorID, 0, constants.FeedFanOutBatchSize)
+	if err != nil {
+		return fmt.Errorf("list followers of user %d failed: %w", authorID, err)
+	}
+	if total >= constants.FeedFanOutThreshold {
+		return svc.redis.PushOutbox(ctx, authorID, item)
+	}
+	for {
+		if err = svc.redis.PushFeed(ctx, followers, item); err != nil {
+			return err
+		}
+		if cursor == 0 {
+			return nil
+		}
+		followers, cursor, _, err = svc.rpc.ListFollowers(ctx, authorID, cursor, constants.FeedFanOutBatchSize)
+		if err != nil {
+			return fmt.Errorf("list followers of user %d failed: %w", authorID, err)
+		}
+	}
+}
+
+// GetFeed 按发布时间倒序读取 uid 关注的作者发布的视频，合并收件箱和大 V 的发件箱，返回一页视频和下一页的游标
+// 取消关注的作者之前推送到收件箱的视频不再展示，可见范围由调用方判断
+func (svc *VideoService) GetFeed(ctx context.Context, uid int64, cursor string, limit int64) ([]*model.VideoProfile, string, error) {
+	if limit <= 0 {
+		limit = constants.FeedDefaultLimit
+	}
+	if limit > constants.FeedMaxLimit {
+		limit = constants.FeedMaxLimit
+	}
+	var before *model.FeedItem
+	if cursor != "" {
+		before = new(model.FeedItem)
+		if err := svc.cursor.Parse(cursor, before); err != nil {
+			return nil, "", err
+		}
+	}
+
+	following, err := svc.rpc.ListFollowing(ctx, uid)
+	if err != nil {
+		return nil, "", fmt.Errorf("list following of user %d failed: %w", uid, err)
+	}
+	if len(following) == 0 {
+		return nil, "", nil
+	}
+	creators, err := svc.redis.FilterBigCreators(ctx, following)
+	if err != nil {
+		return nil, "", err
+	}
+	// 多取一个用来判断是否还有下一页
+	items, err := svc.redis.RangeFeed(ctx, uid, creators, before, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	items = mergeFeedItems(items)
+
+	var next string
+	if int64(len(items)) > limit {
+		items = items[:limit]
+		if next, err = svc.cursor.Sign(items[len(items)-1]); err != nil {
+			return nil, "", err
+		}
+	}
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.VideoID)
+	}
+	followed := make(map[int64]bool, len(following))
+	for _, id := range following {
+		followed[id] = true
+	}
+	videos := svc.loadVideos(ctx, ids)
+	visible := videos[:0]
+	for _, v := range videos {
+		if followed[v.UserID] && v.Status == constants.VideoStatusPublished && svc.InFeed(v.Visibility) {
+			visible = append(visible, v)
+		}
+	}
+	return visible, next, nil
+}
+
+// mergeFeedItems 去掉重复的视频，按发布时间和视频 ID 倒序排列
+// 作者粉丝数越过阈值前后发布的视频可能同时出现在收件箱和发件箱中
+func mergeFeedItems(items []*model.FeedItem) []*model.FeedItem {
+	seen := make(map[int64]bool, len(items))
+	merged := items[:0]
+	for _, item := range items {
+		if !seen[item.VideoID] {
+			seen[item.VideoID] = true
+			merged = append(merged, item)
+		}
+	}
+	sort.Slice(merged, func(i, j int) bool {
+		if merged[i].PublishedAt != merged[j].PublishedAt {
+			return merged[i].PublishedAt > merged[j].PublishedAt
+		}
+		return merged[i].VideoID > merged[j].VideoID
+	})
+	return merged
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/app/video/domain/repository"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+func TestMergeFeedItems(t *testing.T) {
+	convey.Convey("mergeFeedItems", t, func() {
+		item := func(id, t int64) *model.FeedItem { return &model.FeedItem{VideoID: id, PublishedAt: t} }
+		ids := func(items []*model.FeedItem) []int64 {
+			result := make([]int64, 0, len(items))
+			for _, i := range items {
+				result = append(result, i.VideoID)
+			}
+			return result
+		}
+
+		tests := []struct {
+			name     string
+			items    []*model.FeedItem
+			expected []int64
+		}{
+			{"newest first", []*model.FeedItem{item(1, 100), item(3, 300), item(2, 200)}, []int64{3, 2, 1}},
+			{"same millisecond ordered by video id", []*model.FeedItem{item(5, 100), item(7, 100), item(6, 100)}, []int64{7, 6, 5}},
+			{"video in both inbox and outbox appears once", []*model.FeedItem{item(4, 100), item(9, 200), item(4, 100)}, []int64{9, 4}},
+			{"empty", nil, []int64{}},
+		}
+		for _, tt := range tests {
+			convey.Convey(tt.name, func() {
+				convey.So(ids(mergeFeedItems(tt.items)), convey.ShouldResemble, tt.expected)
+			})
+		}
+	})
+}
+
+// feedRedis 模拟关注流的读写，RangeFeed 按游标过滤预设的视频
+type feedRedis struct {
+	repository.VideoRedis
+	items    []*model.FeedItem
+	videos   map[int64]*model.VideoProfile
+	inboxes  map[int64][]int64
+	outboxes map[int64][]int64
+	before   *model.FeedItem
+}
+
+func (r *feedRedis) FilterBigCreators(ctx context.Context, uids []int64) ([]int64, error) {
+	return nil, nil
+}
+
+func (r *feedRedis) RangeFeed(ctx context.Context, uid int64, authors []int64, before *model.FeedItem, limit int64) ([]*model.FeedItem, error) {
+	r.before = before
+	var items []*model.FeedItem
+	for _, item := range r.items {
+		if before == nil || item.PublishedAt < before.PublishedAt ||
+			(item.PublishedAt == before.PublishedAt && item.VideoID < before.VideoID) {
+			copied := *item
+			items = append(items, &copied)
+		}
+	}
+	return items, nil
+}
+
+func (r *feedRedis) GetVideoRedis(ctx context.Context, videoId int64) (*model.VideoProfile, error) {
+	if v, ok := r.videos[videoId]; ok {
+		copied := *v
+		return &copied, nil
+	}
+	return nil, errno.Errorf(errno.RedisKeyNotExist, "video %d not cached", videoId)
+}
+
+func (r *feedRedis) GetViews(ctx context.Context, videoId int64) (int64, error) { return 0, nil }
+
+func (r *feedRedis) GetLikes(ctx context.Context, videoId int64) (int64, error) { return 0, nil }
+
+func (r *feedRedis) PushFeed(ctx context.Context, uids []int64, item *model.FeedItem) error {
+	for _, uid := range uids {
+		r.inboxes[uid] = append(r.inboxes[uid], item.VideoID)
+	}
+	return nil
+}
+
+func (r *feedRedis) PushOutbox(ctx context.Context, authorID int64, item *model.FeedItem) error {
+	r.outboxes[authorID] = append(r.outboxes[authorID], item.VideoID)
+	return nil
+}
+
+// feedRPC 返回关注列表，粉丝列表按 limit 分批返回
+type feedRPC struct {
+	repository.VideoRPC
+	following []int64
+	followers []int64
+	total     int64 // 为 0 时使用 followers 的数量
+}
+
+func (r *feedRPC) ListFollowing(ctx context.Context, uid int64) ([]int64, error) {
+	return r.following, nil
+}
+
+func (r *feedRPC) ListFollowers(ctx context.Context, uid, cursor, limit int64) ([]int64, int64, int64, error) {
+	end := min(cursor+limit, int64(len(r.followers)))
+	next := end
+	if end == int64(len(r.followers)) {
+		next = 0
+	}
+	total := r.total
+	if total == 0 {
+		total = int64(len(r.followers))
+	}
+	return r.followers[cursor:end], next, total, nil
+}
+
+func TestVideoService_GetFeed(t *testing.T) {
+	convey.Convey("GetFeed", t, func() {
+		ctx := context.Background()
+		const followed, unfollowed = 10, 11
+		published := func(id, author int64, visibility string) *model.VideoProfile {
+			return &model.VideoProfile{VideoID: id, UserID: author, Status: constants.VideoStatusPublished, Visibility: visibility}
+		}
+		redis := &feedRedis{
+			items: []*model.FeedItem{
+				{VideoID: 6, PublishedAt: 300}, {VideoID: 5, PublishedAt: 200}, {VideoID: 4, PublishedAt: 200},
+				{VideoID: 3, PublishedAt: 200}, {VideoID: 2, PublishedAt: 100}, {VideoID: 1, PublishedAt: 100},
+			},
+			videos: map[int64]*model.VideoProfile{
+				6: published(6, followed, constants.VideoVisibilityPublic),
+				5: published(5, followed, constants.VideoVisibilityFollowers),
+				4: published(4, unfollowed, constants.VideoVisibilityPublic),
+				3: published(3, followed, constants.VideoVisibilityPrivate),
+				2: {VideoID: 2, UserID: followed, Status: constants.VideoStatusDraft},
+				1: published(1, followed, constants.VideoVisibilityUnlisted),
+			},
+		}
+		svc := &VideoService{redis: redis, rpc: &feedRPC{following: []int64{followed}}, cursor: newCursorSigner()}
+
+		convey.Convey("pages through same-millisecond videos without gaps", func() {
+			videos, next, err := svc.GetFeed(ctx, 1, "", 2)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(next, convey.ShouldNotBeEmpty)
+			convey.So(videos, convey.ShouldHaveLength, 2)
+			convey.So(videos[1].VideoID, convey.ShouldEqual, 5)
+
+			_, next, err = svc.GetFeed(ctx, 1, next, 2)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(redis.before, convey.ShouldResemble, &model.FeedItem{VideoID: 5, PublishedAt: 200})
+			convey.So(next, convey.ShouldNotBeEmpty)
+		})
+
+		convey.Convey("unfollowed authors, drafts and hidden videos are filtered", func() {
+			videos, next, err := svc.GetFeed(ctx, 1, "", 10)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(next, convey.ShouldBeEmpty)
+			ids := make([]int64, 0, len(videos))
+			for _, v := range videos {
+				ids = append(ids, v.VideoID)
+			}
+			convey.So(ids, convey.ShouldResemble, []int64{6, 5})
+		})
+
+		convey.Convey("a forged cursor is rejected", func() {
+			_, _, err := svc.GetFeed(ctx, 1, "e30.AAAA", 2)
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.ParamVerifyErrorCode)
+		})
+
+		convey.Convey("users following nobody get an empty feed", func() {
+			svc.rpc = &feedRPC{}
+			videos, next, err := svc.GetFeed(ctx, 1, "", 2)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(videos, convey.ShouldBeEmpty)
+			convey.So(next, convey.ShouldBeEmpty)
+		})
+	})
+}
+
+func TestVideoService_DeliverFeed(t *testing.T) {
+	convey.Convey("DeliverFeed", t, func() {
+		ctx := context.Background()
+		redis := &feedRedis{inboxes: map[int64][]int64{}, outboxes: map[int64][]int64{}}
+		followers := make([]int64, constants.FeedFanOutBatchSize+1)
+		for i := range followers {
+			followers[i] = int64(i + 1)
+		}
+
+		convey.Convey("small creators fan out to every follower in batches", func() {
+			svc := &VideoService{redis: redis, rpc: &feedRPC{followers: followers}}
+			convey.So(svc.DeliverFeed(ctx, 100, 1, time.Now()), convey.ShouldBeNil)
+			convey.So(redis.inboxes, convey.ShouldHaveLength, len(followers))
+			convey.So(redis.outboxes, convey.ShouldBeEmpty)
+		})
+
+		convey.Convey("big creators only write their outbox", func() {
+			svc := &VideoService{redis: redis, rpc: &feedRPC{followers: followers, total: constants.FeedFanOutThreshold}}
+			convey.So(svc.DeliverFeed(ctx, 100, 1, time.Now()), convey.ShouldBeNil)
+			convey.So(redis.inboxes, convey.ShouldBeEmpty)
+			convey.So(redis.outboxes[100], convey.ShouldResemble, []int64{1})
+		})
+	})
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// RecordSearchHistory 把搜索词记入用户的搜索历史，用户关闭了搜索历史时不记录
+// 查询隐私设置失败时同样不记录
+func (svc *VideoService) RecordSearchHistory(ctx context.Context, uid int64, keyword string) error {
+	keyword = normalizeHistoryKeyword(keyword)
+	if keyword == "" || utf8.RuneCountInString(keyword) > constants.SearchHistoryMaxKeywordLen {
+		return nil
+	}
+	allowed, err := svc.rpc.CheckPrivacy(ctx, uid, uid, constants.PrivacyActionRecordSearch)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return nil
+	}
+	return svc.redis.AddSearchHistory(ctx, uid, keyword)
+}
+
+// ListSearchHistory 返回用户最近的搜索词，最近的在前
+// 用户关闭搜索历史后，顺带清除之前留下的记录
+func (svc *VideoService) ListSearchHistory(ctx context.Context, uid int64) ([]string, error) {
+	allowed, err := svc.rpc.CheckPrivacy(ctx, uid, uid, constants.PrivacyActionRecordSearch)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		if err = svc.redis.ClearSearchHistory(ctx, uid); err != nil {
+			return nil, err
+		}
+		return []string{}, nil
+	}
+	return svc.redis.GetSearchHistory(ctx, uid)
+}
+
+func (svc *VideoService) DeleteSearchHistoryItem(ctx context.Context, uid int64, keyword string) error {
+	keyword = normalizeHistoryKeyword(keyword)
+	if keyword == "" {
+		return errno.ParamVerifyError.WithMessage("keyword is empty")
+	}
+	return svc.redis.RemoveSearchHistory(ctx, uid, keyword)
+}
+
+func (svc *VideoService) ClearSearchHistory(ctx context.Context, uid int64) error {
+	return svc.redis.ClearSearchHistory(ctx, uid)
+}
+
+// normalizeHistoryKeyword 合并连续空白，保留大小写，和用户输入的搜索词保持一致
+func normalizeHistoryKeyword(keyword string) string {
+	return strings.Join(strings.Fields(keyword), " ")
+}
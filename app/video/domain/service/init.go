@@ -1,6 +1,8 @@
 package service
 
 import (
+	"sync/atomic"
+
 	"github.com/LingeringAutumn/Yijie/app/video/domain/repository"
 	"github.com/LingeringAutumn/Yijie/pkg/utils"
 )
@@ -9,23 +11,42 @@ type VideoService struct {
 	db    repository.VideoDB
 	redis repository.VideoRedis
 	rpc   repository.VideoRPC
+	store repository.VideoObjectStore
 	sf    *utils.Snowflake
+
+	cursor     *utils.CursorSigner          // 分页游标的签名组件
+	engine     repository.VideoSearchEngine // 内置搜索引擎，使用 MySQL 搜索时为 nil
+	indexReady atomic.Bool                  // 内置引擎的索引是否已经加载完成
 }
 
-func NewVideoService(db repository.VideoDB, redis repository.VideoRedis, sf *utils.Snowflake) *VideoService {
+// NewVideoService engine 为 nil 时使用 MySQL 搜索
+func NewVideoService(db repository.VideoDB, redis repository.VideoRedis, rpc repository.VideoRPC, store repository.VideoObjectStore, sf *utils.Snowflake, cursor *utils.CursorSigner, engine repository.VideoSearchEngine) *VideoService {
 	if db == nil {
 		panic("videoService`s db should not be nil")
 	}
 	if redis == nil {
 		panic("videoService`s redis should not be nil")
 	}
+	if rpc == nil {
+		panic("videoService`s rpc should not be nil")
+	}
+	if store == nil {
+		panic("videoService`s store should not be nil")
+	}
 	if sf == nil {
 		panic("videoService`s sf should not be nil")
 	}
+	if cursor == nil {
+		panic("videoService`s cursor should not be nil")
+	}
 	svc := &VideoService{
-		db:    db,
-		redis: redis,
-		sf:    sf,
+		db:     db,
+		redis:  redis,
+		rpc:    rpc,
+		store:  store,
+		sf:     sf,
+		cursor: cursor,
+		engine: engine,
 	}
 	return svc
 }
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+	"github.com/LingeringAutumn/Yijie/pkg/utils"
+)
+
+// CheckVideoOwner 校验视频存在且属于 uid，返回视频信息
+func (svc *VideoService) CheckVideoOwner(ctx context.Context, videoID, uid int64) (*model.VideoProfile, error) {
+	profile, err := svc.db.GetVideoDB(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	if profile.UserID != uid {
+		return nil, errno.Errorf(errno.AuthNoOperatePermissionCode, "video %d does not belong to user %d", videoID, uid)
+	}
+	return profile, nil
+}
+
+// ValidateVideoUpdate 校验编辑请求，至少要修改一个字段，标题不能为空且不能超长，标签会被规范化
+func (svc *VideoService) ValidateVideoUpdate(update *model.VideoUpdate) error {
+	if update.Title == nil && update.Description == nil && update.CoverURL == nil && update.Tags == nil && update.Visibility == nil {
+		return errno.ParamVerifyError.WithMessage("nothing to update")
+	}
+	if update.Title != nil {
+		title := strings.TrimSpace(*update.Title)
+		if title == "" {
+			return errno.ParamVerifyError.WithMessage("title should not be empty")
+		}
+		if utf8.RuneCountInString(title) > constants.VideoTitleMaxLength {
+			return errno.ParamVerifyError.WithMessage(fmt.Sprintf("title should not exceed %d characters", constants.VideoTitleMaxLength))
+		}
+		update.Title = &title
+	}
+	if update.Tags != nil {
+		tags, err := svc.NormalizeTags(*update.Tags)
+		if err != nil {
+			return err
+		}
+		update.Tags = &tags
+	}
+	if update.Visibility != nil {
+		if err := validateVisibility(*update.Visibility); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (svc *VideoService) UpdateVideo(ctx context.Context, update *model.VideoUpdate) error {
+	return svc.db.UpdateVideo(ctx, update)
+}
+
+func (svc *VideoService) DeleteVideo(ctx context.Context, videoID int64) error {
+	return svc.db.DeleteVideo(ctx, videoID)
+}
+
+func (svc *VideoService) DeleteVideoRedis(ctx context.Context, videoID int64) error {
+	return svc.redis.DeleteVideoRedis(ctx, videoID)
+}
+
+func (svc *VideoService) RemoveHotRank(ctx context.Context, videoID int64) error {
+	return svc.redis.RemoveHotRank(ctx, videoID)
+}
+
+// InvalidateSearchCache 让所有搜索缓存失效
+// 编辑可能让原来搜不到这个视频的关键词搜到它，没法只删除受影响的缓存；
+// 缓存键中带有版本号，版本加一后旧缓存不再被读取，随 TTL 过期
+func (svc *VideoService) InvalidateSearchCache(ctx context.Context) error {
+	return svc.redis.BumpSearchCacheVersion(ctx)
+}
+
+// searchCacheKey 生成搜索缓存的键，关键词本身可能包含冒号，所以放在最后
+// 标签经过 url 转义，不会包含冒号和逗号
+func searchCacheKey(query *model.VideoSearchQuery) string {
+	filter := query.Sort
+	if len(query.Tags) > 0 {
+		escaped := make([]string, 0, len(query.Tags))
+		for _, tag := range query.Tags {
+			escaped = append(escaped, url.QueryEscape(tag))
+		}
+		filter += "," + query.TagMode + "," + strings.Join(escaped, ",")
+	}
+	return fmt.Sprintf("%s%d:%d:%s:%s", constants.VideoSearchCacheKeyPrefix, query.PageNum, query.PageSize, filter, query.Keyword)
+}
+
+// versionedSearchCacheKey 在 searchCacheKey 生成的键中加上缓存版本号
+func versionedSearchCacheKey(version int64, key string) string {
+	return fmt.Sprintf("%sv%d:%s", constants.VideoSearchCacheKeyPrefix, version, strings.TrimPrefix(key, constants.VideoSearchCacheKeyPrefix))
+}
+
+// ScheduleObjectPurge 记录视频文件和它的转码产物，VideoPurgeDelay 之后由后台任务从 MinIO 删除
+func (svc *VideoService) ScheduleObjectPurge(ctx context.Context, videoID int64, videoURL string) error {
+	renditionKeys, err := svc.db.ListRenditionObjectKeys(ctx, videoID)
+	if err != nil {
+		return err
+	}
+	purgeAt := time.Now().Add(constants.VideoPurgeDelay)
+	for _, objectKey := range purgeObjectKeys(videoID, videoURL, renditionKeys) {
+		if err = svc.redis.AddPendingPurge(ctx, objectKey, purgeAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// purgeObjectKeys 返回删除视频时需要清理的全部对象名
+// 视频还在转码时产物会在删除之后才上传，video_renditions 中还没有记录，所以按现有的转码规格补上对象名，
+// 删除不存在的对象不会报错
+func purgeObjectKeys(videoID int64, videoURL string, renditionKeys []string) []string {
+	keys := []string{videoObjectKey(videoID, videoURL)}
+	seen := map[string]bool{keys[0]: true}
+	for _, profile := range defaultRenditionProfiles {
+		renditionKeys = append(renditionKeys, fmt.Sprintf(constants.VideoRenditionObjectFormat, videoID, profile.Name))
+	}
+	for _, key := range renditionKeys {
+		if !seen[key] {
+			seen[key] = true
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
+
+// PurgeDeletedObjects 从 MinIO 删除已到期的视频文件，返回成功删除的数量
+func (svc *VideoService) PurgeDeletedObjects(ctx context.Context) (int, error) {
+	objectKeys, err := svc.redis.GetDuePurges(ctx, time.Now(), constants.VideoPurgeBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	purged := 0
+	for _, objectKey := range objectKeys {
+		if _, err := utils.MinioClientGlobal.DeleteFile(constants.VideoBucket, objectKey); err != nil {
+			// 保留记录，下一轮重试
+			logger.Errorf("purge video object %s failed: %v", objectKey, err)
+			continue
+		}
+		if err := svc.redis.RemovePendingPurge(ctx, objectKey); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+	return purged, nil
+}
+
+// videoObjectKey 返回视频文件的 MinIO 对象名，videos.video_url 中保存的就是对象名
+// 之前保存的是 endpoint/bucket/object 形式的完整地址，从中解析出对象名，解析失败时使用投稿时的默认命名
+func videoObjectKey(videoID int64, videoURL string) string {
+	if videoURL != "" && !strings.Contains(videoURL, "/") {
+		return videoURL
+	}
+	marker := "/" + constants.VideoBucket + "/"
+	if idx := strings.Index(videoURL, marker); idx >= 0 {
+		if key := videoURL[idx+len(marker):]; key != "" {
+			return key
+		}
+	}
+	return fmt.Sprintf("%d.mp4", videoID)
+}
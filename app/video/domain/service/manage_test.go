@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/app/video/domain/repository"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// ownerDB 只实现按 ID 查询视频
+type ownerDB struct {
+	repository.VideoDB
+	videos map[int64]*model.VideoProfile
+}
+
+func (db *ownerDB) GetVideoDB(ctx context.Context, videoId int64) (*model.VideoProfile, error) {
+	if v, ok := db.videos[videoId]; ok {
+		return v, nil
+	}
+	return nil, errno.Errorf(errno.DBNotFound, "video %d not found", videoId)
+}
+
+func TestVideoService_CheckVideoOwner(t *testing.T) {
+	convey.Convey("CheckVideoOwner", t, func() {
+		ctx := context.Background()
+		svc := &VideoService{db: &ownerDB{videos: map[int64]*model.VideoProfile{1: {VideoID: 1, UserID: 10}}}}
+
+		convey.Convey("owner gets the video", func() {
+			v, err := svc.CheckVideoOwner(ctx, 1, 10)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(v.VideoID, convey.ShouldEqual, 1)
+		})
+
+		convey.Convey("other users are rejected", func() {
+			_, err := svc.CheckVideoOwner(ctx, 1, 11)
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.AuthNoOperatePermissionCode)
+		})
+
+		convey.Convey("missing video", func() {
+			_, err := svc.CheckVideoOwner(ctx, 2, 10)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
+
+func TestVideoService_ValidateVideoUpdate(t *testing.T) {
+	convey.Convey("ValidateVideoUpdate", t, func() {
+		svc := &VideoService{}
+		str := func(s string) *string { return &s }
+		tags := func(t ...string) *[]string { return &t }
+
+		tests := []struct {
+			name    string
+			update  model.VideoUpdate
+			wantErr bool
+		}{
+			{"nothing to update", model.VideoUpdate{}, true},
+			{"blank title", model.VideoUpdate{Title: str("   ")}, true},
+			{"overlong title", model.VideoUpdate{Title: str(strings.Repeat("长", constants.VideoTitleMaxLength+1))}, true},
+			{"title at max length", model.VideoUpdate{Title: str(strings.Repeat("长", constants.VideoTitleMaxLength))}, false},
+			{"empty description is allowed", model.VideoUpdate{Description: str("")}, false},
+			{"clearing tags", model.VideoUpdate{Tags: tags()}, false},
+			{"invalid tags", model.VideoUpdate{Tags: tags(strings.Repeat("t", constants.VideoTagMaxLength+1))}, true},
+			{"valid visibility", model.VideoUpdate{Visibility: str(constants.VideoVisibilityUnlisted)}, false},
+			{"invalid visibility", model.VideoUpdate{Visibility: str("friends")}, true},
+		}
+		for _, tt := range tests {
+			convey.Convey(tt.name, func() {
+				update := tt.update
+				convey.So(svc.ValidateVideoUpdate(&update) != nil, convey.ShouldEqual, tt.wantErr)
+			})
+		}
+
+		convey.Convey("title is trimmed and tags are normalized", func() {
+			update := &model.VideoUpdate{Title: str("  hello  "), Tags: tags("#Go", "go")}
+			convey.So(svc.ValidateVideoUpdate(update), convey.ShouldBeNil)
+			convey.So(*update.Title, convey.ShouldEqual, "hello")
+			convey.So(*update.Tags, convey.ShouldResemble, []string{"go"})
+		})
+	})
+}
+
+func TestVideoObjectKey(t *testing.T) {
+	convey.Convey("videoObjectKey", t, func() {
+		tests := []struct {
+			name     string
+			videoURL string
+			expected string
+		}{
+			{"object key is used as is", "42.mp4", "42.mp4"},
+			{"legacy full url", "http://minio:9000/" + constants.VideoBucket + "/videos/42.mp4", "videos/42.mp4"},
+			{"url without bucket falls back", "http://cdn.example.com/42.mp4", "42.mp4"},
+			{"empty url falls back", "", "42.mp4"},
+		}
+		for _, tt := range tests {
+			convey.Convey(tt.name, func() {
+				convey.So(videoObjectKey(42, tt.videoURL), convey.ShouldEqual, tt.expected)
+			})
+		}
+	})
+}
+
+func TestVersionedSearchCacheKey(t *testing.T) {
+	convey.Convey("versionedSearchCacheKey", t, func() {
+		query := &model.VideoSearchQuery{Keyword: "a:b", PageNum: 1, PageSize: 10, Sort: constants.VideoSearchSortRelevance}
+		key := searchCacheKey(query)
+		convey.So(key, convey.ShouldStartWith, constants.VideoSearchCacheKeyPrefix)
+		convey.So(key, convey.ShouldEndWith, ":a:b")
+
+		v1 := versionedSearchCacheKey(1, key)
+		convey.So(v1, convey.ShouldEqual, constants.VideoSearchCacheKeyPrefix+"v1:"+strings.TrimPrefix(key, constants.VideoSearchCacheKeyPrefix))
+		convey.So(versionedSearchCacheKey(2, key), convey.ShouldNotEqual, v1)
+	})
+}
+
+// renditionDB 只实现转码产物对象名的查询
+type renditionDB struct {
+	repository.VideoDB
+	keys map[int64][]string
+}
+
+func (db *renditionDB) ListRenditionObjectKeys(ctx context.Context, videoID int64) ([]string, error) {
+	return db.keys[videoID], nil
+}
+
+// purgeRedis 记录待清理的对象
+type purgeRedis struct {
+	repository.VideoRedis
+	pending map[string]time.Time
+}
+
+func (r *purgeRedis) AddPendingPurge(ctx context.Context, objectKey string, purgeAt time.Time) error {
+	r.pending[objectKey] = purgeAt
+	return nil
+}
+
+func TestVideoService_ScheduleObjectPurge(t *testing.T) {
+	convey.Convey("ScheduleObjectPurge", t, func() {
+		ctx := context.Background()
+		redis := &purgeRedis{pending: map[string]time.Time{}}
+		// 240p 是之前的转码规格，现在的规格里已经没有了
+		db := &renditionDB{keys: map[int64][]string{42: {"renditions/42/240p.mp4", "renditions/42/360p.mp4"}}}
+		svc := &VideoService{db: db, redis: redis}
+
+		convey.So(svc.ScheduleObjectPurge(ctx, 42, "42_source.mp4"), convey.ShouldBeNil)
+
+		keys := make([]string, 0, len(redis.pending))
+		for key := range redis.pending {
+			keys = append(keys, key)
+		}
+		convey.So(keys, convey.ShouldContain, "42_source.mp4")
+		convey.So(keys, convey.ShouldContain, "renditions/42/240p.mp4")
+		for _, profile := range defaultRenditionProfiles {
+			convey.So(keys, convey.ShouldContain, fmt.Sprintf(constants.VideoRenditionObjectFormat, 42, profile.Name))
+		}
+		convey.So(keys, convey.ShouldHaveLength, len(defaultRenditionProfiles)+2)
+	})
+}
@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+const trendScope = "trend" // 热榜快照的 scope，搜索快照使用搜索缓存的键
+
+// pageCursor 是热榜和搜索的分页游标，指向第一页时冻结的快照，签名后交给客户端
+type pageCursor struct {
+	Snapshot string `json:"s"`           // 快照 ID
+	Offset   int64  `json:"o"`           // 下一页在快照中的起始位置
+	Size     int64  `json:"n"`           // 快照中的视频数
+	Total    int64  `json:"t,omitempty"` // 搜索的命中总数，可能超过快照的大小
+	Scope    string `json:"q"`           // 快照对应的列表和查询条件，换了条件的游标不能继续使用
+}
+
+// normalizePageSize 校验每页数量，超过 VideoPageMaxPageSize 时按最大值处理
+func normalizePageSize(pageSize int64) (int64, error) {
+	if pageSize <= 0 {
+		return 0, errno.ParamVerifyError.WithMessage("page_size should be positive")
+	}
+	if pageSize > constants.VideoPageMaxPageSize {
+		pageSize = constants.VideoPageMaxPageSize
+	}
+	return pageSize, nil
+}
+
+// pageOffset 没有游标时按页码计算起始位置，页码从 1 开始
+func pageOffset(pageNum, pageSize int64) int64 {
+	if pageNum < 1 {
+		pageNum = 1
+	}
+	return (pageNum - 1) * pageSize
+}
+
+// firstPage 从排好序的视频 ID 中取出 offset 开始的一页，后面还有视频时把 ID 冻结成快照并返回下一页的游标
+func (svc *VideoService) firstPage(ctx context.Context, scope string, ids []int64, total, offset, pageSize int64) ([]int64, string, error) {
+	size := int64(len(ids))
+	if offset >= size {
+		return nil, "", nil
+	}
+	end := min(offset+pageSize, size)
+	if end == size {
+		return ids[offset:end], "", nil
+	}
+
+	snapshotID, err := svc.sf.NextVal()
+	if err != nil {
+		return nil, "", errno.Errorf(errno.InternalServiceErrorCode, "generate page snapshot id failed: %v", err)
+	}
+	snapshot := strconv.FormatInt(snapshotID, 10)
+	if err = svc.redis.SetPageSnapshot(ctx, snapshot, ids, constants.VideoPageSnapshotTTL); err != nil {
+		return nil, "", err
+	}
+	next, err := svc.cursor.Sign(&pageCursor{Snapshot: snapshot, Offset: end, Size: size, Total: total, Scope: scope})
+	if err != nil {
+		return nil, "", err
+	}
+	return ids[offset:end], next, nil
+}
+
+// nextPage 从游标指向的快照中读取下一页，返回这一页的视频 ID、下一页的游标和搜索的命中总数
+// 快照过期后游标失效，客户端需要从第一页重新开始
+func (svc *VideoService) nextPage(ctx context.Context, scope, cursor string, pageSize int64) ([]int64, string, int64, error) {
+	var c pageCursor
+	if err := svc.cursor.Parse(cursor, &c); err != nil {
+		return nil, "", 0, err
+	}
+	if c.Scope != scope {
+		return nil, "", 0, errno.ParamVerifyError.WithMessage("cursor does not match the query")
+	}
+	if c.Offset >= c.Size {
+		return nil, "", c.Total, nil
+	}
+	end := min(c.Offset+pageSize, c.Size)
+	ids, err := svc.redis.GetPageSnapshot(ctx, c.Snapshot, c.Offset, end-1)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	if len(ids) == 0 {
+		return nil, "", 0, errno.ParamVerifyError.WithMessage("cursor has expired")
+	}
+	if end == c.Size {
+		return ids, "", c.Total, nil
+	}
+	c.Offset = end
+	next, err := svc.cursor.Sign(&c)
+	if err != nil {
+		return nil, "", 0, err
+	}
+	return ids, next, c.Total, nil
+}
+
+// loadProfiles 按顺序读取公开的视频，快照之后被删除或改为不公开的视频不再展示
+func (svc *VideoService) loadProfiles(ctx context.Context, ids []int64) []*model.VideoProfile {
+	videos := svc.loadVideos(ctx, ids)
+	public := videos[:0]
+	for _, v := range videos {
+		if svc.IsPublic(v) {
+			public = append(public, v)
+		}
+	}
+	return public
+}
+
+// loadVideos 按顺序读取视频信息，优先使用缓存，并合并 Redis 中的播放量和点赞数，已经删除的视频直接跳过
+func (svc *VideoService) loadVideos(ctx context.Context, ids []int64) []*model.VideoProfile {
+	videos := make([]*model.VideoProfile, 0, len(ids))
+	for _, id := range ids {
+		profile, err := svc.redis.GetVideoRedis(ctx, id)
+		if err != nil {
+			profile, err = svc.db.GetVideoDB(ctx, id)
+			if err != nil {
+				continue
+			}
+			_ = svc.redis.SetVideoRedis(ctx, profile)
+		}
+		profile.Views, _ = svc.GetViews(ctx, id)
+		profile.Likes, _ = svc.GetLikes(ctx, id)
+		videos = append(videos, profile)
+	}
+	return videos
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/repository"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+	"github.com/LingeringAutumn/Yijie/pkg/utils"
+)
+
+// snapshotRedis 在内存中保存分页快照
+type snapshotRedis struct {
+	repository.VideoRedis
+	snapshots map[string][]int64
+}
+
+func (r *snapshotRedis) SetPageSnapshot(ctx context.Context, snapshotID string, videoIDs []int64, ttl time.Duration) error {
+	r.snapshots[snapshotID] = append([]int64(nil), videoIDs...)
+	return nil
+}
+
+func (r *snapshotRedis) GetPageSnapshot(ctx context.Context, snapshotID string, start, stop int64) ([]int64, error) {
+	ids := r.snapshots[snapshotID]
+	if start >= int64(len(ids)) {
+		return nil, nil
+	}
+	return ids[start:min(stop+1, int64(len(ids)))], nil
+}
+
+func newCursorSigner() *utils.CursorSigner {
+	key := base64.StdEncoding.EncodeToString([]byte(strings.Repeat("k", 32)))
+	signer, err := utils.NewCursorSignerWithKey(key)
+	convey.So(err, convey.ShouldBeNil)
+	return signer
+}
+
+func TestVideoService_SnapshotPages(t *testing.T) {
+	convey.Convey("snapshot pagination", t, func() {
+		ctx := context.Background()
+		sf, err := utils.NewSnowflake(0, 0)
+		convey.So(err, convey.ShouldBeNil)
+		redis := &snapshotRedis{snapshots: map[string][]int64{}}
+		svc := &VideoService{redis: redis, sf: sf, cursor: newCursorSigner()}
+		ranked := []int64{9, 8, 7, 6, 5}
+
+		ids, next, err := svc.firstPage(ctx, trendScope, ranked, 5, 0, 2)
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(ids, convey.ShouldResemble, []int64{9, 8})
+		convey.So(next, convey.ShouldNotBeEmpty)
+
+		convey.Convey("pages follow the frozen snapshot even if the ranking changes", func() {
+			ranked[2], ranked[3] = 1, 2
+			ids, next, total, err := svc.nextPage(ctx, trendScope, next, 2)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ids, convey.ShouldResemble, []int64{7, 6})
+			convey.So(total, convey.ShouldEqual, 5)
+
+			ids, next, _, err = svc.nextPage(ctx, trendScope, next, 2)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ids, convey.ShouldResemble, []int64{5})
+			convey.So(next, convey.ShouldBeEmpty)
+		})
+
+		convey.Convey("a cursor cannot be reused for another query", func() {
+			_, _, _, err := svc.nextPage(ctx, "video:search:1:20:relevance:go", next, 2)
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.ParamVerifyErrorCode)
+		})
+
+		convey.Convey("a cursor signed with another key is rejected", func() {
+			other, err := utils.NewCursorSignerWithKey(base64.StdEncoding.EncodeToString([]byte(strings.Repeat("x", 32))))
+			convey.So(err, convey.ShouldBeNil)
+			svc.cursor = other
+			_, _, _, err = svc.nextPage(ctx, trendScope, next, 2)
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.ParamVerifyErrorCode)
+		})
+
+		convey.Convey("an expired snapshot invalidates the cursor", func() {
+			redis.snapshots = map[string][]int64{}
+			_, _, _, err := svc.nextPage(ctx, trendScope, next, 2)
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.ParamVerifyErrorCode)
+		})
+
+		convey.Convey("a single page does not freeze a snapshot", func() {
+			redis.snapshots = map[string][]int64{}
+			ids, next, err := svc.firstPage(ctx, trendScope, ranked, 5, 0, 10)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ids, convey.ShouldHaveLength, 5)
+			convey.So(next, convey.ShouldBeEmpty)
+			convey.So(redis.snapshots, convey.ShouldBeEmpty)
+		})
+
+		convey.Convey("page numbers past the end return nothing", func() {
+			ids, next, err := svc.firstPage(ctx, trendScope, ranked, 5, pageOffset(4, 2), 2)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ids, convey.ShouldBeEmpty)
+			convey.So(next, convey.ShouldBeEmpty)
+		})
+	})
+}
@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/app/video/domain/repository"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+)
+
+// defaultRenditionProfiles 从低到高排列，由 selectProfiles 按原视频的高度选取
+var defaultRenditionProfiles = []*model.RenditionProfile{
+	{Name: "360p", Height: 360, VideoBitrate: 800_000, AudioBitrate: 96_000},
+	{Name: "480p", Height: 480, VideoBitrate: 1_400_000, AudioBitrate: 128_000},
+	{Name: "720p", Height: 720, VideoBitrate: 2_800_000, AudioBitrate: 128_000},
+	{Name: "1080p", Height: 1080, VideoBitrate: 5_000_000, AudioBitrate: 192_000},
+}
+
+// ProcessingJob 是流水线各步骤之间传递的数据
+type ProcessingJob struct {
+	VideoID    int64
+	InputPath  string // 原始文件的本地路径
+	WorkDir    string // 本次处理的临时目录，产物写在这里
+	Probe      *model.ProbeResult
+	Renditions []*model.VideoRendition
+	Progress   func(percent int64) // 报告处理进度，可以为 nil
+}
+
+func (job *ProcessingJob) report(percent int64) {
+	if job.Progress != nil {
+		job.Progress(percent)
+	}
+}
+
+// ProcessingStage 是流水线中的一步，返回错误时流水线终止
+type ProcessingStage interface {
+	Name() string
+	Run(ctx context.Context, job *ProcessingJob) error
+}
+
+// Pipeline 按顺序执行各个步骤，流水线中的错误都说明文件本身有问题，重试没有意义
+type Pipeline struct {
+	stages []ProcessingStage
+}
+
+func NewPipeline(stages ...ProcessingStage) *Pipeline {
+	return &Pipeline{stages: stages}
+}
+
+// DefaultPipeline 探测、校验、转码
+func DefaultPipeline(transcoder repository.VideoTranscoder) *Pipeline {
+	return NewPipeline(
+		&ProbeStage{transcoder: transcoder},
+		&ValidateStage{},
+		&TranscodeStage{transcoder: transcoder, profiles: defaultRenditionProfiles},
+	)
+}
+
+func (p *Pipeline) Run(ctx context.Context, job *ProcessingJob) error {
+	for _, stage := range p.stages {
+		if err := stage.Run(ctx, job); err != nil {
+			return fmt.Errorf("%s: %w", stage.Name(), err)
+		}
+	}
+	return nil
+}
+
+// ProbeStage 读取视频的真实元数据，后面的步骤都依赖它
+type ProbeStage struct {
+	transcoder repository.VideoTranscoder
+}
+
+func (s *ProbeStage) Name() string { return "probe" }
+
+func (s *ProbeStage) Run(ctx context.Context, job *ProcessingJob) error {
+	probe, err := s.transcoder.Probe(ctx, job.InputPath)
+	if err != nil {
+		return err
+	}
+	job.Probe = probe
+	return nil
+}
+
+// ValidateStage 拒绝没有视频流、时长或分辨率超出范围的文件
+type ValidateStage struct{}
+
+func (s *ValidateStage) Name() string { return "validate" }
+
+func (s *ValidateStage) Run(ctx context.Context, job *ProcessingJob) error {
+	p := job.Probe
+	if p == nil {
+		return fmt.Errorf("video has not been probed")
+	}
+	if p.VideoCodec == "" {
+		return fmt.Errorf("no video stream")
+	}
+	if p.Width <= 0 || p.Height <= 0 {
+		return fmt.Errorf("invalid resolution %dx%d", p.Width, p.Height)
+	}
+	if p.Width > constants.VideoMaxDimension || p.Height > constants.VideoMaxDimension {
+		return fmt.Errorf("resolution %dx%d exceeds %d", p.Width, p.Height, constants.VideoMaxDimension)
+	}
+	if p.DurationSeconds < constants.VideoMinDurationSeconds || p.DurationSeconds > constants.VideoMaxDurationSeconds {
+		return fmt.Errorf("duration %.1fs out of range [%d, %d]", p.DurationSeconds,
+			constants.VideoMinDurationSeconds, constants.VideoMaxDurationSeconds)
+	}
+	job.report(constants.VideoProgressValidated)
+	return nil
+}
+
+// TranscodeStage 把视频转成多个清晰度的 H.264/AAC MP4
+type TranscodeStage struct {
+	transcoder repository.VideoTranscoder
+	profiles   []*model.RenditionProfile
+}
+
+func (s *TranscodeStage) Name() string { return "transcode" }
+
+func (s *TranscodeStage) Run(ctx context.Context, job *ProcessingJob) error {
+	profiles := s.selectProfiles(job.Probe.Height)
+	// 转码的进度区间按清晰度平分，每一段内按 ffmpeg 报告的已处理时长计算
+	span := float64(constants.VideoProgressTranscoded-constants.VideoProgressValidated) / float64(len(profiles))
+	for i, profile := range profiles {
+		base := float64(constants.VideoProgressValidated) + span*float64(i)
+		onProgress := func(seconds float64) {
+			ratio := seconds / job.Probe.DurationSeconds
+			if ratio > 1 {
+				ratio = 1
+			}
+			job.report(int64(base + span*ratio))
+		}
+		output := filepath.Join(job.WorkDir, profile.Name+".mp4")
+		if err := s.transcoder.Transcode(ctx, job.InputPath, output, profile, onProgress); err != nil {
+			return fmt.Errorf("%s: %w", profile.Name, err)
+		}
+		job.Renditions = append(job.Renditions, &model.VideoRendition{
+			VideoID:   job.VideoID,
+			Name:      profile.Name,
+			ObjectKey: fmt.Sprintf(constants.VideoRenditionObjectFormat, job.VideoID, profile.Name),
+			Width:     scaledWidth(job.Probe.Width, job.Probe.Height, profile.Height),
+			Height:    profile.Height,
+			Bitrate:   profile.VideoBitrate + profile.AudioBitrate,
+			LocalPath: output,
+		})
+	}
+	return nil
+}
+
+// selectProfiles 选出不高于原视频的清晰度，原视频比最低一档还小时按原始高度生成一档，不放大
+func (s *TranscodeStage) selectProfiles(height int64) []*model.RenditionProfile {
+	var selected []*model.RenditionProfile
+	for _, profile := range s.profiles {
+		if profile.Height <= height {
+			selected = append(selected, profile)
+		}
+	}
+	if len(selected) == 0 {
+		low := *s.profiles[0]
+		low.Height = height - height%2
+		selected = append(selected, &low)
+	}
+	return selected
+}
+
+// scaledWidth 按宽高比计算缩放后的宽度，取偶数以满足 H.264 编码的要求
+func scaledWidth(width, height, targetHeight int64) int64 {
+	w := width * targetHeight / height
+	return w - w%2
+}
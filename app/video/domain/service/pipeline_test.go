@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/app/video/infrastructure/transcode"
+)
+
+func TestPipeline_Run(t *testing.T) {
+	convey.Convey("DefaultPipeline", t, func() {
+		dir := t.TempDir()
+		input := filepath.Join(dir, "source.mp4")
+		convey.So(os.WriteFile(input, []byte("fake video"), 0o644), convey.ShouldBeNil)
+		job := &ProcessingJob{VideoID: 42, InputPath: input, WorkDir: dir}
+		probe := &model.ProbeResult{DurationSeconds: 12.5, Width: 1280, Height: 720, VideoCodec: "h264", AudioCodec: "aac"}
+
+		convey.Convey("transcodes renditions up to the source height", func() {
+			fake := transcode.NewFakeTranscoder(probe)
+			convey.So(DefaultPipeline(fake).Run(context.Background(), job), convey.ShouldBeNil)
+			convey.So(fake.Profiles, convey.ShouldResemble, []string{"360p", "480p", "720p"})
+			convey.So(len(job.Renditions), convey.ShouldEqual, 3)
+			convey.So(job.Renditions[0].Width, convey.ShouldEqual, 640)
+			convey.So(job.Renditions[2].ObjectKey, convey.ShouldEqual, "renditions/42/720p.mp4")
+			_, err := os.Stat(job.Renditions[2].LocalPath)
+			convey.So(err, convey.ShouldBeNil)
+		})
+
+		convey.Convey("small source is not upscaled", func() {
+			small := *probe
+			small.Width, small.Height = 320, 241
+			fake := transcode.NewFakeTranscoder(&small)
+			convey.So(DefaultPipeline(fake).Run(context.Background(), job), convey.ShouldBeNil)
+			convey.So(len(job.Renditions), convey.ShouldEqual, 1)
+			convey.So(job.Renditions[0].Height, convey.ShouldEqual, 240)
+		})
+
+		convey.Convey("rejects files without video stream", func() {
+			audio := *probe
+			audio.VideoCodec, audio.Width, audio.Height = "", 0, 0
+			fake := transcode.NewFakeTranscoder(&audio)
+			err := DefaultPipeline(fake).Run(context.Background(), job)
+			convey.So(err, convey.ShouldNotBeNil)
+			convey.So(err.Error(), convey.ShouldStartWith, "validate:")
+			convey.So(fake.Profiles, convey.ShouldBeEmpty)
+		})
+
+		convey.Convey("rejects too long videos", func() {
+			long := *probe
+			long.DurationSeconds = 7200
+			err := DefaultPipeline(transcode.NewFakeTranscoder(&long)).Run(context.Background(), job)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("stops at the failing stage", func() {
+			fake := transcode.NewFakeTranscoder(probe)
+			fake.TranscodeErr = errors.New("encoder crashed")
+			err := DefaultPipeline(fake).Run(context.Background(), job)
+			convey.So(err.Error(), convey.ShouldEqual, "transcode: 360p: encoder crashed")
+			convey.So(job.Renditions, convey.ShouldBeEmpty)
+		})
+	})
+}
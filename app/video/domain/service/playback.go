@@ -0,0 +1,48 @@
+package service
+
+import (
+	"context"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/config"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+)
+
+// PlaybackURL 生成视频文件带签名、会过期的播放地址，短时间内重复获取时返回缓存的地址
+// videoURL 是 videos.video_url 中保存的对象名，兼容之前保存的完整地址
+func (svc *VideoService) PlaybackURL(ctx context.Context, videoID int64, videoURL string) (string, error) {
+	objectKey := videoObjectKey(videoID, videoURL)
+	url, err := svc.redis.GetPlaybackURL(ctx, objectKey)
+	if err != nil {
+		logger.Errorf("get cached playback url of %s failed: %v", objectKey, err)
+	} else if url != "" {
+		return url, nil
+	}
+
+	ttl := config.GetPlaybackURLTTL()
+	if url, err = svc.store.PresignGet(ctx, constants.VideoBucket, objectKey, ttl); err != nil {
+		return "", err
+	}
+	cacheTTL := min(constants.VideoPlaybackURLCacheTTL, ttl/2)
+	if err = svc.redis.SetPlaybackURL(ctx, objectKey, url, cacheTTL); err != nil {
+		logger.Errorf("cache playback url of %s failed: %v", objectKey, err)
+	}
+	return url, nil
+}
+
+// SignPlaybackURLs 把列表中每个视频的对象名换成播放地址，生成失败的视频不返回播放地址
+func (svc *VideoService) SignPlaybackURLs(ctx context.Context, videos []*model.VideoProfile) {
+	for _, v := range videos {
+		url, err := svc.PlaybackURL(ctx, v.VideoID, v.VideoURL)
+		if err != nil {
+			logger.Errorf("generate playback url of video %d failed: %v", v.VideoID, err)
+		}
+		v.VideoURL = url
+	}
+}
+
+// VideoObjectKey 返回视频文件在 video 桶中的对象名
+func (svc *VideoService) VideoObjectKey(profile *model.VideoProfile) string {
+	return videoObjectKey(profile.VideoID, profile.VideoURL)
+}
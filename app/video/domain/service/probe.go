@@ -0,0 +1,72 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+	"github.com/LingeringAutumn/Yijie/pkg/mp4"
+)
+
+// ProbeVideo 解析投稿的 MP4 文件，拒绝损坏的文件和与客户端声明不一致的时长
+// 校验通过后用文件的实际时长覆盖客户端声明的时长，并把元数据挂在 video 上随视频一起保存
+func (svc *VideoService) ProbeVideo(video *model.Video, data []byte) error {
+	return probeVideo(video, bytes.NewReader(data), int64(len(data)))
+}
+
+// probeVideo 文件本身有问题时返回 ParamVerifyError，读取失败时原样返回错误
+func probeVideo(video *model.Video, r io.ReaderAt, size int64) error {
+	info, err := mp4.Probe(r, size)
+	if err != nil {
+		if errors.Is(err, mp4.ErrMalformed) || errors.Is(err, mp4.ErrUnsupported) {
+			return errno.ParamVerifyError.WithMessage(fmt.Sprintf("invalid video file: %v", err))
+		}
+		return fmt.Errorf("probe video file failed: %w", err)
+	}
+	if info.VideoCodec == "" {
+		return errno.ParamVerifyError.WithMessage("video file has no video track")
+	}
+	if info.Duration < constants.VideoMinDurationSeconds || info.Duration > constants.VideoMaxDurationSeconds {
+		return errno.ParamVerifyError.WithMessage(fmt.Sprintf("video duration should be between %d and %d seconds",
+			constants.VideoMinDurationSeconds, constants.VideoMaxDurationSeconds))
+	}
+	if info.Width <= 0 || info.Height <= 0 || info.Width > constants.VideoMaxDimension || info.Height > constants.VideoMaxDimension {
+		return errno.ParamVerifyError.WithMessage(fmt.Sprintf("invalid video resolution %dx%d", info.Width, info.Height))
+	}
+	if video.DurationSeconds > 0 && math.Abs(float64(video.DurationSeconds)-info.Duration) > constants.VideoDurationTolerance {
+		return errno.ParamVerifyError.WithMessage(fmt.Sprintf("declared duration %ds does not match the file (%.1fs)",
+			video.DurationSeconds, info.Duration))
+	}
+
+	video.DurationSeconds = int64(math.Round(info.Duration))
+	video.Metadata = &model.VideoMetadata{
+		MajorBrand: info.MajorBrand,
+		DurationMs: int64(math.Round(info.Duration * 1000)),
+		Width:      info.Width,
+		Height:     info.Height,
+		VideoCodec: info.VideoCodec,
+		AudioCodec: info.AudioCodec,
+		Bitrate:    info.Bitrate,
+		Size:       info.Size,
+		FastStart:  info.FastStart,
+	}
+	return nil
+}
+
+// FastStart 把 moov 不在文件开头的 MP4 重新封装，保证 video 桶中的文件都可以边下载边播放，需要在 ProbeVideo 之后调用
+func (svc *VideoService) FastStart(video *model.Video, data []byte) ([]byte, error) {
+	if video.Metadata == nil || video.Metadata.FastStart {
+		return data, nil
+	}
+	out, err := mp4.FastStart(data)
+	if err != nil {
+		return nil, errno.ParamVerifyError.WithMessage(fmt.Sprintf("invalid video file: %v", err))
+	}
+	video.Metadata.FastStart = true
+	return out, nil
+}
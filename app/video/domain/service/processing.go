@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/app/video/domain/repository"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+)
+
+// ProcessingService 是视频处理 worker 的业务逻辑
+// worker 不需要用户服务和搜索引擎，所以不复用 VideoService
+type ProcessingService struct {
+	db       repository.VideoDB
+	redis    repository.VideoRedis
+	store    repository.VideoObjectStore
+	pipeline *Pipeline
+	workDir  string
+}
+
+func NewProcessingService(db repository.VideoDB, redis repository.VideoRedis, store repository.VideoObjectStore, pipeline *Pipeline, workDir string) *ProcessingService {
+	if db == nil {
+		panic("processingService`s db should not be nil")
+	}
+	if redis == nil {
+		panic("processingService`s redis should not be nil")
+	}
+	if store == nil {
+		panic("processingService`s store should not be nil")
+	}
+	if pipeline == nil {
+		panic("processingService`s pipeline should not be nil")
+	}
+	return &ProcessingService{
+		db:       db,
+		redis:    redis,
+		store:    store,
+		pipeline: pipeline,
+		workDir:  workDir,
+	}
+}
+
+// Process 下载原始文件，执行处理流水线并上传转码产物
+// 文件本身有问题时把视频标记为失败并返回 nil；返回错误说明是下载、上传或数据库的问题，可以重试
+// Kafka 可能重复投递，已经处理完成的视频直接跳过
+func (svc *ProcessingService) Process(ctx context.Context, task *model.ProcessingTask) error {
+	processing, err := svc.db.GetProcessing(ctx, task.VideoID)
+	if err == nil && processing.Status == constants.VideoProcessingStatusReady {
+		logger.Infof("video %d has already been processed, skipped", task.VideoID)
+		return nil
+	}
+	if err != nil && errno.ConvertErr(err).ErrorCode != errno.DBNotFound {
+		return err
+	}
+	if err = svc.db.StartProcessing(ctx, task.VideoID); err != nil {
+		return err
+	}
+	svc.statusChanged(ctx, task.VideoID)
+
+	dir, err := os.MkdirTemp(svc.workDir, fmt.Sprintf("video-%d-*", task.VideoID))
+	if err != nil {
+		return fmt.Errorf("create work dir failed: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	input := filepath.Join(dir, "source"+filepath.Ext(task.Object))
+	if err = svc.store.Download(ctx, constants.VideoBucket, task.Object, input); err != nil {
+		return err
+	}
+	report := svc.progressReporter(ctx, task.VideoID)
+	report(constants.VideoProgressDownloaded)
+
+	job := &ProcessingJob{VideoID: task.VideoID, InputPath: input, WorkDir: dir, Progress: report}
+	runCtx, cancel := context.WithTimeout(ctx, constants.VideoProcessingTimeout)
+	defer cancel()
+	if err = svc.pipeline.Run(runCtx, job); err != nil {
+		// 进程正在退出，不标记失败，消息没有提交，之后会重新处理
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		logger.Warnf("process video %d failed: %v", task.VideoID, err)
+		return svc.FailProcessing(ctx, task.VideoID, err.Error())
+	}
+
+	// 上传占最后一段进度
+	span := float64(constants.VideoProgressReady-constants.VideoProgressTranscoded) / float64(len(job.Renditions)+1)
+	for i, r := range job.Renditions {
+		if r.Size, err = svc.store.Upload(ctx, constants.VideoBucket, r.ObjectKey, r.LocalPath, constants.VideoType); err != nil {
+			return err
+		}
+		report(constants.VideoProgressTranscoded + int64(span*float64(i+1)))
+	}
+	if err = svc.db.FinishProcessing(ctx, task.VideoID, job.Renditions); err != nil {
+		return err
+	}
+	svc.statusChanged(ctx, task.VideoID)
+	logger.Infof("video %d processed, %d renditions", task.VideoID, len(job.Renditions))
+	return nil
+}
+
+// FailProcessing 把视频标记为处理失败，过长的原因会被截断
+func (svc *ProcessingService) FailProcessing(ctx context.Context, videoID int64, reason string) error {
+	if r := []rune(reason); len(r) > constants.VideoFailReasonMaxLength {
+		reason = string(r[:constants.VideoFailReasonMaxLength])
+	}
+	if err := svc.db.FailProcessing(ctx, videoID, reason); err != nil {
+		return err
+	}
+	svc.statusChanged(ctx, videoID)
+	return nil
+}
+
+// progressReporter 返回写入处理进度的函数，进度增加不足 VideoProgressStep 时不写库
+// 进度只是展示用，写库失败不影响处理
+func (svc *ProcessingService) progressReporter(ctx context.Context, videoID int64) func(percent int64) {
+	var reported int64
+	return func(percent int64) {
+		if percent-reported < constants.VideoProgressStep {
+			return
+		}
+		if err := svc.db.UpdateProcessingProgress(ctx, videoID, percent); err != nil {
+			logger.Warnf("update processing progress of video %d failed: %v", videoID, err)
+			return
+		}
+		reported = percent
+	}
+}
+
+// statusChanged 处理状态变化后删除视频缓存，并通知视频服务的各实例更新搜索索引
+// 缓存中的视频带有处理状态，不删除的话其他用户要等缓存过期才能看到处理完成的视频
+func (svc *ProcessingService) statusChanged(ctx context.Context, videoID int64) {
+	if err := svc.redis.DeleteVideoRedis(ctx, videoID); err != nil {
+		logger.Errorf("delete cache of video %d failed: %v", videoID, err)
+	}
+	if err := svc.redis.PublishIndexEvent(ctx, videoID); err != nil {
+		logger.Errorf("publish index event of video %d failed: %v", videoID, err)
+	}
+}
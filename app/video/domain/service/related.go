@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+)
+
+// RefreshRelatedVideos 为所有公开视频重新计算相关视频，返回计算的视频数
+// 多个实例中每个周期只有抢到锁的实例计算，锁不主动释放，到期后下一个周期再计算
+func (svc *VideoService) RefreshRelatedVideos(ctx context.Context) (int, error) {
+	locked, err := svc.redis.LockRelatedRefresh(ctx, constants.RelatedVideoRefreshInterval)
+	if err != nil || !locked {
+		return 0, err
+	}
+
+	count := 0
+	var afterID int64
+	for {
+		videos, err := svc.db.ListIndexableVideos(ctx, afterID, constants.RelatedVideoBatchSize)
+		if err != nil {
+			return count, err
+		}
+		for _, v := range videos {
+			if err = svc.refreshRelated(ctx, v); err != nil {
+				logger.Errorf("refresh related videos of video %d failed: %v", v.VideoID, err)
+				continue
+			}
+			count++
+		}
+		if len(videos) < constants.RelatedVideoBatchSize {
+			return count, nil
+		}
+		afterID = videos[len(videos)-1].VideoID
+	}
+}
+
+func (svc *VideoService) refreshRelated(ctx context.Context, source *model.VideoProfile) error {
+	candidates, err := svc.db.ListRelatedCandidates(ctx, source, constants.RelatedVideoCandidateLimit)
+	if err != nil {
+		return err
+	}
+	for _, c := range candidates {
+		c.Score = relatedScore(c)
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Score != candidates[j].Score {
+			return candidates[i].Score > candidates[j].Score
+		}
+		return candidates[i].VideoID > candidates[j].VideoID
+	})
+	if len(candidates) > constants.RelatedVideoMaxSize {
+		candidates = candidates[:constants.RelatedVideoMaxSize]
+	}
+	return svc.redis.SetRelatedVideos(ctx, source.VideoID, candidates, constants.RelatedVideoTTL)
+}
+
+// relatedScore 计算相关度，共同点赞人数取对数，避免少数热门视频压过标签和作者的信号
+func relatedScore(c *model.RelatedCandidate) float64 {
+	score := float64(c.SharedTags) * constants.RelatedVideoTagWeight
+	if c.SameAuthor {
+		score += constants.RelatedVideoAuthorWeight
+	}
+	return score + math.Log1p(float64(c.CoLikes))*constants.RelatedVideoCoLikeWeight
+}
+
+// RelatedVideos 返回视频的相关视频，viewer 为 0 表示未登录用户，登录用户看过的视频不再推荐
+// 相关视频还没有计算出来或者过滤后不够时用热榜补足
+func (svc *VideoService) RelatedVideos(ctx context.Context, viewer, videoID, limit int64) ([]*model.VideoProfile, error) {
+	if limit <= 0 {
+		limit = constants.RelatedVideoDefaultLimit
+	}
+	if limit > constants.RelatedVideoMaxLimit {
+		limit = constants.RelatedVideoMaxLimit
+	}
+	related, err := svc.redis.GetRelatedVideos(ctx, videoID)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := svc.unwatched(ctx, viewer, videoID, related)
+	if err != nil {
+		return nil, err
+	}
+	videos := make([]*model.VideoProfile, 0, limit)
+	seen := make(map[int64]bool, len(ids))
+	videos = svc.appendRelated(ctx, videos, ids, limit, seen)
+	if int64(len(videos)) < limit {
+		hotIDs, err := svc.rankedVideoIDs(ctx, constants.RelatedVideoFallbackSize)
+		if err != nil {
+			return nil, err
+		}
+		if hotIDs, err = svc.unwatched(ctx, viewer, videoID, hotIDs); err != nil {
+			return nil, err
+		}
+		videos = svc.appendRelated(ctx, videos, hotIDs, limit, seen)
+	}
+	return videos, nil
+}
+
+// appendRelated 去重后按顺序读取视频追加到 videos 中，直到够 limit 个
+// 部分视频可能已经删除或不再公开，分批读取直到够数
+func (svc *VideoService) appendRelated(ctx context.Context, videos []*model.VideoProfile, ids []int64, limit int64, seen map[int64]bool) []*model.VideoProfile {
+	unique := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	for start := 0; start < len(unique) && int64(len(videos)) < limit; {
+		end := min(start+int(limit)-len(videos), len(unique))
+		for _, v := range svc.loadProfiles(ctx, unique[start:end]) {
+			if v.Status == constants.VideoStatusPublished {
+				videos = append(videos, v)
+			}
+		}
+		start = end
+	}
+	return videos
+}
+
+// unwatched 去掉视频本身和 viewer 看过的视频
+func (svc *VideoService) unwatched(ctx context.Context, viewer, videoID int64, ids []int64) ([]int64, error) {
+	filtered := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id != videoID {
+			filtered = append(filtered, id)
+		}
+	}
+	if viewer == 0 {
+		return filtered, nil
+	}
+	return svc.redis.FilterWatched(ctx, viewer, filtered)
+}
+
+// RecordWatch 记录用户看过的视频
+func (svc *VideoService) RecordWatch(ctx context.Context, uid, videoID int64) error {
+	return svc.redis.AddWatchHistory(ctx, uid, videoID, time.Now())
+}
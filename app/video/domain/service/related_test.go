@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/app/video/domain/repository"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+func TestRelatedScore(t *testing.T) {
+	convey.Convey("relatedScore", t, func() {
+		tests := []struct {
+			name      string
+			candidate model.RelatedCandidate
+			expected  float64
+		}{
+			{"no signal", model.RelatedCandidate{}, 0},
+			{"shared tags", model.RelatedCandidate{SharedTags: 2}, 2 * constants.RelatedVideoTagWeight},
+			{"same author", model.RelatedCandidate{SameAuthor: true}, constants.RelatedVideoAuthorWeight},
+			{"co-likes are log scaled", model.RelatedCandidate{CoLikes: 99}, math.Log(100) * constants.RelatedVideoCoLikeWeight},
+			{"signals add up", model.RelatedCandidate{SharedTags: 1, SameAuthor: true, CoLikes: 1},
+				constants.RelatedVideoTagWeight + constants.RelatedVideoAuthorWeight + math.Log(2)*constants.RelatedVideoCoLikeWeight},
+		}
+		for _, tt := range tests {
+			convey.Convey(tt.name, func() {
+				convey.So(relatedScore(&tt.candidate), convey.ShouldAlmostEqual, tt.expected, 1e-9)
+			})
+		}
+
+		convey.Convey("co-likes grow the score sublinearly", func() {
+			some := relatedScore(&model.RelatedCandidate{CoLikes: 100})
+			many := relatedScore(&model.RelatedCandidate{CoLikes: 1000})
+			convey.So(many, convey.ShouldBeGreaterThan, some)
+			convey.So(many, convey.ShouldBeLessThan, 2*some)
+		})
+	})
+}
+
+// relatedDB 返回预设的候选视频
+type relatedDB struct {
+	repository.VideoDB
+	candidates []*model.RelatedCandidate
+}
+
+func (db *relatedDB) ListRelatedCandidates(ctx context.Context, source *model.VideoProfile, limit int) ([]*model.RelatedCandidate, error) {
+	return db.candidates, nil
+}
+
+// relatedRedis 保存相关视频、热榜和观看记录
+type relatedRedis struct {
+	repository.VideoRedis
+	related map[int64][]int64
+	hotRank []int64
+	watched map[int64]map[int64]bool
+	videos  map[int64]*model.VideoProfile
+}
+
+func (r *relatedRedis) SetRelatedVideos(ctx context.Context, videoID int64, related []*model.RelatedCandidate, ttl time.Duration) error {
+	ids := make([]int64, 0, len(related))
+	for _, c := range related {
+		ids = append(ids, c.VideoID)
+	}
+	r.related[videoID] = ids
+	return nil
+}
+
+func (r *relatedRedis) GetRelatedVideos(ctx context.Context, videoID int64) ([]int64, error) {
+	return append([]int64{}, r.related[videoID]...), nil
+}
+
+func (r *relatedRedis) GetHotRankRange(ctx context.Context, start, end int64) ([]redis.Z, error) {
+	result := make([]redis.Z, 0, len(r.hotRank))
+	for i, id := range r.hotRank {
+		result = append(result, redis.Z{Score: float64(len(r.hotRank) - i), Member: strconv.FormatInt(id, 10)})
+	}
+	return result, nil
+}
+
+func (r *relatedRedis) FilterWatched(ctx context.Context, uid int64, videoIDs []int64) ([]int64, error) {
+	result := make([]int64, 0, len(videoIDs))
+	for _, id := range videoIDs {
+		if !r.watched[uid][id] {
+			result = append(result, id)
+		}
+	}
+	return result, nil
+}
+
+func (r *relatedRedis) GetVideoRedis(ctx context.Context, videoId int64) (*model.VideoProfile, error) {
+	if v, ok := r.videos[videoId]; ok {
+		copied := *v
+		return &copied, nil
+	}
+	return nil, errno.Errorf(errno.RedisKeyNotExist, "video %d not cached", videoId)
+}
+
+func (r *relatedRedis) GetViews(ctx context.Context, videoId int64) (int64, error) { return 0, nil }
+
+func (r *relatedRedis) GetLikes(ctx context.Context, videoId int64) (int64, error) { return 0, nil }
+
+func TestVideoService_RelatedVideos(t *testing.T) {
+	convey.Convey("related videos", t, func() {
+		ctx := context.Background()
+		const source, viewer = 1, 100
+		videos := map[int64]*model.VideoProfile{}
+		for id := int64(1); id <= 9; id++ {
+			videos[id] = &model.VideoProfile{VideoID: id, Status: constants.VideoStatusPublished, Visibility: constants.VideoVisibilityPublic}
+		}
+		videos[4].Visibility = constants.VideoVisibilityPrivate
+		videos[5].Status = constants.VideoStatusDraft
+		rdb := &relatedRedis{
+			related: map[int64][]int64{},
+			hotRank: []int64{9, 1, 3, 8, 7},
+			watched: map[int64]map[int64]bool{viewer: {2: true, 8: true}},
+			videos:  videos,
+		}
+		db := &relatedDB{candidates: []*model.RelatedCandidate{
+			{VideoID: 2, CoLikes: 1},
+			{VideoID: 3, SharedTags: 2},
+			{VideoID: 4, SharedTags: 3},
+			{VideoID: 5, SameAuthor: true},
+			{VideoID: 6, SameAuthor: true},
+		}}
+		svc := &VideoService{db: db, redis: rdb}
+		ids := func(videos []*model.VideoProfile) []int64 {
+			result := make([]int64, 0, len(videos))
+			for _, v := range videos {
+				result = append(result, v.VideoID)
+			}
+			return result
+		}
+
+		convey.So(svc.refreshRelated(ctx, videos[source]), convey.ShouldBeNil)
+
+		convey.Convey("candidates are ranked by score, ties by newer video", func() {
+			convey.So(rdb.related[source], convey.ShouldResemble, []int64{4, 3, 2, 6, 5})
+		})
+
+		convey.Convey("hidden and unpublished videos are skipped", func() {
+			result, err := svc.RelatedVideos(ctx, 0, source, 3)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ids(result), convey.ShouldResemble, []int64{3, 2, 6})
+		})
+
+		convey.Convey("watched videos and the source are excluded, trending fills the gap left by hidden ones", func() {
+			result, err := svc.RelatedVideos(ctx, viewer, source, 4)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ids(result), convey.ShouldResemble, []int64{3, 6, 9, 7})
+		})
+
+		convey.Convey("videos without related results fall back to trending", func() {
+			result, err := svc.RelatedVideos(ctx, viewer, 7, 10)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ids(result), convey.ShouldResemble, []int64{9, 1, 3})
+		})
+	})
+}
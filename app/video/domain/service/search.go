@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+)
+
+// RefreshSearchIndex 视频投稿、编辑、删除、发布后调用，更新本实例的索引并通知其他实例
+// 使用 MySQL 搜索时什么也不做
+func (svc *VideoService) RefreshSearchIndex(ctx context.Context, videoID int64) error {
+	if svc.engine == nil {
+		return nil
+	}
+	if err := svc.reindexVideo(ctx, videoID); err != nil {
+		return err
+	}
+	return svc.redis.PublishIndexEvent(ctx, videoID)
+}
+
+// reindexVideo 以数据库为准更新索引：已发布、公开且处理完成的视频写入索引，其余状态或已删除的视频从索引中移除
+// 事件只携带视频 ID，重复或乱序处理都不会出错
+func (svc *VideoService) reindexVideo(ctx context.Context, videoID int64) error {
+	profile, err := svc.db.GetVideoDB(ctx, videoID)
+	if err != nil {
+		if errno.ConvertErr(err).ErrorCode == errno.DBNotFound {
+			svc.engine.Remove(videoID)
+			return nil
+		}
+		return err
+	}
+	if !svc.IsListed(profile) || profile.ProcessingStatus != constants.VideoProcessingStatusReady {
+		svc.engine.Remove(videoID)
+		return nil
+	}
+	svc.engine.Index(profile)
+	return nil
+}
+
+// InitSearchIndex 加载索引快照并追补快照之后的变化，没有可用的快照时从数据库全量重建
+func (svc *VideoService) InitSearchIndex(ctx context.Context) error {
+	takenAt, err := svc.engine.LoadSnapshot(ctx)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logger.Errorf("load search index snapshot failed, rebuilding: %v", err)
+		}
+		return svc.rebuildSearchIndex(ctx)
+	}
+
+	ids, err := svc.db.ListUpdatedVideoIDs(ctx, takenAt.Add(-constants.SearchIndexCatchUpMargin))
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		if err = svc.reindexVideo(ctx, id); err != nil {
+			return err
+		}
+	}
+	logger.Infof("search index loaded from snapshot taken at %v, %d videos caught up, %d videos indexed",
+		takenAt, len(ids), svc.engine.Len())
+	return nil
+}
+
+func (svc *VideoService) rebuildSearchIndex(ctx context.Context) error {
+	var lastID int64
+	for {
+		videos, err := svc.db.ListIndexableVideos(ctx, lastID, constants.SearchIndexRebuildBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(videos) == 0 {
+			break
+		}
+		for _, v := range videos {
+			svc.engine.Index(v)
+		}
+		lastID = videos[len(videos)-1].VideoID
+	}
+	logger.Infof("search index rebuilt from database, %d videos indexed", svc.engine.Len())
+	return nil
+}
+
+// runSearchIndex 初始化索引并持续消费索引事件，初始化完成之前搜索仍然走 MySQL
+// 先订阅再初始化，初始化期间的事件会在之后被处理，不会丢失
+func (svc *VideoService) runSearchIndex(ctx context.Context) {
+	events, err := svc.redis.SubscribeIndexEvents(ctx)
+	for err != nil {
+		logger.Errorf("subscribe search index events failed: %v", err)
+		time.Sleep(constants.SearchIndexRetryInterval)
+		events, err = svc.redis.SubscribeIndexEvents(ctx)
+	}
+	for err = svc.InitSearchIndex(ctx); err != nil; err = svc.InitSearchIndex(ctx) {
+		logger.Errorf("init search index failed: %v", err)
+		time.Sleep(constants.SearchIndexRetryInterval)
+	}
+	svc.indexReady.Store(true)
+
+	for videoID := range events {
+		if err := svc.reindexVideo(ctx, videoID); err != nil {
+			logger.Errorf("reindex video %d failed: %v", videoID, err)
+		}
+	}
+	logger.Errorf("search index events channel closed, index will no longer be updated")
+}
@@ -11,6 +11,8 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/LingeringAutumn/Yijie/config"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
 	"github.com/LingeringAutumn/Yijie/pkg/logger"
 
 	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
@@ -66,17 +68,48 @@ func (svc *VideoService) SetVideoRedis(ctx context.Context, videoProfile *model.
 	return svc.redis.SetVideoRedis(ctx, videoProfile)
 }
 
-// TrendVideo Redis 热度排行榜 + 数据缓存
-func (svc *VideoService) TrendVideo(ctx context.Context, pageNum, pageSize int64) ([]*model.VideoProfile, error) {
-	start := (pageNum - 1) * pageSize
-	end := start + pageSize - 1
+// TrendVideo 按热度返回一页热榜视频和下一页的游标，没有更多视频时游标为空
+// 第一页时把热榜前 VideoPageSnapshotSize 个视频冻结成快照，之后的页从快照中读取，热度返回最新的值
+func (svc *VideoService) TrendVideo(ctx context.Context, cursor string, pageNum, pageSize int64) ([]*model.VideoProfile, string, error) {
+	pageSize, err := normalizePageSize(pageSize)
+	if err != nil {
+		return nil, "", err
+	}
+	var ids []int64
+	var next string
+	if cursor != "" {
+		ids, next, _, err = svc.nextPage(ctx, trendScope, cursor, pageSize)
+	} else {
+		var ranked []int64
+		if ranked, err = svc.rankedVideoIDs(ctx, constants.VideoPageSnapshotSize); err != nil {
+			return nil, "", err
+		}
+		ids, next, err = svc.firstPage(ctx, trendScope, ranked, int64(len(ranked)), pageOffset(pageNum, pageSize), pageSize)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	videos := svc.loadProfiles(ctx, ids)
+	videoIDs := make([]int64, 0, len(videos))
+	for _, v := range videos {
+		videoIDs = append(videoIDs, v.VideoID)
+	}
+	if scores, err := svc.redis.GetHotScores(ctx, videoIDs); err == nil {
+		for i, v := range videos {
+			v.HotScore = scores[i]
+		}
+	}
+	return videos, next, nil
+}
 
-	idsWithScores, err := svc.redis.GetHotRankRange(ctx, start, end)
+// rankedVideoIDs 读取热榜前 size 个视频 ID
+func (svc *VideoService) rankedVideoIDs(ctx context.Context, size int64) ([]int64, error) {
+	idsWithScores, err := svc.redis.GetHotRankRange(ctx, 0, size-1)
 	if err != nil {
 		return nil, fmt.Errorf("redis hot_rank fetch failed: %w", err)
 	}
-
-	var results []*model.VideoProfile
+	ids := make([]int64, 0, len(idsWithScores))
 	for _, item := range idsWithScores {
 		videoIDStr, ok := item.Member.(string)
 		if !ok {
@@ -86,51 +119,80 @@ func (svc *VideoService) TrendVideo(ctx context.Context, pageNum, pageSize int64
 		if err != nil {
 			continue
 		}
+		ids = append(ids, videoID)
+	}
+	return ids, nil
+}
 
-		profile, err := svc.redis.GetVideoRedis(ctx, videoID)
-		if err != nil {
-			profile, err = svc.db.GetVideoDB(ctx, videoID)
-			if err != nil {
-				continue
-			}
-			_ = svc.redis.SetVideoRedis(ctx, profile)
+// SearchVideo 全文搜索并按标签过滤，返回一页结果、命中总数和下一页的游标
+// 第一页时把前 VideoPageSnapshotSize 个结果冻结成快照，之后的页从快照中读取
+func (svc *VideoService) SearchVideo(ctx context.Context, query *model.VideoSearchQuery) (*model.VideoSearchResult, error) {
+	pageSize, err := normalizePageSize(query.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	scope := searchCacheKey(snapshotQuery(query))
+	var ids []int64
+	var next string
+	var total int64
+	if query.Cursor != "" {
+		ids, next, total, err = svc.nextPage(ctx, scope, query.Cursor, pageSize)
+	} else {
+		var matched []int64
+		if matched, total, err = svc.searchVideoIDs(ctx, query); err != nil {
+			return nil, err
 		}
-
-		profile.HotScore = item.Score
-		profile.Views, _ = svc.GetViews(ctx, videoID)
-		profile.Likes, _ = svc.GetLikes(ctx, videoID) //
-		results = append(results, profile)
+		ids, next, err = svc.firstPage(ctx, scope, matched, total, pageOffset(query.PageNum, pageSize), pageSize)
 	}
+	if err != nil {
+		return nil, err
+	}
+	return &model.VideoSearchResult{Videos: svc.loadProfiles(ctx, ids), Total: total, NextCursor: next}, nil
+}
 
-	return results, nil
+// snapshotQuery 返回用于生成快照的查询条件：与分页无关，固定取前 VideoPageSnapshotSize 个结果
+func snapshotQuery(query *model.VideoSearchQuery) *model.VideoSearchQuery {
+	q := *query
+	q.PageNum, q.PageSize, q.Cursor = 1, constants.VideoPageSnapshotSize, ""
+	return &q
 }
 
-// SearchVideo 按关键词模糊搜索，Redis 无法预缓存，仅搜索结果缓存
-func (svc *VideoService) SearchVideo(ctx context.Context, keyword string, tags []string, pageNum, pageSize int64) ([]*model.VideoProfile, error) {
-	cacheKey := fmt.Sprintf("video:search:%s:%d:%d", keyword, pageNum, pageSize)
-	cached, err := svc.redis.GetSearchCache(ctx, cacheKey)
-	if err == nil && cached != nil {
-		// 合并播放量 + 点赞数
-		for _, v := range cached {
-			v.Views, _ = svc.GetViews(ctx, v.VideoID)
-			v.Likes, _ = svc.GetLikes(ctx, v.VideoID)
+// searchVideoIDs 按排序返回前 VideoPageSnapshotSize 个命中的视频 ID 和命中总数
+// 配置了内置引擎且索引加载完成时使用内置引擎，索引实时更新，所以不缓存结果；否则使用 MySQL 全文索引并缓存结果
+func (svc *VideoService) searchVideoIDs(ctx context.Context, query *model.VideoSearchQuery) ([]int64, int64, error) {
+	q := snapshotQuery(query)
+	if svc.engine != nil && svc.indexReady.Load() {
+		ids, total := svc.engine.Search(q)
+		return ids, total, nil
+	}
+
+	// 缓存中只保存视频 ID，视频信息在读取每一页时再查询；读不到缓存版本号时不使用缓存
+	version, err := svc.redis.GetSearchCacheVersion(ctx)
+	cacheable := err == nil
+	cacheKey := versionedSearchCacheKey(version, searchCacheKey(q))
+	if cacheable {
+		cached, err := svc.redis.GetSearchCache(ctx, cacheKey)
+		if err == nil && cached != nil {
+			ids := make([]int64, 0, len(cached.Videos))
+			for _, v := range cached.Videos {
+				ids = append(ids, v.VideoID)
+			}
+			return ids, cached.Total, nil
 		}
-		return cached, nil
 	}
 
-	dbResult, err := svc.db.SearchVideo(ctx, keyword, tags, pageNum, pageSize)
+	ids, total, err := svc.db.SearchVideoIDs(ctx, q, constants.VideoPageSnapshotSize)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
-
-	// 合并播放量 + 点赞数
-	for _, v := range dbResult {
-		v.Views, _ = svc.GetViews(ctx, v.VideoID)
-		v.Likes, _ = svc.GetLikes(ctx, v.VideoID)
+	if cacheable {
+		result := &model.VideoSearchResult{Videos: make([]*model.VideoProfile, 0, len(ids)), Total: total}
+		for _, id := range ids {
+			result.Videos = append(result.Videos, &model.VideoProfile{VideoID: id})
+		}
+		_ = svc.redis.SetSearchCache(ctx, cacheKey, result, constants.VideoSearchCacheTTL)
 	}
-
-	_ = svc.redis.SetSearchCache(ctx, cacheKey, dbResult, 5*time.Minute)
-	return dbResult, nil
+	return ids, total, nil
 }
 
 func (svc *VideoService) IncrViews(ctx context.Context, videoId int64) (int64, error) {
@@ -175,6 +237,9 @@ func (svc *VideoService) SyncViewsToDB(ctx context.Context) error {
 		if err := svc.db.UpdateViews(ctx, videoID, views); err != nil {
 			return err
 		}
+		if svc.engine != nil {
+			svc.engine.UpdateViews(videoID, views)
+		}
 	}
 
 	return nil
@@ -197,6 +262,86 @@ func (svc *VideoService) StartBackgroundTasks() {
 		}
 	}()
 
+	// 启动定时任务：发布到期的定时草稿
+	go func() {
+		ticker := time.NewTicker(constants.VideoPublishScanInterval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			published, err := svc.PublishDueDrafts(context.Background())
+			if err != nil {
+				logger.Errorf("periodic publish scheduled drafts failed: %v", err)
+			}
+			if published > 0 {
+				logger.Infof("published %d scheduled drafts", published)
+			}
+		}
+	}()
+
+	// 启动定时任务：清理已删除视频在 MinIO 中的文件
+	go func() {
+		ticker := time.NewTicker(constants.VideoPurgeInterval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			purged, err := svc.PurgeDeletedObjects(context.Background())
+			if err != nil {
+				logger.Errorf("periodic purge deleted videos failed: %v", err)
+			}
+			if purged > 0 {
+				logger.Infof("purged %d deleted video objects", purged)
+			}
+		}
+	}()
+
+	// 启动定时任务：热搜榜按时间衰减
+	go func() {
+		ticker := time.NewTicker(constants.TrendingSearchDecayInterval)
+		defer ticker.Stop()
+
+		for range ticker.C {
+			if _, err := svc.DecayTrendingSearches(context.Background()); err != nil {
+				logger.Errorf("periodic decay trending searches failed: %v", err)
+			}
+		}
+	}()
+
+	// 启动定时任务：计算相关视频，启动时先计算一次
+	go func() {
+		ticker := time.NewTicker(constants.RelatedVideoRefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			refreshed, err := svc.RefreshRelatedVideos(context.Background())
+			if err != nil {
+				logger.Errorf("periodic refresh related videos failed: %v", err)
+			}
+			if refreshed > 0 {
+				logger.Infof("refreshed related videos of %d videos", refreshed)
+			}
+			<-ticker.C
+		}
+	}()
+
+	// 内置搜索引擎：加载索引、消费索引事件、定时保存快照
+	if svc.engine != nil {
+		go svc.runSearchIndex(context.Background())
+
+		go func() {
+			ticker := time.NewTicker(config.GetSearchSnapshotInterval())
+			defer ticker.Stop()
+
+			for range ticker.C {
+				if !svc.indexReady.Load() {
+					continue
+				}
+				if err := svc.engine.SaveSnapshot(context.Background()); err != nil {
+					logger.Errorf("periodic save search index snapshot failed: %v", err)
+				}
+			}
+		}()
+	}
+
 	// 启动退出监听：服务关闭前执行一次 Redis → MySQL 同步
 	go func() {
 		sigs := make(chan os.Signal, 1)
@@ -209,6 +354,11 @@ func (svc *VideoService) StartBackgroundTasks() {
 		} else {
 			logger.Infof("flush before shutdown success")
 		}
+		if svc.engine != nil && svc.indexReady.Load() {
+			if err := svc.engine.SaveSnapshot(context.Background()); err != nil {
+				logger.Errorf("save search index snapshot before shutdown failed: %v", err)
+			}
+		}
 		os.Exit(0)
 	}()
 }
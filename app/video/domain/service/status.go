@@ -0,0 +1,34 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// GetProcessingStatus 获取视频的处理状态
+// 没有处理记录的视频是处理流程上线之前上传的，视为已就绪
+func (svc *VideoService) GetProcessingStatus(ctx context.Context, profile *model.VideoProfile) (*model.VideoProcessing, error) {
+	processing, err := svc.db.GetProcessing(ctx, profile.VideoID)
+	if err == nil {
+		return processing, nil
+	}
+	if errno.ConvertErr(err).ErrorCode != errno.DBNotFound {
+		return nil, err
+	}
+	return &model.VideoProcessing{
+		VideoID:   profile.VideoID,
+		Status:    constants.VideoProcessingStatusReady,
+		Progress:  constants.VideoProgressReady,
+		CreatedAt: time.Unix(profile.CreatedAt, 0),
+		UpdatedAt: time.Unix(profile.CreatedAt, 0),
+	}, nil
+}
+
+// FailProcessing 把还没有处理完成的视频标记为处理失败
+func (svc *VideoService) FailProcessing(ctx context.Context, videoID int64, reason string) error {
+	return svc.db.FailProcessing(ctx, videoID, reason)
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+)
+
+// RecordSearch 记录一次搜索：计入热搜榜，并提高这个搜索词在联想中的权重
+func (svc *VideoService) RecordSearch(ctx context.Context, keyword string) error {
+	phrase := normalizeSuggestion(keyword)
+	if phrase == "" {
+		return nil
+	}
+	if err := svc.redis.IncrTrendingSearch(ctx, phrase); err != nil {
+		return err
+	}
+	return svc.redis.AddSuggestion(ctx, suggestPrefixes(phrase), phrase, 1)
+}
+
+// AddTitleSuggestion 视频发布或改标题后，把标题加入联想
+func (svc *VideoService) AddTitleSuggestion(ctx context.Context, title string) error {
+	phrase := normalizeSuggestion(title)
+	if phrase == "" {
+		return nil
+	}
+	return svc.redis.AddSuggestion(ctx, suggestPrefixes(phrase), phrase, constants.SearchSuggestTitleWeight)
+}
+
+// RemoveTitleSuggestion 视频删除或改标题后，扣除旧标题在联想中的权重
+// 只扣除标题贡献的权重，同名的其他视频和用户的搜索记录不受影响
+func (svc *VideoService) RemoveTitleSuggestion(ctx context.Context, title string) error {
+	phrase := normalizeSuggestion(title)
+	if phrase == "" {
+		return nil
+	}
+	return svc.redis.AddSuggestion(ctx, suggestPrefixes(phrase), phrase, -constants.SearchSuggestTitleWeight)
+}
+
+// SuggestSearch 返回以 prefix 开头的联想建议
+func (svc *VideoService) SuggestSearch(ctx context.Context, prefix string, limit int) ([]string, error) {
+	if limit <= 0 {
+		limit = constants.SearchSuggestDefaultLimit
+	}
+	if limit > constants.SearchSuggestMaxLimit {
+		limit = constants.SearchSuggestMaxLimit
+	}
+	prefix = strings.ToLower(strings.Join(strings.Fields(prefix), " "))
+	if prefix == "" {
+		return []string{}, nil
+	}
+
+	// 超出前缀索引长度时，取出索引中的全部建议再按完整前缀过滤
+	runes := []rune(prefix)
+	if len(runes) <= constants.SearchSuggestMaxPrefixLen {
+		return svc.redis.GetSuggestions(ctx, prefix, int64(limit))
+	}
+	candidates, err := svc.redis.GetSuggestions(ctx, string(runes[:constants.SearchSuggestMaxPrefixLen]), constants.SearchSuggestSetSize)
+	if err != nil {
+		return nil, err
+	}
+	suggestions := make([]string, 0, limit)
+	for _, c := range candidates {
+		if strings.HasPrefix(c, prefix) {
+			suggestions = append(suggestions, c)
+			if len(suggestions) == limit {
+				break
+			}
+		}
+	}
+	return suggestions, nil
+}
+
+// TrendingSearches 返回热搜榜的前 limit 个搜索词
+func (svc *VideoService) TrendingSearches(ctx context.Context, limit int) ([]*model.SearchTrend, error) {
+	if limit <= 0 {
+		limit = constants.TrendingSearchDefaultLimit
+	}
+	if limit > constants.TrendingSearchMaxLimit {
+		limit = constants.TrendingSearchMaxLimit
+	}
+	return svc.redis.GetTrendingSearches(ctx, int64(limit))
+}
+
+func (svc *VideoService) DecayTrendingSearches(ctx context.Context) (bool, error) {
+	return svc.redis.DecayTrendingSearches(ctx, time.Now())
+}
+
+// normalizeSuggestion 合并连续空白并转成小写，过长的内容不适合作为联想，返回空字符串
+func normalizeSuggestion(text string) string {
+	phrase := strings.ToLower(strings.Join(strings.Fields(text), " "))
+	if utf8.RuneCountInString(phrase) > constants.SearchSuggestMaxPhraseLen {
+		return ""
+	}
+	return phrase
+}
+
+// suggestPrefixes 返回 phrase 的所有前缀，最长 SearchSuggestMaxPrefixLen 个字
+func suggestPrefixes(phrase string) []string {
+	runes := []rune(phrase)
+	if len(runes) > constants.SearchSuggestMaxPrefixLen {
+		runes = runes[:constants.SearchSuggestMaxPrefixLen]
+	}
+	prefixes := make([]string, 0, len(runes))
+	for i := 1; i <= len(runes); i++ {
+		prefix := string(runes[:i])
+		// 以空格结尾的前缀和去掉空格的前缀查询时是一样的
+		if !strings.HasSuffix(prefix, " ") {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes
+}
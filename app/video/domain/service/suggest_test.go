@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/repository"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+)
+
+func TestNormalizeSuggestion(t *testing.T) {
+	convey.Convey("normalizeSuggestion", t, func() {
+		tests := []struct {
+			name     string
+			text     string
+			expected string
+		}{
+			{"lowercases", "Golang", "golang"},
+			{"collapses whitespace", "  Go \t  Kitex\n", "go kitex"},
+			{"keeps non-ascii", "美食 Vlog", "美食 vlog"},
+			{"blank text", "   ", ""},
+			{"phrase at max length", strings.Repeat("字", constants.SearchSuggestMaxPhraseLen), strings.Repeat("字", constants.SearchSuggestMaxPhraseLen)},
+			{"overlong phrase is dropped", strings.Repeat("字", constants.SearchSuggestMaxPhraseLen+1), ""},
+		}
+		for _, tt := range tests {
+			convey.Convey(tt.name, func() {
+				convey.So(normalizeSuggestion(tt.text), convey.ShouldEqual, tt.expected)
+			})
+		}
+	})
+}
+
+func TestSuggestPrefixes(t *testing.T) {
+	convey.Convey("suggestPrefixes", t, func() {
+		long := strings.Repeat("字", constants.SearchSuggestMaxPrefixLen+5)
+		tests := []struct {
+			name     string
+			phrase   string
+			expected []string
+		}{
+			{"every prefix", "abc", []string{"a", "ab", "abc"}},
+			{"prefixes ending in a space are skipped", "go k", []string{"g", "go", "go k"}},
+			{"splits by character not byte", "美食", []string{"美", "美食"}},
+			{"empty phrase", "", []string{}},
+		}
+		for _, tt := range tests {
+			convey.Convey(tt.name, func() {
+				convey.So(suggestPrefixes(tt.phrase), convey.ShouldResemble, tt.expected)
+			})
+		}
+
+		convey.Convey("long phrases are indexed up to the max prefix length", func() {
+			prefixes := suggestPrefixes(long)
+			convey.So(prefixes, convey.ShouldHaveLength, constants.SearchSuggestMaxPrefixLen)
+			convey.So(prefixes[len(prefixes)-1], convey.ShouldEqual, strings.Repeat("字", constants.SearchSuggestMaxPrefixLen))
+		})
+	})
+}
+
+// suggestRedis 按前缀返回固定的建议，记录查询的前缀和数量
+type suggestRedis struct {
+	repository.VideoRedis
+	suggestions map[string][]string
+	prefix      string
+	limit       int64
+}
+
+func (r *suggestRedis) GetSuggestions(ctx context.Context, prefix string, limit int64) ([]string, error) {
+	r.prefix, r.limit = prefix, limit
+	return r.suggestions[prefix], nil
+}
+
+func TestVideoService_SuggestSearch(t *testing.T) {
+	convey.Convey("SuggestSearch", t, func() {
+		ctx := context.Background()
+		indexed := strings.Repeat("a", constants.SearchSuggestMaxPrefixLen)
+		redis := &suggestRedis{suggestions: map[string][]string{
+			"go":    {"go", "golang"},
+			indexed: {indexed + "bc", indexed + "bd", indexed + "x"},
+		}}
+		svc := &VideoService{redis: redis}
+
+		convey.Convey("input is normalized before lookup", func() {
+			suggestions, err := svc.SuggestSearch(ctx, "  GO ", 0)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(suggestions, convey.ShouldResemble, []string{"go", "golang"})
+			convey.So(redis.limit, convey.ShouldEqual, constants.SearchSuggestDefaultLimit)
+		})
+
+		convey.Convey("limit is capped", func() {
+			_, err := svc.SuggestSearch(ctx, "go", 1000)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(redis.limit, convey.ShouldEqual, constants.SearchSuggestMaxLimit)
+		})
+
+		convey.Convey("blank input returns nothing without querying", func() {
+			suggestions, err := svc.SuggestSearch(ctx, "   ", 5)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(suggestions, convey.ShouldBeEmpty)
+			convey.So(redis.prefix, convey.ShouldBeEmpty)
+		})
+
+		convey.Convey("input longer than the prefix index is filtered by the full prefix", func() {
+			suggestions, err := svc.SuggestSearch(ctx, indexed+"b", 1)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(redis.prefix, convey.ShouldEqual, indexed)
+			convey.So(redis.limit, convey.ShouldEqual, constants.SearchSuggestSetSize)
+			convey.So(suggestions, convey.ShouldResemble, []string{indexed + "bc"})
+		})
+	})
+}
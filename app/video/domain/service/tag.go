@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// NormalizeTags 规范化标签：去掉首尾空白和开头的 #，转成小写，去掉空标签和重复标签
+// 超过长度或数量限制时返回参数错误
+func (svc *VideoService) NormalizeTags(tags []string) ([]string, error) {
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(strings.TrimLeft(strings.TrimSpace(tag), "#")))
+		if tag == "" {
+			continue
+		}
+		if utf8.RuneCountInString(tag) > constants.VideoTagMaxLength {
+			return nil, errno.ParamVerifyError.WithMessage(fmt.Sprintf("tag should not exceed %d characters", constants.VideoTagMaxLength))
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		result = append(result, tag)
+	}
+	if len(result) > constants.VideoTagMaxCount {
+		return nil, errno.ParamVerifyError.WithMessage(fmt.Sprintf("a video can have at most %d tags", constants.VideoTagMaxCount))
+	}
+	return result, nil
+}
+
+// NormalizeTagMode 校验标签匹配方式，默认命中任一标签
+func (svc *VideoService) NormalizeTagMode(tagMode string) (string, error) {
+	switch tagMode {
+	case "", constants.VideoTagModeAny:
+		return constants.VideoTagModeAny, nil
+	case constants.VideoTagModeAll:
+		return constants.VideoTagModeAll, nil
+	default:
+		return "", errno.ParamVerifyError.WithMessage("tag_mode should be any or all")
+	}
+}
+
+// NormalizeSearchSort 校验搜索排序方式，默认按综合相关度排序
+func (svc *VideoService) NormalizeSearchSort(sort string) (string, error) {
+	switch sort {
+	case "":
+		return constants.VideoSearchSortRelevance, nil
+	case constants.VideoSearchSortRelevance, constants.VideoSearchSortNewest, constants.VideoSearchSortMostViewed:
+		return sort, nil
+	default:
+		return "", errno.ParamVerifyError.WithMessage("sort should be relevance, newest or most_viewed")
+	}
+}
+
+func (svc *VideoService) ListVideosByTag(ctx context.Context, tag string, pageNum, pageSize int64) ([]*model.VideoProfile, error) {
+	videos, err := svc.db.ListVideosByTag(ctx, tag, pageNum, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range videos {
+		v.Views, _ = svc.GetViews(ctx, v.VideoID)
+		v.Likes, _ = svc.GetLikes(ctx, v.VideoID)
+	}
+	return videos, nil
+}
+
+// PopularTags 返回使用最多的 limit 个标签
+// 缓存中保存前 PopularTagsMaxLimit 个，按需截取
+func (svc *VideoService) PopularTags(ctx context.Context, limit int) ([]*model.TagCount, error) {
+	if limit <= 0 {
+		limit = constants.PopularTagsDefaultLimit
+	}
+	if limit > constants.PopularTagsMaxLimit {
+		limit = constants.PopularTagsMaxLimit
+	}
+	tags, err := svc.redis.GetPopularTagsCache(ctx)
+	if err != nil {
+		tags, err = svc.db.PopularTags(ctx, constants.PopularTagsMaxLimit)
+		if err != nil {
+			return nil, err
+		}
+		_ = svc.redis.SetPopularTagsCache(ctx, tags, constants.PopularTagsCacheTTL)
+	}
+	if len(tags) > limit {
+		tags = tags[:limit]
+	}
+	return tags, nil
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+)
+
+func TestVideoService_NormalizeTags(t *testing.T) {
+	convey.Convey("NormalizeTags", t, func() {
+		svc := &VideoService{}
+		tooMany := make([]string, 0, constants.VideoTagMaxCount+1)
+		for i := 0; i <= constants.VideoTagMaxCount; i++ {
+			tooMany = append(tooMany, strings.Repeat("t", i+1))
+		}
+
+		tests := []struct {
+			name     string
+			tags     []string
+			expected []string
+			wantErr  bool
+		}{
+			{"trims, strips # and lowercases", []string{"  #Go ", "##Kitex"}, []string{"go", "kitex"}, false},
+			{"drops empty and duplicate tags", []string{"go", "", " # ", "GO", "#go"}, []string{"go"}, false},
+			{"keeps the first occurrence order", []string{"b", "a", "B"}, []string{"b", "a"}, false},
+			{"keeps non-ascii tags", []string{"#美食"}, []string{"美食"}, false},
+			{"nil means no tags", nil, []string{}, false},
+			{"rejects overlong tags", []string{strings.Repeat("长", constants.VideoTagMaxLength+1)}, nil, true},
+			{"max length counts characters", []string{strings.Repeat("长", constants.VideoTagMaxLength)}, []string{strings.Repeat("长", constants.VideoTagMaxLength)}, false},
+			{"rejects too many tags", tooMany, nil, true},
+			{"duplicates do not count towards the limit", append(tooMany[:constants.VideoTagMaxCount:constants.VideoTagMaxCount], "T"), tooMany[:constants.VideoTagMaxCount], false},
+		}
+		for _, tt := range tests {
+			convey.Convey(tt.name, func() {
+				tags, err := svc.NormalizeTags(tt.tags)
+				if tt.wantErr {
+					convey.So(err, convey.ShouldNotBeNil)
+					return
+				}
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(tags, convey.ShouldResemble, tt.expected)
+			})
+		}
+	})
+}
+
+func TestVideoService_NormalizeTagMode(t *testing.T) {
+	convey.Convey("NormalizeTagMode", t, func() {
+		svc := &VideoService{}
+		tests := []struct {
+			mode     string
+			expected string
+			wantErr  bool
+		}{
+			{"", constants.VideoTagModeAny, false},
+			{constants.VideoTagModeAny, constants.VideoTagModeAny, false},
+			{constants.VideoTagModeAll, constants.VideoTagModeAll, false},
+			{"ALL", "", true},
+			{"none", "", true},
+		}
+		for _, tt := range tests {
+			convey.Convey("mode "+tt.mode, func() {
+				mode, err := svc.NormalizeTagMode(tt.mode)
+				convey.So(err != nil, convey.ShouldEqual, tt.wantErr)
+				convey.So(mode, convey.ShouldEqual, tt.expected)
+			})
+		}
+	})
+}
@@ -0,0 +1,310 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/app/video/domain/repository"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+	"github.com/LingeringAutumn/Yijie/pkg/mp4"
+)
+
+// InitUpload 发起分片上传，视频信息暂存在上传会话中，所有分片合并并校验通过后才创建视频
+func (svc *VideoService) InitUpload(ctx context.Context, uid int64, video *model.Video, fileSize int64) (*model.UploadSession, error) {
+	if fileSize <= 0 || fileSize > constants.VideoUploadMaxFileSize {
+		return nil, errno.ParamVerifyError.WithMessage(fmt.Sprintf("file size should be between 1 and %d bytes", constants.VideoUploadMaxFileSize))
+	}
+	uploadID, err := svc.sf.NextVal()
+	if err != nil {
+		return nil, fmt.Errorf("sf: failed to generate upload ID: %w", err)
+	}
+	object := fmt.Sprintf(constants.VideoUploadObjectFormat, uploadID)
+	multipartID, err := svc.store.NewMultipartUpload(ctx, constants.UploadBucket, object, constants.VideoType)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &model.UploadSession{
+		UploadID:    uploadID,
+		UserID:      uid,
+		MultipartID: multipartID,
+		Object:      object,
+		FileSize:    fileSize,
+		ChunkSize:   constants.VideoUploadChunkSize,
+		TotalChunks: (fileSize + constants.VideoUploadChunkSize - 1) / constants.VideoUploadChunkSize,
+		Video:       video,
+	}
+	if err = svc.saveUploadSession(ctx, session); err != nil {
+		if abortErr := svc.store.AbortMultipartUpload(ctx, constants.UploadBucket, object, multipartID); abortErr != nil {
+			logger.Errorf("abort multipart upload of %s failed: %v", object, abortErr)
+		}
+		return nil, err
+	}
+	return session, nil
+}
+
+// InitDirectUpload 发起直传，返回预签名的表单，客户端用它把整个文件直接上传到暂存桶，文件不经过网关
+// 表单限制了文件的类型和大小，完成直传时还会再校验一次
+func (svc *VideoService) InitDirectUpload(ctx context.Context, uid int64, video *model.Video, fileSize int64) (*model.UploadSession, *model.PresignedUpload, error) {
+	if fileSize <= 0 || fileSize > constants.VideoUploadMaxFileSize {
+		return nil, nil, errno.ParamVerifyError.WithMessage(fmt.Sprintf("file size should be between 1 and %d bytes", constants.VideoUploadMaxFileSize))
+	}
+	uploadID, err := svc.sf.NextVal()
+	if err != nil {
+		return nil, nil, fmt.Errorf("sf: failed to generate upload ID: %w", err)
+	}
+	object := fmt.Sprintf(constants.VideoUploadObjectFormat, uploadID)
+	presigned, err := svc.store.PresignUpload(ctx, constants.UploadBucket, object, constants.VideoType, fileSize,
+		time.Now().Add(constants.VideoDirectUploadTTL))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	session := &model.UploadSession{
+		UploadID: uploadID,
+		UserID:   uid,
+		Object:   object,
+		FileSize: fileSize,
+		Direct:   true,
+		Video:    video,
+	}
+	if err = svc.saveUploadSession(ctx, session); err != nil {
+		return nil, nil, err
+	}
+	return session, presigned, nil
+}
+
+// GetUploadSession 获取上传会话，只有发起上传的用户可以访问
+func (svc *VideoService) GetUploadSession(ctx context.Context, uploadID, uid int64) (*model.UploadSession, error) {
+	session, err := svc.redis.GetUploadSession(ctx, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	if session == nil {
+		return nil, errno.Errorf(errno.DBNotFound, "upload %d not found or expired", uploadID)
+	}
+	if session.UserID != uid {
+		return nil, errno.Errorf(errno.AuthNoOperatePermissionCode, "upload %d does not belong to user %d", uploadID, uid)
+	}
+	return session, nil
+}
+
+// GetUploadProgress 返回上传会话和已经上传的分片，客户端断线后据此续传
+func (svc *VideoService) GetUploadProgress(ctx context.Context, session *model.UploadSession) (*model.UploadProgress, error) {
+	chunks, err := svc.uploadedChunks(ctx, session.UploadID)
+	if err != nil {
+		return nil, err
+	}
+	return &model.UploadProgress{Session: session, Chunks: chunks}, nil
+}
+
+// UploadChunk 校验分片的大小和 SHA-256 后写入 MinIO，同一个分片可以重复上传，以最后一次为准
+// 除最后一片外每片都必须是 ChunkSize 大小
+func (svc *VideoService) UploadChunk(ctx context.Context, session *model.UploadSession, partNumber int64, checksum string, data []byte) error {
+	if session.Direct {
+		return errno.ParamVerifyError.WithMessage(fmt.Sprintf("upload %d is a direct upload", session.UploadID))
+	}
+	if partNumber < 1 || partNumber > session.TotalChunks {
+		return errno.ParamVerifyError.WithMessage(fmt.Sprintf("part number should be between 1 and %d", session.TotalChunks))
+	}
+	expected := session.ChunkSize
+	if partNumber == session.TotalChunks {
+		expected = session.FileSize - (session.TotalChunks-1)*session.ChunkSize
+	}
+	if int64(len(data)) != expected {
+		return errno.ParamVerifyError.WithMessage(fmt.Sprintf("chunk %d should be %d bytes, got %d", partNumber, expected, len(data)))
+	}
+	sum := sha256.Sum256(data)
+	actual := hex.EncodeToString(sum[:])
+	if !strings.EqualFold(checksum, actual) {
+		return errno.ParamVerifyError.WithMessage(fmt.Sprintf("checksum of chunk %d does not match", partNumber))
+	}
+	locked, err := svc.redis.IsUploadLocked(ctx, session.UploadID)
+	if err != nil {
+		return err
+	}
+	if locked || session.Assembled {
+		return errno.ParamVerifyError.WithMessage(fmt.Sprintf("upload %d is being completed", session.UploadID))
+	}
+
+	etag, err := svc.store.UploadPart(ctx, constants.UploadBucket, session.Object, session.MultipartID, partNumber, data, actual)
+	if err != nil {
+		return err
+	}
+	chunk := &model.UploadedChunk{
+		PartNumber: partNumber,
+		ETag:       etag,
+		Checksum:   actual,
+		Size:       int64(len(data)),
+	}
+	if err = svc.redis.AddUploadedChunk(ctx, session.UploadID, chunk, constants.VideoUploadSessionTTL); err != nil {
+		return err
+	}
+	// 每上传一个分片都延长会话的有效期
+	return svc.saveUploadSession(ctx, session)
+}
+
+// CompleteUpload 合并分片或确认直传的文件已经上传，校验文件后把 moov 移到文件开头放进 video 桶
+// 返回待创建的视频，对象名为 <视频ID>.mp4；文件本身有问题时丢弃这次上传，不能再重试
+// 成功时上传仍然处于加锁状态，调用方创建视频后需要调用 FinishUpload 或 RollbackUpload
+func (svc *VideoService) CompleteUpload(ctx context.Context, session *model.UploadSession) (video *model.Video, objectKey string, err error) {
+	locked, err := svc.redis.LockUpload(ctx, session.UploadID, constants.VideoUploadCompleteTTL)
+	if err != nil {
+		return nil, "", err
+	}
+	if !locked {
+		return nil, "", errno.ParamVerifyError.WithMessage(fmt.Sprintf("upload %d is being completed", session.UploadID))
+	}
+	defer func() {
+		if err == nil {
+			return
+		}
+		if errno.ConvertErr(err).ErrorCode == errno.ParamVerifyErrorCode {
+			svc.discardUpload(ctx, session)
+		} else if unlockErr := svc.redis.UnlockUpload(ctx, session.UploadID); unlockErr != nil {
+			logger.Errorf("unlock upload %d failed: %v", session.UploadID, unlockErr)
+		}
+	}()
+
+	if !session.Direct && !session.Assembled {
+		chunks, err := svc.uploadedChunks(ctx, session.UploadID)
+		if err != nil {
+			return nil, "", err
+		}
+		// 分片编号在上传时已经校验过范围，数量够了就说明没有缺少的分片
+		// 缺少分片时客户端还可以补传，所以不用 ParamVerifyError，以免丢弃这次上传
+		if int64(len(chunks)) != session.TotalChunks {
+			return nil, "", errno.Errorf(errno.ParamMissingErrorCode, "%d of %d chunks uploaded", len(chunks), session.TotalChunks)
+		}
+		if err = svc.store.CompleteMultipartUpload(ctx, constants.UploadBucket, session.Object, session.MultipartID, chunks); err != nil {
+			return nil, "", err
+		}
+		session.Assembled = true
+		if err = svc.saveUploadSession(ctx, session); err != nil {
+			return nil, "", err
+		}
+	}
+
+	object, err := svc.store.Open(ctx, constants.UploadBucket, session.Object)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) && session.Direct {
+			// 直传的文件还没有上传完，客户端可以上传后重试
+			return nil, "", errno.Errorf(errno.ParamMissingErrorCode, "file of upload %d has not been uploaded", session.UploadID)
+		}
+		return nil, "", err
+	}
+	defer object.Close()
+	if object.Size() != session.FileSize {
+		return nil, "", errno.ParamVerifyError.WithMessage(fmt.Sprintf("uploaded %d bytes, expected %d", object.Size(), session.FileSize))
+	}
+	if object.ContentType() != constants.VideoType {
+		return nil, "", errno.ParamVerifyError.WithMessage(fmt.Sprintf("uploaded file is %q, expected %q", object.ContentType(), constants.VideoType))
+	}
+	video = session.Video
+	if err = probeVideo(video, object, object.Size()); err != nil {
+		return nil, "", err
+	}
+
+	if video.VideoID, err = svc.GenerateVideoId(); err != nil {
+		return nil, "", err
+	}
+	objectKey = fmt.Sprintf("%d.mp4", video.VideoID)
+	if video.Metadata.FastStart {
+		err = svc.store.Copy(ctx, constants.UploadBucket, session.Object, constants.VideoBucket, objectKey)
+	} else {
+		err = svc.putFastStart(ctx, object, objectKey)
+		video.Metadata.FastStart = true
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	return video, objectKey, nil
+}
+
+// FinishUpload 视频创建成功后删除暂存对象和上传会话
+func (svc *VideoService) FinishUpload(ctx context.Context, session *model.UploadSession) {
+	svc.discardUpload(ctx, session)
+}
+
+// RollbackUpload 创建视频失败时删除已经放进 video 桶的文件并解锁，保留暂存对象，客户端可以重试
+func (svc *VideoService) RollbackUpload(ctx context.Context, session *model.UploadSession, objectKey string) {
+	if err := svc.store.Remove(ctx, constants.VideoBucket, objectKey); err != nil {
+		logger.Errorf("remove video object %s failed: %v", objectKey, err)
+	}
+	if err := svc.redis.UnlockUpload(ctx, session.UploadID); err != nil {
+		logger.Errorf("unlock upload %d failed: %v", session.UploadID, err)
+	}
+}
+
+// putFastStart 把 moov 移到文件开头后上传到 video 桶，只有 moov 会读入内存
+func (svc *VideoService) putFastStart(ctx context.Context, object repository.VideoObject, objectKey string) error {
+	reader, err := mp4.FastStartReader(object, object.Size())
+	if err != nil {
+		if errors.Is(err, mp4.ErrMalformed) || errors.Is(err, mp4.ErrUnsupported) {
+			return errno.ParamVerifyError.WithMessage(fmt.Sprintf("invalid video file: %v", err))
+		}
+		return err
+	}
+	return svc.store.Put(ctx, constants.VideoBucket, objectKey, reader, object.Size(), constants.VideoType)
+}
+
+// AbortUpload 取消上传，删除已经上传的分片或文件和上传会话
+func (svc *VideoService) AbortUpload(ctx context.Context, session *model.UploadSession) error {
+	locked, err := svc.redis.IsUploadLocked(ctx, session.UploadID)
+	if err != nil {
+		return err
+	}
+	if locked {
+		return errno.ParamVerifyError.WithMessage(fmt.Sprintf("upload %d is being completed", session.UploadID))
+	}
+	if session.Direct || session.Assembled {
+		err = svc.store.Remove(ctx, constants.UploadBucket, session.Object)
+	} else {
+		err = svc.store.AbortMultipartUpload(ctx, constants.UploadBucket, session.Object, session.MultipartID)
+	}
+	if err != nil {
+		return err
+	}
+	return svc.redis.DeleteUploadSession(ctx, session.UploadID)
+}
+
+func (svc *VideoService) saveUploadSession(ctx context.Context, session *model.UploadSession) error {
+	session.ExpiresAt = time.Now().Add(constants.VideoUploadSessionTTL).Unix()
+	return svc.redis.SetUploadSession(ctx, session, constants.VideoUploadSessionTTL)
+}
+
+// uploadedChunks 返回按分片编号排序的已上传分片
+func (svc *VideoService) uploadedChunks(ctx context.Context, uploadID int64) ([]*model.UploadedChunk, error) {
+	chunks, err := svc.redis.GetUploadedChunks(ctx, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(chunks, func(i, j int) bool {
+		return chunks[i].PartNumber < chunks[j].PartNumber
+	})
+	return chunks, nil
+}
+
+// discardUpload 删除暂存对象和上传会话，失败只记录日志
+func (svc *VideoService) discardUpload(ctx context.Context, session *model.UploadSession) {
+	if session.Direct || session.Assembled {
+		if err := svc.store.Remove(ctx, constants.UploadBucket, session.Object); err != nil {
+			logger.Errorf("remove upload object %s failed: %v", session.Object, err)
+		}
+	} else if err := svc.store.AbortMultipartUpload(ctx, constants.UploadBucket, session.Object, session.MultipartID); err != nil {
+		logger.Errorf("abort multipart upload of %s failed: %v", session.Object, err)
+	}
+	if err := svc.redis.DeleteUploadSession(ctx, session.UploadID); err != nil {
+		logger.Errorf("delete upload session %d failed: %v", session.UploadID, err)
+	}
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/app/video/domain/repository"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+	"github.com/LingeringAutumn/Yijie/pkg/utils"
+)
+
+// directObject 是暂存桶中直传的文件
+type directObject struct {
+	*strings.Reader
+	contentType string
+}
+
+func (o *directObject) Close() error        { return nil }
+func (o *directObject) ContentType() string { return o.contentType }
+
+// directStore 在内存中模拟直传用到的对象存储操作
+type directStore struct {
+	repository.VideoObjectStore
+	objects   map[string]*directObject // bucket/object
+	presigned []string
+}
+
+func (s *directStore) PresignUpload(ctx context.Context, bucket, object, contentType string, size int64, expiresAt time.Time) (*model.PresignedUpload, error) {
+	s.presigned = append(s.presigned, bucket+"/"+object)
+	return &model.PresignedUpload{
+		URL:       "http://minio/" + bucket,
+		FormData:  map[string]string{"key": object, "Content-Type": contentType},
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (s *directStore) Open(ctx context.Context, bucket, object string) (repository.VideoObject, error) {
+	if o, ok := s.objects[bucket+"/"+object]; ok {
+		return o, nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (s *directStore) Remove(ctx context.Context, bucket, object string) error {
+	delete(s.objects, bucket+"/"+object)
+	return nil
+}
+
+// uploadRedis 在内存中保存上传会话和锁
+type uploadRedis struct {
+	repository.VideoRedis
+	sessions map[int64]*model.UploadSession
+	locked   map[int64]bool
+}
+
+func (r *uploadRedis) SetUploadSession(ctx context.Context, session *model.UploadSession, ttl time.Duration) error {
+	r.sessions[session.UploadID] = session
+	return nil
+}
+
+func (r *uploadRedis) GetUploadSession(ctx context.Context, uploadID int64) (*model.UploadSession, error) {
+	return r.sessions[uploadID], nil
+}
+
+func (r *uploadRedis) LockUpload(ctx context.Context, uploadID int64, ttl time.Duration) (bool, error) {
+	if r.locked[uploadID] {
+		return false, nil
+	}
+	r.locked[uploadID] = true
+	return true, nil
+}
+
+func (r *uploadRedis) UnlockUpload(ctx context.Context, uploadID int64) error {
+	delete(r.locked, uploadID)
+	return nil
+}
+
+func (r *uploadRedis) DeleteUploadSession(ctx context.Context, uploadID int64) error {
+	delete(r.sessions, uploadID)
+	delete(r.locked, uploadID)
+	return nil
+}
+
+func TestVideoService_DirectUpload(t *testing.T) {
+	convey.Convey("direct upload", t, func() {
+		ctx := context.Background()
+		sf, err := utils.NewSnowflake(0, 0)
+		convey.So(err, convey.ShouldBeNil)
+		store := &directStore{objects: map[string]*directObject{}}
+		redis := &uploadRedis{sessions: map[int64]*model.UploadSession{}, locked: map[int64]bool{}}
+		svc := &VideoService{redis: redis, store: store, sf: sf}
+
+		convey.Convey("rejects invalid file sizes before presigning", func() {
+			for _, size := range []int64{0, -1, constants.VideoUploadMaxFileSize + 1} {
+				_, _, err := svc.InitDirectUpload(ctx, 1, &model.Video{}, size)
+				convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.ParamVerifyErrorCode)
+			}
+			convey.So(store.presigned, convey.ShouldBeEmpty)
+			convey.So(redis.sessions, convey.ShouldBeEmpty)
+		})
+
+		const fileSize = 16
+		session, presigned, err := svc.InitDirectUpload(ctx, 1, &model.Video{Title: "t"}, fileSize)
+		convey.So(err, convey.ShouldBeNil)
+		staged := constants.UploadBucket + "/" + session.Object
+
+		convey.Convey("presigns a form for the staging object and saves a direct session", func() {
+			convey.So(store.presigned, convey.ShouldResemble, []string{staged})
+			convey.So(presigned.FormData["Content-Type"], convey.ShouldEqual, constants.VideoType)
+			convey.So(presigned.ExpiresAt, convey.ShouldHappenAfter, time.Now())
+			saved, err := svc.GetUploadSession(ctx, session.UploadID, 1)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(saved.Direct, convey.ShouldBeTrue)
+			convey.So(saved.FileSize, convey.ShouldEqual, fileSize)
+			convey.So(saved.MultipartID, convey.ShouldBeEmpty)
+		})
+
+		convey.Convey("other users cannot access the session", func() {
+			_, err := svc.GetUploadSession(ctx, session.UploadID, 2)
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.AuthNoOperatePermissionCode)
+		})
+
+		convey.Convey("completing before the file arrives keeps the session for a retry", func() {
+			_, _, err := svc.CompleteUpload(ctx, session)
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.ParamMissingErrorCode)
+			convey.So(redis.sessions, convey.ShouldContainKey, session.UploadID)
+			convey.So(redis.locked, convey.ShouldBeEmpty)
+		})
+
+		convey.Convey("a file of the wrong size is discarded", func() {
+			store.objects[staged] = &directObject{Reader: strings.NewReader("short"), contentType: constants.VideoType}
+			_, _, err := svc.CompleteUpload(ctx, session)
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.ParamVerifyErrorCode)
+			convey.So(store.objects, convey.ShouldBeEmpty)
+			convey.So(redis.sessions, convey.ShouldBeEmpty)
+		})
+
+		convey.Convey("a file of the wrong type is discarded", func() {
+			store.objects[staged] = &directObject{Reader: strings.NewReader(strings.Repeat("x", fileSize)), contentType: "image/png"}
+			_, _, err := svc.CompleteUpload(ctx, session)
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.ParamVerifyErrorCode)
+			convey.So(store.objects, convey.ShouldBeEmpty)
+			convey.So(redis.sessions, convey.ShouldBeEmpty)
+		})
+
+		convey.Convey("a second completion is rejected while the first holds the lock", func() {
+			redis.locked[session.UploadID] = true
+			_, _, err := svc.CompleteUpload(ctx, session)
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.ParamVerifyErrorCode)
+			convey.So(redis.sessions, convey.ShouldContainKey, session.UploadID)
+		})
+	})
+}
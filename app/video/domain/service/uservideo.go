@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+)
+
+// ListUserVideos 按游标列出作者的视频，返回一页视频和下一页的游标，没有更多视频时游标为空
+// 作者本人能看到全部视频；其他人只能看到已发布、处理完成的公开视频，关注了作者的还能看到仅粉丝可见的视频
+func (svc *VideoService) ListUserVideos(ctx context.Context, viewer, userID int64, cursor string, limit int64, sort string) ([]*model.VideoProfile, string, error) {
+	sort, err := normalizeUserVideoSort(sort)
+	if err != nil {
+		return nil, "", err
+	}
+	if limit <= 0 {
+		limit = constants.UserVideoDefaultLimit
+	}
+	if limit > constants.UserVideoMaxLimit {
+		limit = constants.UserVideoMaxLimit
+	}
+	// 多取一个用来判断是否还有下一页
+	query := &model.UserVideoQuery{UserID: userID, Sort: sort, Limit: int(limit) + 1}
+	if cursor != "" {
+		if query.After, err = svc.decodeUserVideoCursor(sort, cursor); err != nil {
+			return nil, "", err
+		}
+	}
+	if viewer != 0 && viewer == userID {
+		query.IncludeAll = true
+	} else {
+		query.Visibilities = []string{constants.VideoVisibilityPublic}
+		if viewer != 0 {
+			// 查询关注关系失败时只展示公开视频
+			followed, err := svc.rpc.CheckPrivacy(ctx, viewer, userID, constants.PrivacyActionViewFollowed)
+			if err != nil {
+				logger.Errorf("check whether user %d follows user %d failed: %v", viewer, userID, err)
+			}
+			if followed {
+				query.Visibilities = append(query.Visibilities, constants.VideoVisibilityFollowers)
+			}
+		}
+	}
+
+	videos, err := svc.db.ListUserVideos(ctx, query)
+	if err != nil {
+		return nil, "", err
+	}
+	var next string
+	if len(videos) > int(limit) {
+		videos = videos[:limit]
+		if next, err = svc.encodeUserVideoCursor(sort, videos[len(videos)-1]); err != nil {
+			return nil, "", err
+		}
+	}
+	// 游标使用数据库中的值，之后再合并 Redis 中的播放量和点赞数
+	for _, v := range videos {
+		v.Views, _ = svc.GetViews(ctx, v.VideoID)
+		v.Likes, _ = svc.GetLikes(ctx, v.VideoID)
+	}
+	return videos, next, nil
+}
+
+func normalizeUserVideoSort(sort string) (string, error) {
+	switch sort {
+	case "":
+		return constants.UserVideoSortNewest, nil
+	case constants.UserVideoSortNewest, constants.UserVideoSortMostViewed, constants.UserVideoSortMostLiked:
+		return sort, nil
+	default:
+		return "", errno.ParamVerifyError.WithMessage("sort should be newest, most_viewed or most_liked")
+	}
+}
+
+// userVideoCursor 是作者视频列表的游标，换了排序方式的游标不能继续使用
+type userVideoCursor struct {
+	Sort    string `json:"s"`
+	Value   int64  `json:"v"` // 最后一个视频排序字段的值
+	VideoID int64  `json:"id"`
+}
+
+// encodeUserVideoCursor 把最后一个视频的位置签名成游标
+func (svc *VideoService) encodeUserVideoCursor(sort string, last *model.VideoProfile) (string, error) {
+	value := last.CreatedAt
+	switch sort {
+	case constants.UserVideoSortMostViewed:
+		value = last.Views
+	case constants.UserVideoSortMostLiked:
+		value = last.Likes
+	}
+	return svc.cursor.Sign(&userVideoCursor{Sort: sort, Value: value, VideoID: last.VideoID})
+}
+
+func (svc *VideoService) decodeUserVideoCursor(sort, cursor string) (*model.UserVideoCursor, error) {
+	var c userVideoCursor
+	if err := svc.cursor.Parse(cursor, &c); err != nil {
+		return nil, err
+	}
+	if c.Sort != sort {
+		return nil, errno.ParamVerifyError.WithMessage("cursor does not match the sort")
+	}
+	return &model.UserVideoCursor{Value: c.Value, VideoID: c.VideoID}, nil
+}
@@ -0,0 +1,173 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/app/video/domain/repository"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+func TestNormalizeUserVideoSort(t *testing.T) {
+	convey.Convey("normalizeUserVideoSort", t, func() {
+		tests := []struct {
+			sort     string
+			expected string
+			wantErr  bool
+		}{
+			{"", constants.UserVideoSortNewest, false},
+			{constants.UserVideoSortNewest, constants.UserVideoSortNewest, false},
+			{constants.UserVideoSortMostViewed, constants.UserVideoSortMostViewed, false},
+			{constants.UserVideoSortMostLiked, constants.UserVideoSortMostLiked, false},
+			{"oldest", "", true},
+			{"Newest", "", true},
+		}
+		for _, tt := range tests {
+			convey.Convey("sort "+tt.sort, func() {
+				sort, err := normalizeUserVideoSort(tt.sort)
+				convey.So(err != nil, convey.ShouldEqual, tt.wantErr)
+				convey.So(sort, convey.ShouldEqual, tt.expected)
+			})
+		}
+	})
+}
+
+// userVideoDB 记录查询条件，返回固定的视频
+type userVideoDB struct {
+	repository.VideoDB
+	videos []*model.VideoProfile
+	query  *model.UserVideoQuery
+}
+
+func (db *userVideoDB) ListUserVideos(ctx context.Context, query *model.UserVideoQuery) ([]*model.VideoProfile, error) {
+	db.query = query
+	result := make([]*model.VideoProfile, 0, query.Limit)
+	for _, v := range db.videos[:min(query.Limit, len(db.videos))] {
+		copied := *v
+		result = append(result, &copied)
+	}
+	return result, nil
+}
+
+// counterRedis 返回 Redis 中比数据库更新的播放量和点赞数
+type counterRedis struct {
+	repository.VideoRedis
+	views, likes int64
+}
+
+func (r *counterRedis) GetViews(ctx context.Context, videoId int64) (int64, error) {
+	return r.views, nil
+}
+
+func (r *counterRedis) GetLikes(ctx context.Context, videoId int64) (int64, error) {
+	return r.likes, nil
+}
+
+// privacyRPC 按关注关系回答 CheckPrivacy
+type privacyRPC struct {
+	repository.VideoRPC
+	follows map[[2]int64]bool // {viewer, owner}
+}
+
+func (r *privacyRPC) CheckPrivacy(ctx context.Context, viewerID, ownerID int64, action string) (bool, error) {
+	return r.follows[[2]int64{viewerID, ownerID}], nil
+}
+
+func TestVideoService_ListUserVideos(t *testing.T) {
+	convey.Convey("ListUserVideos", t, func() {
+		ctx := context.Background()
+		const author, follower, stranger = 1, 2, 3
+		db := &userVideoDB{videos: []*model.VideoProfile{
+			{VideoID: 30, UserID: author, CreatedAt: 300, Views: 10, Likes: 1},
+			{VideoID: 20, UserID: author, CreatedAt: 200, Views: 30, Likes: 3},
+			{VideoID: 10, UserID: author, CreatedAt: 100, Views: 20, Likes: 2},
+		}}
+		svc := &VideoService{
+			db:     db,
+			redis:  &counterRedis{views: 999, likes: 99},
+			rpc:    &privacyRPC{follows: map[[2]int64]bool{{follower, author}: true}},
+			cursor: newCursorSigner(),
+		}
+
+		convey.Convey("visibility depends on the viewer", func() {
+			tests := []struct {
+				name         string
+				viewer       int64
+				includeAll   bool
+				visibilities []string
+			}{
+				{"author sees everything", author, true, nil},
+				{"follower sees followers-only videos", follower, false, []string{constants.VideoVisibilityPublic, constants.VideoVisibilityFollowers}},
+				{"stranger sees public videos", stranger, false, []string{constants.VideoVisibilityPublic}},
+				{"guest sees public videos", 0, false, []string{constants.VideoVisibilityPublic}},
+			}
+			for _, tt := range tests {
+				convey.Convey(tt.name, func() {
+					_, _, err := svc.ListUserVideos(ctx, tt.viewer, author, "", 10, "")
+					convey.So(err, convey.ShouldBeNil)
+					convey.So(db.query.IncludeAll, convey.ShouldEqual, tt.includeAll)
+					convey.So(db.query.Visibilities, convey.ShouldResemble, tt.visibilities)
+					convey.So(db.query.Sort, convey.ShouldEqual, constants.UserVideoSortNewest)
+				})
+			}
+		})
+
+		convey.Convey("limit is defaulted and capped, one extra row detects the next page", func() {
+			_, _, err := svc.ListUserVideos(ctx, 0, author, "", 0, "")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(db.query.Limit, convey.ShouldEqual, constants.UserVideoDefaultLimit+1)
+			_, _, err = svc.ListUserVideos(ctx, 0, author, "", 1000, "")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(db.query.Limit, convey.ShouldEqual, constants.UserVideoMaxLimit+1)
+		})
+
+		convey.Convey("the last page has no cursor", func() {
+			videos, next, err := svc.ListUserVideos(ctx, 0, author, "", 3, "")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(videos, convey.ShouldHaveLength, 3)
+			convey.So(next, convey.ShouldBeEmpty)
+		})
+
+		convey.Convey("cursor uses the database counters of the last video", func() {
+			tests := []struct {
+				sort  string
+				value int64
+			}{
+				{constants.UserVideoSortNewest, 200},
+				{constants.UserVideoSortMostViewed, 30},
+				{constants.UserVideoSortMostLiked, 3},
+			}
+			for _, tt := range tests {
+				convey.Convey(tt.sort, func() {
+					videos, next, err := svc.ListUserVideos(ctx, 0, author, "", 2, tt.sort)
+					convey.So(err, convey.ShouldBeNil)
+					convey.So(videos, convey.ShouldHaveLength, 2)
+					convey.So(videos[1].Views, convey.ShouldEqual, 999)
+					convey.So(next, convey.ShouldNotBeEmpty)
+
+					_, _, err = svc.ListUserVideos(ctx, 0, author, next, 2, tt.sort)
+					convey.So(err, convey.ShouldBeNil)
+					convey.So(db.query.After, convey.ShouldResemble, &model.UserVideoCursor{Value: tt.value, VideoID: 20})
+				})
+			}
+		})
+
+		convey.Convey("a cursor cannot be reused with another sort", func() {
+			_, next, err := svc.ListUserVideos(ctx, 0, author, "", 2, constants.UserVideoSortNewest)
+			convey.So(err, convey.ShouldBeNil)
+			_, _, err = svc.ListUserVideos(ctx, 0, author, next, 2, constants.UserVideoSortMostViewed)
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.ParamVerifyErrorCode)
+		})
+
+		convey.Convey("invalid sort and cursor are rejected", func() {
+			_, _, err := svc.ListUserVideos(ctx, 0, author, "", 2, "oldest")
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.ParamVerifyErrorCode)
+			_, _, err = svc.ListUserVideos(ctx, 0, author, "garbage", 2, "")
+			convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.ParamVerifyErrorCode)
+		})
+	})
+}
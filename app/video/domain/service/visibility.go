@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+func validateVisibility(visibility string) error {
+	switch visibility {
+	case constants.VideoVisibilityPublic, constants.VideoVisibilityUnlisted,
+		constants.VideoVisibilityPrivate, constants.VideoVisibilityFollowers:
+		return nil
+	}
+	return errno.ParamVerifyError.WithMessage("visibility should be one of public, unlisted, private and followers")
+}
+
+// IsPublic 判断视频是否公开，可见范围上线之前写入的缓存没有这个字段，视为公开
+func (svc *VideoService) IsPublic(profile *model.VideoProfile) bool {
+	return profile.Visibility == "" || profile.Visibility == constants.VideoVisibilityPublic
+}
+
+// IsListed 判断视频是否出现在热榜、搜索和搜索联想中：已发布且公开
+func (svc *VideoService) IsListed(profile *model.VideoProfile) bool {
+	return profile.Status == constants.VideoStatusPublished && svc.IsPublic(profile)
+}
+
+// CheckVisibility 按可见范围判断 viewer 能否观看视频，viewer 为 0 表示未登录用户
+// 作者本人总能观看；仅粉丝可见的视频需要 viewer 关注了作者
+func (svc *VideoService) CheckVisibility(ctx context.Context, viewer int64, profile *model.VideoProfile) (bool, error) {
+	if viewer != 0 && viewer == profile.UserID {
+		return true, nil
+	}
+	switch profile.Visibility {
+	case constants.VideoVisibilityPrivate:
+		return false, nil
+	case constants.VideoVisibilityFollowers:
+		if viewer == 0 {
+			return false, nil
+		}
+		return svc.rpc.CheckPrivacy(ctx, viewer, profile.UserID, constants.PrivacyActionViewFollowed)
+	}
+	return true, nil
+}
@@ -23,3 +23,25 @@ func NewProducerConfig() (*sarama.Config, error) {
 
 	return cfg, nil
 }
+
+// NewConsumerConfig 构造带 SASL 的 Kafka 消费者组配置
+// 偏移量手动标记，只有处理完成或确认无法处理的消息才会提交
+func NewConsumerConfig() (*sarama.Config, error) {
+	cfg := sarama.NewConfig()
+	cfg.Consumer.Return.Errors = true
+	cfg.Consumer.Offsets.Initial = sarama.OffsetNewest
+	if config.Kafka.AutoOffsetReset == "earliest" {
+		cfg.Consumer.Offsets.Initial = sarama.OffsetOldest
+	}
+	cfg.Consumer.Group.Rebalance.GroupStrategies = []sarama.BalanceStrategy{sarama.NewBalanceStrategyRoundRobin()}
+
+	cfg.Net.SASL.Enable = true
+	cfg.Net.SASL.User = config.Kafka.SASLUser
+	cfg.Net.SASL.Password = config.Kafka.SASLPassword
+	cfg.Net.SASL.Mechanism = sarama.SASLTypePlaintext
+
+	cfg.Net.TLS.Enable = false
+	cfg.Version = sarama.V2_8_0_0
+
+	return cfg, nil
+}
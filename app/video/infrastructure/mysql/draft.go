@@ -0,0 +1,100 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	dmodel "github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// ListDrafts 查询用户的草稿，最近修改的在前
+func (db *videoDB) ListDrafts(ctx context.Context, uid int64, offset, limit int64) ([]*dmodel.VideoProfile, error) {
+	var results []*dmodel.VideoProfile
+
+	err := db.client.WithContext(ctx).
+		Table(fmt.Sprintf("%s AS v", constants.VideoTableName)).
+		Select(`
+			v.video_id, v.user_id, v.title, v.description, v.cover_url, v.video_url,
+			v.duration_seconds, UNIX_TIMESTAMP(v.created_at) AS created_at,
+			v.status, v.visibility, IFNULL(UNIX_TIMESTAMP(v.publish_at), 0) AS publish_at,
+			IFNULL(vp.status, ?) AS processing_status
+		`, constants.VideoProcessingStatusReady).
+		Joins(fmt.Sprintf("LEFT JOIN %s AS vp ON v.video_id = vp.video_id", constants.VideoProcessingTableName)).
+		Where("v.user_id = ? AND v.status = ? AND v.deleted_at IS NULL", uid, constants.VideoStatusDraft).
+		Order("v.updated_at DESC").
+		Offset(int(offset)).
+		Limit(int(limit)).
+		Scan(&results).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "list drafts failed: %v", err)
+	}
+	if err = db.attachTags(ctx, results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// ScheduleDraft 设置草稿的定时发布时间
+func (db *videoDB) ScheduleDraft(ctx context.Context, videoID int64, publishAt time.Time) error {
+	result := db.client.WithContext(ctx).
+		Table(constants.VideoTableName).
+		Where("video_id = ? AND status = ? AND deleted_at IS NULL", videoID, constants.VideoStatusDraft).
+		Update("publish_at", publishAt)
+	if result.Error != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "schedule draft failed: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errno.Errorf(errno.DBNotFound, "draft %d not found", videoID)
+	}
+	return nil
+}
+
+// ListDueDrafts 查询发布时间已到的草稿 ID
+func (db *videoDB) ListDueDrafts(ctx context.Context, now time.Time, limit int) ([]int64, error) {
+	var ids []int64
+	err := db.client.WithContext(ctx).
+		Table(constants.VideoTableName).
+		Where("status = ? AND publish_at <= ? AND deleted_at IS NULL", constants.VideoStatusDraft, now).
+		Order("publish_at ASC").
+		Limit(limit).
+		Pluck("video_id", &ids).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "list due drafts failed: %v", err)
+	}
+	return ids, nil
+}
+
+// PublishDraft 在一个事务中把草稿改为已发布并初始化统计数据
+// 状态更新带 status = 'draft' 条件，多个实例同时发布同一个草稿时只有一个会成功，返回 false 表示已被其他实例发布或草稿已不存在
+func (db *videoDB) PublishDraft(ctx context.Context, videoID int64, publishedAt time.Time, stat *dmodel.VideoStat) (bool, error) {
+	published := false
+	err := db.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Table(constants.VideoTableName).
+			Where("video_id = ? AND status = ? AND deleted_at IS NULL", videoID, constants.VideoStatusDraft).
+			Updates(map[string]interface{}{
+				"status":     constants.VideoStatusPublished,
+				"publish_at": nil,
+				"created_at": publishedAt, // created_at 即发布时间，热度从这一刻开始计算
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		if err := tx.Table(constants.VideoStatsTableName).Create(stat).Error; err != nil {
+			return err
+		}
+		published = true
+		return nil
+	})
+	if err != nil {
+		return false, errno.Errorf(errno.InternalDatabaseErrorCode, "publish draft %d failed: %v", videoID, err)
+	}
+	return published, nil
+}
@@ -3,8 +3,11 @@ package mysql
 import (
 	"context"
 	"fmt"
+	"time"
+	"unicode/utf8"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	dmodel "github.com/LingeringAutumn/Yijie/app/video/domain/model"
 	"github.com/LingeringAutumn/Yijie/app/video/domain/repository"
@@ -21,7 +24,26 @@ func NewVideoDB(client *gorm.DB) repository.VideoDB {
 }
 
 func (db *videoDB) StoreVideo(ctx context.Context, video *dmodel.Video) error {
-	if err := db.client.WithContext(ctx).Table(constants.VideoTableName).Create(&video).Error; err != nil {
+	err := db.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(constants.VideoTableName).Create(&video).Error; err != nil {
+			return err
+		}
+		if err := replaceTags(tx, video.VideoID, video.Tags); err != nil {
+			return err
+		}
+		if video.Metadata != nil {
+			video.Metadata.VideoID = video.VideoID
+			if err := tx.Table(constants.VideoMetadataTableName).Create(video.Metadata).Error; err != nil {
+				return err
+			}
+		}
+		// worker 已经插入的处理记录比 uploaded 更新，不能覆盖
+		processing := &dmodel.VideoProcessing{VideoID: video.VideoID, Status: constants.VideoProcessingStatusUploaded}
+		return tx.Table(constants.VideoProcessingTableName).
+			Clauses(clause.OnConflict{DoNothing: true}).
+			Create(processing).Error
+	})
+	if err != nil {
 		return errno.Errorf(errno.InternalDatabaseErrorCode, "mysql: failed to store video: %v", err)
 	}
 	return nil
@@ -43,12 +65,15 @@ func (db *videoDB) GetVideoDB(ctx context.Context, videoId int64) (*dmodel.Video
 		Select(`
 			v.video_id, v.user_id, v.title, v.description, v.cover_url, v.video_url,
 			v.duration_seconds, UNIX_TIMESTAMP(v.created_at) AS created_at,
+			v.status, v.visibility, IFNULL(UNIX_TIMESTAMP(v.publish_at), 0) AS publish_at,
 			IFNULL(vs.views, 0) AS views,
 			IFNULL(vs.likes, 0) AS likes,
 			IFNULL(vs.comments, 0) AS comments,
-			IFNULL(vs.hot_score, 0) AS hot_score
-		`).
+			IFNULL(vs.hot_score, 0) AS hot_score,
+			IFNULL(vp.status, ?) AS processing_status
+		`, constants.VideoProcessingStatusReady).
 		Joins(fmt.Sprintf("LEFT JOIN %s AS vs ON v.video_id = vs.video_id", constants.VideoStatsTableName)).
+		Joins(fmt.Sprintf("LEFT JOIN %s AS vp ON v.video_id = vp.video_id", constants.VideoProcessingTableName)).
 		Where("v.video_id = ? AND v.deleted_at IS NULL", videoId).
 		Scan(&result).Error
 	if err != nil {
@@ -60,6 +85,9 @@ func (db *videoDB) GetVideoDB(ctx context.Context, videoId int64) (*dmodel.Video
 		return nil, errno.Errorf(errno.DBNotFound, "video %d not found", videoId)
 	}
 
+	if err = db.attachTags(ctx, []*dmodel.VideoProfile{&result}); err != nil {
+		return nil, err
+	}
 	return &result, nil
 }
 
@@ -75,30 +103,73 @@ func (db *videoDB) UpdateViews(ctx context.Context, videoID int64, views int64)
 	return nil
 }
 
-func (db *videoDB) SearchVideo(ctx context.Context, keyword string, tags []string, pageNum, pageSize int64) ([]*dmodel.VideoProfile, error) {
-	var results []*dmodel.VideoProfile
-	offset := int((pageNum - 1) * pageSize) // 分页偏移计算
+// SearchVideoIDs 基于全文索引搜索已发布的公开视频，按排序返回前 limit 个视频 ID 和命中总数
+// 关键词为空时只按标签过滤，关键词短于 ngram 分词长度时无法走全文索引，退化为 LIKE 匹配
+func (db *videoDB) SearchVideoIDs(ctx context.Context, query *dmodel.VideoSearchQuery, limit int) ([]int64, int64, error) {
+	var ids []int64
 
-	// 执行多表联查（videos + video_stats），查询符合关键词的视频
-	err := db.client.WithContext(ctx).
-		Table(fmt.Sprintf("%s AS v", constants.VideoTableName)). // 主表别名 v
-		Select(`
-			v.video_id, v.user_id, v.title, v.description, v.cover_url, v.video_url,
-			v.duration_seconds, UNIX_TIMESTAMP(v.created_at) as created_at,
-			vs.views, vs.likes, vs.comments, vs.hot_score
-		`). // SELECT 字段来自主表和统计表
-		Joins(fmt.Sprintf("LEFT JOIN %s AS vs ON v.video_id = vs.video_id", constants.VideoStatsTableName)). // 统计信息表联接
-		Where("v.status = ?", "published"). // 只查询已发布视频
-		Where("v.title LIKE ? OR v.description LIKE ?", "%"+keyword+"%", "%"+keyword+"%"). // 模糊搜索标题或描述
-		Order("v.created_at DESC"). // 新发布的在前
-		Offset(offset). // 分页偏移
-		Limit(int(pageSize)). // 限制结果数量
-		Scan(&results).Error // 结果扫描进结构体切片
+	// 过滤条件，查询结果和统计总数共用
+	filter := func() *gorm.DB {
+		tx := db.client.WithContext(ctx).
+			Table(fmt.Sprintf("%s AS v", constants.VideoTableName)).                       // 主表别名 v
+			Where("v.status = ? AND v.deleted_at IS NULL", constants.VideoStatusPublished). // 只查询已发布视频
+			Where("v.visibility = ?", constants.VideoVisibilityPublic).                     // 只查询公开视频
+			Where(readyCondition())                                                        // 只查询处理完成的视频
+		switch {
+		case query.Keyword == "":
+		case utf8.RuneCountInString(query.Keyword) < constants.VideoSearchNgramTokenSize:
+			tx = tx.Where("v.title LIKE ? OR v.description LIKE ?", "%"+query.Keyword+"%", "%"+query.Keyword+"%")
+		default:
+			tx = tx.Where("MATCH(v.title, v.description) AGAINST (? IN NATURAL LANGUAGE MODE)", query.Keyword)
+		}
+		if len(query.Tags) > 0 {
+			tx = tx.Where("v.video_id IN (?)", tagFilter(db.client.WithContext(ctx), query.Tags, query.TagMode))
+		}
+		return tx
+	}
+
+	var total int64
+	if err := filter().Count(&total).Error; err != nil {
+		return nil, 0, errno.Errorf(errno.InternalDatabaseErrorCode, "count search videos failed: %v", err)
+	}
+	if total == 0 {
+		return ids, 0, nil
+	}
+
+	tx := filter().
+		Joins(fmt.Sprintf("LEFT JOIN %s AS vs ON v.video_id = vs.video_id", constants.VideoStatsTableName)) // 统计信息表联接，排序使用
+	switch query.Sort {
+	case constants.VideoSearchSortNewest:
+		tx = tx.Order("v.created_at DESC")
+	case constants.VideoSearchSortMostViewed:
+		tx = tx.Order("IFNULL(vs.views, 0) DESC").Order("v.created_at DESC")
+	default:
+		tx = tx.Order(relevanceOrder(query.Keyword))
+	}
+	err := tx.
+		Limit(limit).                   // 限制结果数量
+		Pluck("v.video_id", &ids).Error // 只取视频 ID
 	if err != nil {
-		return nil, fmt.Errorf("search videos failed: %w", err)
+		return nil, 0, errno.Errorf(errno.InternalDatabaseErrorCode, "search videos failed: %v", err)
 	}
+	return ids, total, nil
+}
 
-	return results, nil
+// relevanceOrder 综合排序：文本相关度 + 热度 + 新鲜度，分数相同时新发布的在前
+// 新鲜度按发布时长衰减，发布 VideoSearchFreshnessHours 小时后减半
+func relevanceOrder(keyword string) clause.OrderBy {
+	freshness := fmt.Sprintf("%v / (1 + TIMESTAMPDIFF(HOUR, v.created_at, NOW()) / %v)",
+		constants.VideoSearchFreshWeight, constants.VideoSearchFreshnessHours)
+	hot := fmt.Sprintf("%v * IFNULL(vs.hot_score, 0)", constants.VideoSearchHotWeight)
+	expr := clause.Expr{SQL: fmt.Sprintf("(%s + %s) DESC, v.created_at DESC", hot, freshness)}
+	if utf8.RuneCountInString(keyword) >= constants.VideoSearchNgramTokenSize {
+		expr = clause.Expr{
+			SQL: fmt.Sprintf("(%v * MATCH(v.title, v.description) AGAINST (? IN NATURAL LANGUAGE MODE) + %s + %s) DESC, v.created_at DESC",
+				constants.VideoSearchTextWeight, hot, freshness),
+			Vars: []interface{}{keyword},
+		}
+	}
+	return clause.OrderBy{Expression: expr}
 }
 
 func (db *videoDB) TrendVideo(ctx context.Context, pageNum, pageSize int64) ([]*dmodel.VideoProfile, error) {
@@ -115,6 +186,8 @@ func (db *videoDB) TrendVideo(ctx context.Context, pageNum, pageSize int64) ([]*
 		`). // SELECT 字段来自主表和统计表
 		Joins(fmt.Sprintf("LEFT JOIN %s AS vs ON v.video_id = vs.video_id", constants.VideoStatsTableName)). // 联接统计表
 		Where("v.status = ?", "published"). // 只显示已发布视频
+		Where("v.visibility = ?", constants.VideoVisibilityPublic). // 只显示公开视频
+		Where(readyCondition()).            // 只显示处理完成的视频
 		Order("vs.hot_score DESC, v.created_at DESC"). // 按热度排序，发布时间为次要排序
 		Offset(offset).
 		Limit(int(pageSize)).
@@ -123,6 +196,9 @@ func (db *videoDB) TrendVideo(ctx context.Context, pageNum, pageSize int64) ([]*
 		return nil, fmt.Errorf("fetch trending videos failed: %w", err)
 	}
 
+	if err = db.attachTags(ctx, results); err != nil {
+		return nil, err
+	}
 	return results, nil
 }
 
@@ -136,3 +212,60 @@ func (db *videoDB) UpdateHotScore(ctx context.Context, videoID int64, score floa
 	}
 	return nil
 }
+
+// UpdateVideo 更新作者可编辑的字段，只更新非 nil 的字段，标签整体替换
+func (db *videoDB) UpdateVideo(ctx context.Context, update *dmodel.VideoUpdate) error {
+	fields := make(map[string]interface{})
+	if update.Title != nil {
+		fields["title"] = *update.Title
+	}
+	if update.Description != nil {
+		fields["description"] = *update.Description
+	}
+	if update.CoverURL != nil {
+		fields["cover_url"] = *update.CoverURL
+	}
+	if update.Visibility != nil {
+		fields["visibility"] = *update.Visibility
+	}
+	if update.Tags != nil {
+		// 标签在单独的表里，需要显式刷新更新时间，搜索索引按更新时间追补变化
+		fields["updated_at"] = time.Now()
+	}
+	err := db.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if len(fields) > 0 {
+			err := tx.Table(constants.VideoTableName).
+				Where("video_id = ? AND deleted_at IS NULL", update.VideoID).
+				Updates(fields).Error
+			if err != nil {
+				return err
+			}
+		}
+		if update.Tags != nil {
+			return replaceTags(tx, update.VideoID, *update.Tags)
+		}
+		return nil
+	})
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "update video failed: %v", err)
+	}
+	return nil
+}
+
+// DeleteVideo 逻辑删除视频：状态置为 deleted 并记录删除时间，统计数据保留
+func (db *videoDB) DeleteVideo(ctx context.Context, videoID int64) error {
+	result := db.client.WithContext(ctx).
+		Table(constants.VideoTableName).
+		Where("video_id = ? AND deleted_at IS NULL", videoID).
+		Updates(map[string]interface{}{
+			"status":     constants.VideoStatusDeleted,
+			"deleted_at": time.Now(),
+		})
+	if result.Error != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "delete video failed: %v", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return errno.Errorf(errno.DBNotFound, "video %d not found", videoID)
+	}
+	return nil
+}
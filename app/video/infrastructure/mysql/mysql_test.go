@@ -0,0 +1,34 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/smartystreets/goconvey/convey"
+
+	dmodel "github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+)
+
+func TestVideoDB_StoreVideo(t *testing.T) {
+	convey.Convey("StoreVideo", t, func() {
+		db, mock, queries, closeDB := newRecordingDB()
+		defer closeDB()
+
+		mock.ExpectBegin()
+		mock.ExpectExec("insert video").WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec("delete tags").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec("insert processing").WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+		err := db.StoreVideo(context.Background(), &dmodel.Video{VideoID: 1, UserID: 2, Title: "t", Status: constants.VideoStatusPublished})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(mock.ExpectationsWereMet(), convey.ShouldBeNil)
+
+		convey.Convey("the processing row never overwrites one the worker already created", func() {
+			insert := (*queries)[len(*queries)-1]
+			convey.So(insert, convey.ShouldStartWith, "INSERT INTO `"+constants.VideoProcessingTableName+"`")
+			convey.So(insert, convey.ShouldContainSubstring, "ON DUPLICATE KEY UPDATE `video_id`=`video_id`")
+		})
+	})
+}
@@ -0,0 +1,151 @@
+package mysql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	dmodel "github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// readyCondition 只保留处理完成的视频，主表别名需要是 v
+// 没有处理记录的视频是处理流程上线之前上传的，视为已就绪
+func readyCondition() string {
+	return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s AS vpr WHERE vpr.video_id = v.video_id AND vpr.status <> '%s')",
+		constants.VideoProcessingTableName, constants.VideoProcessingStatusReady)
+}
+
+func (db *videoDB) GetProcessing(ctx context.Context, videoID int64) (*dmodel.VideoProcessing, error) {
+	var processing dmodel.VideoProcessing
+	err := db.client.WithContext(ctx).
+		Table(constants.VideoProcessingTableName).
+		Where("video_id = ?", videoID).
+		First(&processing).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errno.Errorf(errno.DBNotFound, "processing status of video %d not found", videoID)
+		}
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "query processing status failed: %v", err)
+	}
+	return &processing, nil
+}
+
+// StartProcessing 把视频标记为处理中，进度清零并增加尝试次数
+// 投稿时已经插入了 uploaded 状态的记录，没有记录时（处理流程上线前的视频）插入一条
+func (db *videoDB) StartProcessing(ctx context.Context, videoID int64) error {
+	processing := &dmodel.VideoProcessing{
+		VideoID:  videoID,
+		Status:   constants.VideoProcessingStatusProcessing,
+		Attempts: 1,
+	}
+	err := db.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Table(constants.VideoProcessingTableName).
+			Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "video_id"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"status":      constants.VideoProcessingStatusProcessing,
+					"progress":    0,
+					"fail_reason": "",
+					"attempts":    gorm.Expr("attempts + 1"),
+				}),
+			}).
+			Create(processing).Error
+		if err != nil {
+			return err
+		}
+		// 重新处理已就绪的视频时它会暂时搜不到
+		return touchVideo(tx, videoID)
+	})
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "start processing video %d failed: %v", videoID, err)
+	}
+	return nil
+}
+
+// UpdateProcessingProgress 更新处理进度，只在处理中时生效，进度不会倒退
+func (db *videoDB) UpdateProcessingProgress(ctx context.Context, videoID int64, progress int64) error {
+	err := db.client.WithContext(ctx).
+		Table(constants.VideoProcessingTableName).
+		Where("video_id = ? AND status = ? AND progress < ?", videoID, constants.VideoProcessingStatusProcessing, progress).
+		Update("progress", progress).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "update processing progress of video %d failed: %v", videoID, err)
+	}
+	return nil
+}
+
+// FailProcessing 把视频标记为处理失败，已经就绪的视频不受影响
+func (db *videoDB) FailProcessing(ctx context.Context, videoID int64, reason string) error {
+	err := db.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Table(constants.VideoProcessingTableName).
+			Where("video_id = ? AND status <> ?", videoID, constants.VideoProcessingStatusReady).
+			Updates(map[string]interface{}{
+				"status":      constants.VideoProcessingStatusFailed,
+				"fail_reason": reason,
+			})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return touchVideo(tx, videoID)
+	})
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "mark video %d processing failed: %v", videoID, err)
+	}
+	return nil
+}
+
+// FinishProcessing 在一个事务中保存转码产物并把视频从处理中改为就绪，重复处理时替换之前的产物记录
+func (db *videoDB) FinishProcessing(ctx context.Context, videoID int64, renditions []*dmodel.VideoRendition) error {
+	err := db.client.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Table(constants.VideoRenditionTableName).Where("video_id = ?", videoID).Delete(&dmodel.VideoRendition{}).Error; err != nil {
+			return err
+		}
+		if len(renditions) > 0 {
+			if err := tx.Table(constants.VideoRenditionTableName).Create(&renditions).Error; err != nil {
+				return err
+			}
+		}
+		result := tx.Table(constants.VideoProcessingTableName).
+			Where("video_id = ? AND status = ?", videoID, constants.VideoProcessingStatusProcessing).
+			Updates(map[string]interface{}{
+				"status":      constants.VideoProcessingStatusReady,
+				"progress":    constants.VideoProgressReady,
+				"fail_reason": "",
+			})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+		return touchVideo(tx, videoID)
+	})
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "finish processing video %d failed: %v", videoID, err)
+	}
+	return nil
+}
+
+// touchVideo 更新 videos.updated_at，处理状态决定视频能否被搜到，
+// 从快照恢复索引时按 updated_at 追补，不更新的话会漏掉这段时间处理完成的视频
+func touchVideo(tx *gorm.DB, videoID int64) error {
+	return tx.Table(constants.VideoTableName).
+		Where("video_id = ?", videoID).
+		Update("updated_at", time.Now()).Error
+}
+
+// ListRenditionObjectKeys 查询视频所有转码产物在 MinIO 中的对象名
+func (db *videoDB) ListRenditionObjectKeys(ctx context.Context, videoID int64) ([]string, error) {
+	var keys []string
+	err := db.client.WithContext(ctx).
+		Table(constants.VideoRenditionTableName).
+		Where("video_id = ?", videoID).
+		Pluck("object_key", &keys).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "list renditions of video %d failed: %v", videoID, err)
+	}
+	return keys, nil
+}
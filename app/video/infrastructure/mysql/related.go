@@ -0,0 +1,100 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	dmodel "github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// listedVideos 只保留已发布、公开且处理完成的视频，v 是 videos 表的别名
+func listedVideos(tx *gorm.DB) *gorm.DB {
+	return tx.Where("v.status = ? AND v.visibility = ? AND v.deleted_at IS NULL",
+		constants.VideoStatusPublished, constants.VideoVisibilityPublic).
+		Where(readyCondition())
+}
+
+func (db *videoDB) ListRelatedCandidates(ctx context.Context, source *dmodel.VideoProfile, limit int) ([]*dmodel.RelatedCandidate, error) {
+	candidates := make(map[int64]*dmodel.RelatedCandidate)
+	candidate := func(videoID int64) *dmodel.RelatedCandidate {
+		c, ok := candidates[videoID]
+		if !ok {
+			c = &dmodel.RelatedCandidate{VideoID: videoID}
+			candidates[videoID] = c
+		}
+		return c
+	}
+
+	// 共同标签
+	if len(source.Tags) > 0 {
+		var rows []struct {
+			VideoID int64
+			Shared  int64
+		}
+		tx := db.client.WithContext(ctx).
+			Table(fmt.Sprintf("%s AS t", constants.VideoTagTableName)).
+			Select("t.video_id, COUNT(*) AS shared").
+			Joins(fmt.Sprintf("JOIN %s AS v ON v.video_id = t.video_id", constants.VideoTableName)).
+			Where("t.tag IN ? AND t.video_id <> ?", source.Tags, source.VideoID)
+		err := listedVideos(tx).
+			Group("t.video_id").
+			Order("shared DESC").
+			Limit(limit).
+			Scan(&rows).Error
+		if err != nil {
+			return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "query videos sharing tags failed: %v", err)
+		}
+		for _, row := range rows {
+			candidate(row.VideoID).SharedTags = row.Shared
+		}
+	}
+
+	// 同一作者最近发布的视频
+	var sameAuthor []int64
+	tx := db.client.WithContext(ctx).
+		Table(fmt.Sprintf("%s AS v", constants.VideoTableName)).
+		Where("v.user_id = ? AND v.video_id <> ?", source.UserID, source.VideoID)
+	err := listedVideos(tx).
+		Order("v.created_at DESC").
+		Limit(limit).
+		Pluck("v.video_id", &sameAuthor).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "query videos of the same author failed: %v", err)
+	}
+	for _, id := range sameAuthor {
+		candidate(id).SameAuthor = true
+	}
+
+	// 点赞了这个视频的用户还点赞了哪些视频，外键在 video_likes.video_id 上建有索引
+	var coLikes []struct {
+		VideoID int64
+		CoLikes int64
+	}
+	tx = db.client.WithContext(ctx).
+		Table(fmt.Sprintf("%s AS l1", constants.VideoLikeTableName)).
+		Select("l2.video_id, COUNT(*) AS co_likes").
+		Joins(fmt.Sprintf("JOIN %s AS l2 ON l2.user_id = l1.user_id AND l2.video_id <> l1.video_id AND l2.is_liked", constants.VideoLikeTableName)).
+		Joins(fmt.Sprintf("JOIN %s AS v ON v.video_id = l2.video_id", constants.VideoTableName)).
+		Where("l1.video_id = ? AND l1.is_liked", source.VideoID)
+	err = listedVideos(tx).
+		Group("l2.video_id").
+		Order("co_likes DESC").
+		Limit(limit).
+		Scan(&coLikes).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "query co-liked videos failed: %v", err)
+	}
+	for _, row := range coLikes {
+		candidate(row.VideoID).CoLikes = row.CoLikes
+	}
+
+	results := make([]*dmodel.RelatedCandidate, 0, len(candidates))
+	for _, c := range candidates {
+		results = append(results, c)
+	}
+	return results, nil
+}
@@ -0,0 +1,50 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dmodel "github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// ListIndexableVideos 按视频 ID 顺序分批读取已发布、公开且处理完成的视频，用于重建搜索索引
+func (db *videoDB) ListIndexableVideos(ctx context.Context, afterID int64, limit int) ([]*dmodel.VideoProfile, error) {
+	var results []*dmodel.VideoProfile
+	err := db.client.WithContext(ctx).
+		Table(fmt.Sprintf("%s AS v", constants.VideoTableName)).
+		Select(`
+			v.video_id, v.user_id, v.title, v.description, v.cover_url, v.video_url,
+			v.duration_seconds, UNIX_TIMESTAMP(v.created_at) AS created_at,
+			IFNULL(vs.views, 0) AS views
+		`).
+		Joins(fmt.Sprintf("LEFT JOIN %s AS vs ON v.video_id = vs.video_id", constants.VideoStatsTableName)).
+		Where("v.video_id > ? AND v.status = ? AND v.deleted_at IS NULL", afterID, constants.VideoStatusPublished).
+		Where("v.visibility = ?", constants.VideoVisibilityPublic).
+		Where(readyCondition()).
+		Order("v.video_id ASC").
+		Limit(limit).
+		Scan(&results).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "list indexable videos failed: %v", err)
+	}
+	if err = db.attachTags(ctx, results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// ListUpdatedVideoIDs 查询 since 之后有变化的视频 ID，包括已删除的视频，用于从快照恢复后追补索引
+func (db *videoDB) ListUpdatedVideoIDs(ctx context.Context, since time.Time) ([]int64, error) {
+	var ids []int64
+	err := db.client.WithContext(ctx).
+		Table(constants.VideoTableName).
+		Where("updated_at >= ?", since).
+		Pluck("video_id", &ids).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "list updated videos failed: %v", err)
+	}
+	return ids, nil
+}
@@ -0,0 +1,88 @@
+package mysql
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/smartystreets/goconvey/convey"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	dmodel "github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+)
+
+// newRecordingDB 返回一个记录实际执行的 SQL 的 videoDB，期望的语句只按顺序匹配，不校验内容
+func newRecordingDB() (*videoDB, sqlmock.Sqlmock, *[]string, func()) {
+	var queries []string
+	matcher := sqlmock.QueryMatcherFunc(func(expected, actual string) error {
+		queries = append(queries, actual)
+		return nil
+	})
+	sqlDB, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(matcher))
+	convey.So(err, convey.ShouldBeNil)
+	client, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}),
+		&gorm.Config{SkipDefaultTransaction: true})
+	convey.So(err, convey.ShouldBeNil)
+	return &videoDB{client: client}, mock, &queries, func() { _ = sqlDB.Close() }
+}
+
+func TestVideoDB_SearchVideoIDs(t *testing.T) {
+	convey.Convey("SearchVideoIDs", t, func() {
+		ctx := context.Background()
+		db, mock, queries, closeDB := newRecordingDB()
+		defer closeDB()
+		search := func(query *dmodel.VideoSearchQuery) ([]int64, int64) {
+			mock.ExpectQuery("count").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+			mock.ExpectQuery("search").WillReturnRows(sqlmock.NewRows([]string{"video_id"}).AddRow(2).AddRow(1))
+			ids, total, err := db.SearchVideoIDs(ctx, query, 10)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(mock.ExpectationsWereMet(), convey.ShouldBeNil)
+			convey.So(*queries, convey.ShouldHaveLength, 2)
+			return ids, total
+		}
+
+		convey.Convey("long keyword uses the full-text index and blended relevance", func() {
+			ids, total := search(&dmodel.VideoSearchQuery{Keyword: "golang", Sort: constants.VideoSearchSortRelevance})
+			convey.So(ids, convey.ShouldResemble, []int64{2, 1})
+			convey.So(total, convey.ShouldEqual, 2)
+			count, list := (*queries)[0], (*queries)[1]
+			convey.So(count, convey.ShouldContainSubstring, "MATCH(v.title, v.description) AGAINST (? IN NATURAL LANGUAGE MODE)")
+			convey.So(count, convey.ShouldContainSubstring, "v.visibility = ?")
+			order := list[strings.Index(list, "ORDER BY"):]
+			convey.So(order, convey.ShouldContainSubstring, "MATCH(v.title, v.description)")
+			convey.So(order, convey.ShouldContainSubstring, "vs.hot_score")
+			convey.So(order, convey.ShouldContainSubstring, "TIMESTAMPDIFF(HOUR, v.created_at, NOW())")
+			convey.So(order, convey.ShouldEndWith, "v.created_at DESC LIMIT ?")
+		})
+
+		convey.Convey("short keyword falls back to LIKE and ranks by hotness and freshness", func() {
+			search(&dmodel.VideoSearchQuery{Keyword: "g", Sort: constants.VideoSearchSortRelevance})
+			count, list := (*queries)[0], (*queries)[1]
+			convey.So(count, convey.ShouldContainSubstring, "v.title LIKE ? OR v.description LIKE ?")
+			convey.So(count, convey.ShouldNotContainSubstring, "MATCH")
+			convey.So(list, convey.ShouldNotContainSubstring, "MATCH")
+			convey.So(list, convey.ShouldContainSubstring, "vs.hot_score")
+		})
+
+		convey.Convey("newest and most viewed ignore relevance", func() {
+			search(&dmodel.VideoSearchQuery{Keyword: "golang", Sort: constants.VideoSearchSortNewest})
+			convey.So((*queries)[1], convey.ShouldEndWith, "ORDER BY v.created_at DESC LIMIT ?")
+
+			*queries = nil
+			search(&dmodel.VideoSearchQuery{Keyword: "golang", Sort: constants.VideoSearchSortMostViewed})
+			convey.So((*queries)[1], convey.ShouldEndWith, "ORDER BY IFNULL(vs.views, 0) DESC,v.created_at DESC LIMIT ?")
+		})
+
+		convey.Convey("no hits skips the ranked query", func() {
+			mock.ExpectQuery("count").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+			ids, total, err := db.SearchVideoIDs(ctx, &dmodel.VideoSearchQuery{Keyword: "golang"}, 10)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(ids, convey.ShouldBeEmpty)
+			convey.So(total, convey.ShouldEqual, 0)
+			convey.So(*queries, convey.ShouldHaveLength, 1)
+		})
+	})
+}
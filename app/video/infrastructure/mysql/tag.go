@@ -0,0 +1,117 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	dmodel "github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// replaceTags 用 tags 整体替换视频的标签，需要在事务中调用
+func replaceTags(tx *gorm.DB, videoID int64, tags []string) error {
+	if err := tx.Table(constants.VideoTagTableName).Where("video_id = ?", videoID).Delete(&dmodel.VideoTag{}).Error; err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	rows := make([]*dmodel.VideoTag, 0, len(tags))
+	for _, tag := range tags {
+		rows = append(rows, &dmodel.VideoTag{VideoID: videoID, Tag: tag})
+	}
+	return tx.Table(constants.VideoTagTableName).Create(&rows).Error
+}
+
+// tagFilter 构造命中标签的视频 ID 子查询，all 模式要求命中全部标签
+func tagFilter(client *gorm.DB, tags []string, tagMode string) *gorm.DB {
+	sub := client.Table(constants.VideoTagTableName).Select("video_id").Where("tag IN ?", tags)
+	if tagMode == constants.VideoTagModeAll {
+		sub = sub.Group("video_id").Having("COUNT(DISTINCT tag) = ?", len(tags))
+	}
+	return sub
+}
+
+// attachTags 批量查询视频的标签并填充到 profiles 中
+func (db *videoDB) attachTags(ctx context.Context, profiles []*dmodel.VideoProfile) error {
+	if len(profiles) == 0 {
+		return nil
+	}
+	ids := make([]int64, 0, len(profiles))
+	for _, p := range profiles {
+		ids = append(ids, p.VideoID)
+	}
+	var rows []*dmodel.VideoTag
+	err := db.client.WithContext(ctx).
+		Table(constants.VideoTagTableName).
+		Where("video_id IN ?", ids).
+		Order("tag_id ASC").
+		Find(&rows).Error
+	if err != nil {
+		return errno.Errorf(errno.InternalDatabaseErrorCode, "query video tags failed: %v", err)
+	}
+	tags := make(map[int64][]string, len(profiles))
+	for _, row := range rows {
+		tags[row.VideoID] = append(tags[row.VideoID], row.Tag)
+	}
+	for _, p := range profiles {
+		p.Tags = tags[p.VideoID]
+	}
+	return nil
+}
+
+// ListVideosByTag 查询带有某个标签的已发布、公开且处理完成的视频，新发布的在前
+func (db *videoDB) ListVideosByTag(ctx context.Context, tag string, pageNum, pageSize int64) ([]*dmodel.VideoProfile, error) {
+	var results []*dmodel.VideoProfile
+	offset := int((pageNum - 1) * pageSize)
+
+	err := db.client.WithContext(ctx).
+		Table(fmt.Sprintf("%s AS v", constants.VideoTableName)).
+		Select(`
+			v.video_id, v.user_id, v.title, v.description, v.cover_url, v.video_url,
+			v.duration_seconds, UNIX_TIMESTAMP(v.created_at) AS created_at,
+			IFNULL(vs.views, 0) AS views,
+			IFNULL(vs.likes, 0) AS likes,
+			IFNULL(vs.comments, 0) AS comments,
+			IFNULL(vs.hot_score, 0) AS hot_score
+		`).
+		Joins(fmt.Sprintf("JOIN %s AS vt ON v.video_id = vt.video_id", constants.VideoTagTableName)).
+		Joins(fmt.Sprintf("LEFT JOIN %s AS vs ON v.video_id = vs.video_id", constants.VideoStatsTableName)).
+		Where("vt.tag = ? AND v.status = ? AND v.deleted_at IS NULL", tag, constants.VideoStatusPublished).
+		Where("v.visibility = ?", constants.VideoVisibilityPublic).
+		Where(readyCondition()).
+		Order("v.created_at DESC").
+		Offset(offset).
+		Limit(int(pageSize)).
+		Scan(&results).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "list videos by tag failed: %v", err)
+	}
+
+	if err = db.attachTags(ctx, results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// PopularTags 统计已发布的公开视频中使用最多的标签
+func (db *videoDB) PopularTags(ctx context.Context, limit int) ([]*dmodel.TagCount, error) {
+	var results []*dmodel.TagCount
+	err := db.client.WithContext(ctx).
+		Table(fmt.Sprintf("%s AS vt", constants.VideoTagTableName)).
+		Select("vt.tag, COUNT(*) AS count").
+		Joins(fmt.Sprintf("JOIN %s AS v ON v.video_id = vt.video_id", constants.VideoTableName)).
+		Where("v.status = ? AND v.deleted_at IS NULL", constants.VideoStatusPublished).
+		Where("v.visibility = ?", constants.VideoVisibilityPublic).
+		Group("vt.tag").
+		Order("count DESC, vt.tag ASC").
+		Limit(limit).
+		Scan(&results).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "query popular tags failed: %v", err)
+	}
+	return results, nil
+}
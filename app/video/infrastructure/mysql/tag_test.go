@@ -0,0 +1,43 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/smartystreets/goconvey/convey"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+)
+
+func TestTagFilter(t *testing.T) {
+	convey.Convey("tagFilter", t, func() {
+		sqlDB, _, err := sqlmock.New()
+		convey.So(err, convey.ShouldBeNil)
+		defer sqlDB.Close()
+		client, err := gorm.Open(mysql.New(mysql.Config{Conn: sqlDB, SkipInitializeWithVersion: true}),
+			&gorm.Config{SkipDefaultTransaction: true})
+		convey.So(err, convey.ShouldBeNil)
+
+		toSQL := func(tagMode string) string {
+			return client.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				var ids []int64
+				return tx.Table(constants.VideoTableName).Select("video_id").
+					Where("video_id IN (?)", tagFilter(tx, []string{"go", "kitex"}, tagMode)).Find(&ids)
+			})
+		}
+
+		convey.Convey("any mode matches videos with one of the tags", func() {
+			sql := toSQL(constants.VideoTagModeAny)
+			convey.So(sql, convey.ShouldContainSubstring, "tag IN ('go','kitex')")
+			convey.So(sql, convey.ShouldNotContainSubstring, "HAVING")
+		})
+
+		convey.Convey("all mode requires every tag", func() {
+			sql := toSQL(constants.VideoTagModeAll)
+			convey.So(sql, convey.ShouldContainSubstring, "tag IN ('go','kitex')")
+			convey.So(sql, convey.ShouldContainSubstring, "GROUP BY `video_id` HAVING COUNT(DISTINCT tag) = 2")
+		})
+	})
+}
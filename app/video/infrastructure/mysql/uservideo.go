@@ -0,0 +1,64 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	dmodel "github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// userVideoSortExpr 返回作者视频列表的排序字段，与游标中保存的值一一对应
+func userVideoSortExpr(sort string) string {
+	switch sort {
+	case constants.UserVideoSortMostViewed:
+		return "IFNULL(vs.views, 0)"
+	case constants.UserVideoSortMostLiked:
+		return "IFNULL(vs.likes, 0)"
+	default:
+		return "UNIX_TIMESTAMP(v.created_at)"
+	}
+}
+
+// ListUserVideos 查询作者的视频，通过 idx_user_id 定位作者，按排序字段和视频 ID 降序取游标之后的一页
+func (db *videoDB) ListUserVideos(ctx context.Context, query *dmodel.UserVideoQuery) ([]*dmodel.VideoProfile, error) {
+	var results []*dmodel.VideoProfile
+	sortExpr := userVideoSortExpr(query.Sort)
+
+	tx := db.client.WithContext(ctx).
+		Table(fmt.Sprintf("%s AS v", constants.VideoTableName)).
+		Select(`
+			v.video_id, v.user_id, v.title, v.description, v.cover_url, v.video_url,
+			v.duration_seconds, UNIX_TIMESTAMP(v.created_at) AS created_at,
+			v.status, v.visibility, IFNULL(UNIX_TIMESTAMP(v.publish_at), 0) AS publish_at,
+			IFNULL(vs.views, 0) AS views,
+			IFNULL(vs.likes, 0) AS likes,
+			IFNULL(vs.comments, 0) AS comments,
+			IFNULL(vs.hot_score, 0) AS hot_score,
+			IFNULL(vp.status, ?) AS processing_status
+		`, constants.VideoProcessingStatusReady).
+		Joins(fmt.Sprintf("LEFT JOIN %s AS vs ON v.video_id = vs.video_id", constants.VideoStatsTableName)).
+		Joins(fmt.Sprintf("LEFT JOIN %s AS vp ON v.video_id = vp.video_id", constants.VideoProcessingTableName)).
+		Where("v.user_id = ? AND v.deleted_at IS NULL", query.UserID)
+	if !query.IncludeAll {
+		tx = tx.Where("v.status = ? AND v.visibility IN ?", constants.VideoStatusPublished, query.Visibilities).
+			Where(readyCondition())
+	}
+	if query.After != nil {
+		tx = tx.Where(fmt.Sprintf("(%s < ? OR (%s = ? AND v.video_id < ?))", sortExpr, sortExpr),
+			query.After.Value, query.After.Value, query.After.VideoID)
+	}
+	err := tx.
+		Order(fmt.Sprintf("%s DESC, v.video_id DESC", sortExpr)).
+		Limit(query.Limit).
+		Scan(&results).Error
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalDatabaseErrorCode, "list user videos failed: %v", err)
+	}
+
+	if err = db.attachTags(ctx, results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,59 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/smartystreets/goconvey/convey"
+
+	dmodel "github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+)
+
+func TestVideoDB_ListUserVideos(t *testing.T) {
+	convey.Convey("ListUserVideos", t, func() {
+		ctx := context.Background()
+		db, mock, queries, closeDB := newRecordingDB()
+		defer closeDB()
+
+		tests := []struct {
+			sort string
+			expr string
+		}{
+			{constants.UserVideoSortNewest, "UNIX_TIMESTAMP(v.created_at)"},
+			{constants.UserVideoSortMostViewed, "IFNULL(vs.views, 0)"},
+			{constants.UserVideoSortMostLiked, "IFNULL(vs.likes, 0)"},
+		}
+		for _, tt := range tests {
+			convey.Convey("keyset on "+tt.sort+" breaks ties by video id", func() {
+				mock.ExpectQuery("list").
+					WithArgs(constants.VideoProcessingStatusReady, int64(1), int64(500), int64(500), int64(42), 21).
+					WillReturnRows(sqlmock.NewRows([]string{"video_id"}))
+				_, err := db.ListUserVideos(ctx, &dmodel.UserVideoQuery{
+					UserID: 1, Sort: tt.sort, Limit: 21, IncludeAll: true,
+					After: &dmodel.UserVideoCursor{Value: 500, VideoID: 42},
+				})
+				convey.So(err, convey.ShouldBeNil)
+				convey.So(mock.ExpectationsWereMet(), convey.ShouldBeNil)
+				sql := (*queries)[0]
+				convey.So(sql, convey.ShouldContainSubstring, "("+tt.expr+" < ? OR ("+tt.expr+" = ? AND v.video_id < ?))")
+				convey.So(sql, convey.ShouldEndWith, "ORDER BY "+tt.expr+" DESC, v.video_id DESC LIMIT ?")
+				convey.So(sql, convey.ShouldNotContainSubstring, "v.visibility IN")
+			})
+		}
+
+		convey.Convey("other viewers only see published, ready videos they are allowed to", func() {
+			mock.ExpectQuery("list").WillReturnRows(sqlmock.NewRows([]string{"video_id"}))
+			_, err := db.ListUserVideos(ctx, &dmodel.UserVideoQuery{
+				UserID: 1, Sort: constants.UserVideoSortNewest, Limit: 21,
+				Visibilities: []string{constants.VideoVisibilityPublic},
+			})
+			convey.So(err, convey.ShouldBeNil)
+			sql := (*queries)[0]
+			convey.So(sql, convey.ShouldContainSubstring, "v.status = ? AND v.visibility IN (?)")
+			convey.So(sql, convey.ShouldContainSubstring, readyCondition())
+			convey.So(sql, convey.ShouldNotContainSubstring, "v.video_id < ?")
+		})
+	})
+}
@@ -0,0 +1,99 @@
+package objectstore
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/minio/minio-go"
+
+	dmodel "github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/app/video/domain/repository"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/utils"
+)
+
+type minioStore struct {
+	client *utils.MinioClient
+}
+
+func NewMinioStore(client *utils.MinioClient) repository.VideoObjectStore {
+	return &minioStore{client: client}
+}
+
+// Download path 所在的目录需要已经存在，且 path 本身不存在
+func (s *minioStore) Download(ctx context.Context, bucket, object, path string) error {
+	return s.client.DownloadFile(bucket, object, path)
+}
+
+func (s *minioStore) Upload(ctx context.Context, bucket, object, path, contentType string) (int64, error) {
+	return s.client.FUploadFile(bucket, object, constants.Location, contentType, path)
+}
+
+func (s *minioStore) NewMultipartUpload(ctx context.Context, bucket, object, contentType string) (string, error) {
+	return s.client.NewMultipartUpload(bucket, object, constants.Location, contentType)
+}
+
+func (s *minioStore) UploadPart(ctx context.Context, bucket, object, multipartID string, partNumber int64, data []byte, sha256Hex string) (string, error) {
+	return s.client.PutObjectPart(bucket, object, multipartID, int(partNumber), data, sha256Hex)
+}
+
+// CompleteMultipartUpload chunks 需要按分片编号升序排列
+func (s *minioStore) CompleteMultipartUpload(ctx context.Context, bucket, object, multipartID string, chunks []*dmodel.UploadedChunk) error {
+	parts := make([]minio.CompletePart, 0, len(chunks))
+	for _, chunk := range chunks {
+		parts = append(parts, minio.CompletePart{PartNumber: int(chunk.PartNumber), ETag: chunk.ETag})
+	}
+	return s.client.CompleteMultipartUpload(bucket, object, multipartID, parts)
+}
+
+func (s *minioStore) AbortMultipartUpload(ctx context.Context, bucket, object, multipartID string) error {
+	return s.client.AbortMultipartUpload(bucket, object, multipartID)
+}
+
+func (s *minioStore) Open(ctx context.Context, bucket, object string) (repository.VideoObject, error) {
+	obj, info, err := s.client.OpenFile(bucket, object)
+	if err != nil {
+		return nil, err
+	}
+	return &minioObject{Object: obj, info: info}, nil
+}
+
+func (s *minioStore) Put(ctx context.Context, bucket, object string, reader io.Reader, size int64, contentType string) error {
+	return s.client.PutFile(bucket, object, constants.Location, contentType, reader, size)
+}
+
+func (s *minioStore) Copy(ctx context.Context, srcBucket, srcObject, dstBucket, dstObject string) error {
+	return s.client.CopyFile(srcBucket, srcObject, dstBucket, dstObject, constants.Location)
+}
+
+func (s *minioStore) Remove(ctx context.Context, bucket, object string) error {
+	_, err := s.client.DeleteFile(bucket, object)
+	return err
+}
+
+func (s *minioStore) PresignUpload(ctx context.Context, bucket, object, contentType string, size int64, expiresAt time.Time) (*dmodel.PresignedUpload, error) {
+	url, formData, err := s.client.PresignedPostPolicy(bucket, object, constants.Location, contentType, size, size, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return &dmodel.PresignedUpload{URL: url, FormData: formData, ExpiresAt: expiresAt}, nil
+}
+
+func (s *minioStore) PresignGet(ctx context.Context, bucket, object string, ttl time.Duration) (string, error) {
+	return s.client.GetPresignedGetObject(bucket, object, ttl)
+}
+
+// minioObject 在 minio.Object 的随机读取之外记录对象信息
+type minioObject struct {
+	*minio.Object
+	info minio.ObjectInfo
+}
+
+func (o *minioObject) Size() int64 {
+	return o.info.Size
+}
+
+func (o *minioObject) ContentType() string {
+	return o.info.ContentType
+}
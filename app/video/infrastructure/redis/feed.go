@@ -0,0 +1,127 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+)
+
+func feedInboxKey(uid int64) string {
+	return constants.FeedInboxKeyPrefix + strconv.FormatInt(uid, 10)
+}
+
+func feedOutboxKey(authorID int64) string {
+	return constants.FeedOutboxKeyPrefix + strconv.FormatInt(authorID, 10)
+}
+
+// PushFeed 把视频写入这些用户的收件箱，并删除超出长度的最早的视频
+func (v *videoRedis) PushFeed(ctx context.Context, uids []int64, item *model.FeedItem) error {
+	if len(uids) == 0 {
+		return nil
+	}
+	member := redis.Z{Score: float64(item.PublishedAt), Member: item.VideoID}
+	pipe := v.client.Pipeline()
+	for _, uid := range uids {
+		key := feedInboxKey(uid)
+		pipe.ZAdd(ctx, key, member)
+		pipe.ZRemRangeByRank(ctx, key, 0, -constants.FeedInboxMaxLen-1)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis push feed failed: %w", err)
+	}
+	return nil
+}
+
+// PushOutbox 把视频写入作者的发件箱，并把作者加入大 V 集合
+func (v *videoRedis) PushOutbox(ctx context.Context, authorID int64, item *model.FeedItem) error {
+	key := feedOutboxKey(authorID)
+	pipe := v.client.TxPipeline()
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(item.PublishedAt), Member: item.VideoID})
+	pipe.ZRemRangeByRank(ctx, key, 0, -constants.FeedOutboxMaxLen-1)
+	pipe.SAdd(ctx, constants.FeedBigCreatorKey, authorID)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis push outbox failed: %w", err)
+	}
+	return nil
+}
+
+// FilterBigCreators 返回 uids 中使用过发件箱的作者
+func (v *videoRedis) FilterBigCreators(ctx context.Context, uids []int64) ([]int64, error) {
+	if len(uids) == 0 {
+		return nil, nil
+	}
+	members := make([]interface{}, 0, len(uids))
+	for _, uid := range uids {
+		members = append(members, uid)
+	}
+	found, err := v.client.SMIsMember(ctx, constants.FeedBigCreatorKey, members...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis filter big creators failed: %w", err)
+	}
+	creators := make([]int64, 0)
+	for i, ok := range found {
+		if ok {
+			creators = append(creators, uids[i])
+		}
+	}
+	return creators, nil
+}
+
+func (v *videoRedis) RangeFeed(ctx context.Context, uid int64, authors []int64, before *model.FeedItem, limit int64) ([]*model.FeedItem, error) {
+	keys := make([]string, 0, len(authors)+1)
+	keys = append(keys, feedInboxKey(uid))
+	for _, author := range authors {
+		keys = append(keys, feedOutboxKey(author))
+	}
+
+	// 同一毫秒发布的视频分数相同，先取出和游标同分的全部视频，再按视频 ID 过滤
+	max := "+inf"
+	pipe := v.client.Pipeline()
+	var ties []*redis.ZSliceCmd
+	if before != nil {
+		max = "(" + strconv.FormatInt(before.PublishedAt, 10)
+		for _, key := range keys {
+			ties = append(ties, pipe.ZRangeArgsWithScores(ctx, redis.ZRangeArgs{
+				Key:     key,
+				Start:   before.PublishedAt,
+				Stop:    before.PublishedAt,
+				ByScore: true,
+			}))
+		}
+	}
+	pages := make([]*redis.ZSliceCmd, 0, len(keys))
+	for _, key := range keys {
+		pages = append(pages, pipe.ZRangeArgsWithScores(ctx, redis.ZRangeArgs{
+			Key:     key,
+			Start:   "-inf",
+			Stop:    max,
+			ByScore: true,
+			Rev:     true,
+			Count:   limit,
+		}))
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("redis range feed failed: %w", err)
+	}
+
+	var items []*model.FeedItem
+	for _, cmd := range append(ties, pages...) {
+		for _, z := range cmd.Val() {
+			id, err := strconv.ParseInt(z.Member.(string), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("parse video id %v in feed failed: %w", z.Member, err)
+			}
+			item := &model.FeedItem{VideoID: id, PublishedAt: int64(z.Score)}
+			if before != nil && item.PublishedAt == before.PublishedAt && item.VideoID >= before.VideoID {
+				continue
+			}
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
@@ -0,0 +1,76 @@
+package redis
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+)
+
+func TestVideoRedis_RangeFeed(t *testing.T) {
+	convey.Convey("RangeFeed", t, func() {
+		ctx := context.Background()
+		server := miniredis.RunT(t)
+		r := &videoRedis{client: redis.NewClient(&redis.Options{Addr: server.Addr()})}
+
+		const uid, bigCreator = 1, 100
+		// 5、6、7 和 8 在同一毫秒发布，分别在收件箱和发件箱中
+		convey.So(r.PushFeed(ctx, []int64{uid}, &model.FeedItem{VideoID: 9, PublishedAt: 3000}), convey.ShouldBeNil)
+		convey.So(r.PushFeed(ctx, []int64{uid}, &model.FeedItem{VideoID: 8, PublishedAt: 2000}), convey.ShouldBeNil)
+		convey.So(r.PushFeed(ctx, []int64{uid}, &model.FeedItem{VideoID: 6, PublishedAt: 2000}), convey.ShouldBeNil)
+		convey.So(r.PushOutbox(ctx, bigCreator, &model.FeedItem{VideoID: 7, PublishedAt: 2000}), convey.ShouldBeNil)
+		convey.So(r.PushOutbox(ctx, bigCreator, &model.FeedItem{VideoID: 5, PublishedAt: 2000}), convey.ShouldBeNil)
+		convey.So(r.PushFeed(ctx, []int64{uid}, &model.FeedItem{VideoID: 1, PublishedAt: 1000}), convey.ShouldBeNil)
+
+		creators, err := r.FilterBigCreators(ctx, []int64{bigCreator, 200})
+		convey.So(err, convey.ShouldBeNil)
+		convey.So(creators, convey.ShouldResemble, []int64{bigCreator})
+
+		convey.Convey("items in the same millisecond are neither repeated nor skipped across pages", func() {
+			var seen []int64
+			var before *model.FeedItem
+			for page := 0; page < 10; page++ {
+				items, err := r.RangeFeed(ctx, uid, creators, before, 2)
+				convey.So(err, convey.ShouldBeNil)
+				items = sortFeedItems(items)
+				if len(items) > 2 {
+					items = items[:2]
+				}
+				if len(items) == 0 {
+					break
+				}
+				for _, item := range items {
+					seen = append(seen, item.VideoID)
+				}
+				before = items[len(items)-1]
+			}
+			convey.So(seen, convey.ShouldResemble, []int64{9, 8, 7, 6, 5, 1})
+		})
+
+		convey.Convey("ties before the cursor are excluded", func() {
+			items, err := r.RangeFeed(ctx, uid, creators, &model.FeedItem{VideoID: 7, PublishedAt: 2000}, 10)
+			convey.So(err, convey.ShouldBeNil)
+			ids := make([]int64, 0, len(items))
+			for _, item := range sortFeedItems(items) {
+				ids = append(ids, item.VideoID)
+			}
+			convey.So(ids, convey.ShouldResemble, []int64{6, 5, 1})
+		})
+	})
+}
+
+// sortFeedItems 和关注流合并时一样按发布时间和视频 ID 倒序排列
+func sortFeedItems(items []*model.FeedItem) []*model.FeedItem {
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].PublishedAt != items[j].PublishedAt {
+			return items[i].PublishedAt > items[j].PublishedAt
+		}
+		return items[i].VideoID > items[j].VideoID
+	})
+	return items
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
@@ -115,7 +116,7 @@ func (v *videoRedis) GetHotRankRange(ctx context.Context, start, end int64) ([]r
 }
 
 // GetSearchCache 搜索缓存读取
-func (v *videoRedis) GetSearchCache(ctx context.Context, key string) ([]*model.VideoProfile, error) {
+func (v *videoRedis) GetSearchCache(ctx context.Context, key string) (*model.VideoSearchResult, error) {
 	val, err := v.client.Get(ctx, key).Result()
 	if err != nil {
 		return nil, err
@@ -123,16 +124,16 @@ func (v *videoRedis) GetSearchCache(ctx context.Context, key string) ([]*model.V
 	if val == "" {
 		return nil, nil
 	}
-	var result []*model.VideoProfile
+	var result model.VideoSearchResult
 	if err := json.Unmarshal([]byte(val), &result); err != nil {
 		return nil, err
 	}
-	return result, nil
+	return &result, nil
 }
 
 // SetSearchCache 搜索缓存写入
-func (v *videoRedis) SetSearchCache(ctx context.Context, key string, data []*model.VideoProfile, ttl time.Duration) error {
-	if len(data) == 0 {
+func (v *videoRedis) SetSearchCache(ctx context.Context, key string, data *model.VideoSearchResult, ttl time.Duration) error {
+	if data == nil || len(data.Videos) == 0 {
 		return nil // 空结果不缓存
 	}
 	bytes, err := json.Marshal(data)
@@ -153,3 +154,421 @@ func (r *videoRedis) GetLikes(ctx context.Context, videoID int64) (int64, error)
 	}
 	return val, nil
 }
+
+// DeleteVideoRedis 删除视频详情缓存
+func (v *videoRedis) DeleteVideoRedis(ctx context.Context, videoID int64) error {
+	key := fmt.Sprintf("video:%d", videoID)
+	if err := v.client.Del(ctx, key).Err(); err != nil {
+		return fmt.Errorf("redis del video cache failed: %w", err)
+	}
+	return nil
+}
+
+// RemoveHotRank 从热榜中移除视频
+func (v *videoRedis) RemoveHotRank(ctx context.Context, videoID int64) error {
+	if err := v.client.ZRem(ctx, constants.HotRankKey, videoID).Err(); err != nil {
+		return fmt.Errorf("redis zrem hot rank failed: %w", err)
+	}
+	return nil
+}
+
+// GetSearchCacheVersion 读取搜索缓存的版本号，从未失效过时为 0
+func (v *videoRedis) GetSearchCacheVersion(ctx context.Context) (int64, error) {
+	version, err := v.client.Get(ctx, constants.SearchCacheVersionKey).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, fmt.Errorf("redis get search cache version failed: %w", err)
+	}
+	return version, nil
+}
+
+// BumpSearchCacheVersion 搜索缓存版本号加一，之前的缓存全部失效
+func (v *videoRedis) BumpSearchCacheVersion(ctx context.Context) error {
+	if err := v.client.Incr(ctx, constants.SearchCacheVersionKey).Err(); err != nil {
+		return fmt.Errorf("redis incr search cache version failed: %w", err)
+	}
+	return nil
+}
+
+// AddPendingPurge 记录一个待清理的 MinIO 对象，purgeAt 之后才会被清理
+func (v *videoRedis) AddPendingPurge(ctx context.Context, objectKey string, purgeAt time.Time) error {
+	err := v.client.ZAdd(ctx, constants.VideoPendingPurgeKey, redis.Z{
+		Score:  float64(purgeAt.Unix()),
+		Member: objectKey,
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("redis zadd pending purge failed: %w", err)
+	}
+	return nil
+}
+
+// GetDuePurges 获取已经到期、可以清理的 MinIO 对象
+func (v *videoRedis) GetDuePurges(ctx context.Context, now time.Time, limit int64) ([]string, error) {
+	keys, err := v.client.ZRangeByScore(ctx, constants.VideoPendingPurgeKey, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(now.Unix(), 10),
+		Count: limit,
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis get due purges failed: %w", err)
+	}
+	return keys, nil
+}
+
+// RemovePendingPurge 对象清理完成后移除记录
+func (v *videoRedis) RemovePendingPurge(ctx context.Context, objectKey string) error {
+	if err := v.client.ZRem(ctx, constants.VideoPendingPurgeKey, objectKey).Err(); err != nil {
+		return fmt.Errorf("redis zrem pending purge failed: %w", err)
+	}
+	return nil
+}
+
+// GetPopularTagsCache 热门标签缓存读取
+func (v *videoRedis) GetPopularTagsCache(ctx context.Context) ([]*model.TagCount, error) {
+	val, err := v.client.Get(ctx, constants.PopularTagsCacheKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	var result []*model.TagCount
+	if err := json.Unmarshal([]byte(val), &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SetPopularTagsCache 热门标签缓存写入
+func (v *videoRedis) SetPopularTagsCache(ctx context.Context, tags []*model.TagCount, ttl time.Duration) error {
+	bytes, err := json.Marshal(tags)
+	if err != nil {
+		return err
+	}
+	return v.client.Set(ctx, constants.PopularTagsCacheKey, bytes, ttl).Err()
+}
+
+// PublishIndexEvent 通知所有视频服务实例重新索引视频
+func (v *videoRedis) PublishIndexEvent(ctx context.Context, videoID int64) error {
+	if err := v.client.Publish(ctx, constants.SearchIndexEventChannel, videoID).Err(); err != nil {
+		return fmt.Errorf("redis publish index event failed: %w", err)
+	}
+	return nil
+}
+
+// SubscribeIndexEvents 订阅需要重新索引的视频 ID，连接断开时 go-redis 会自动重连并重新订阅
+func (v *videoRedis) SubscribeIndexEvents(ctx context.Context) (<-chan int64, error) {
+	pubsub := v.client.Subscribe(ctx, constants.SearchIndexEventChannel)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, fmt.Errorf("redis subscribe index events failed: %w", err)
+	}
+	events := make(chan int64, constants.SearchIndexRebuildBatchSize)
+	go func() {
+		defer close(events)
+		defer pubsub.Close()
+		for msg := range pubsub.Channel() {
+			videoID, err := strconv.ParseInt(msg.Payload, 10, 64)
+			if err != nil {
+				continue
+			}
+			events <- videoID
+		}
+	}()
+	return events, nil
+}
+
+// AddSuggestion 把 phrase 加入每个前缀的联想集合，weight 为负数时降低权重，降到 0 以下的建议被移除
+// 每个前缀只保留权重最高的 SearchSuggestSetSize 个建议
+func (v *videoRedis) AddSuggestion(ctx context.Context, prefixes []string, phrase string, weight float64) error {
+	pipe := v.client.TxPipeline()
+	for _, prefix := range prefixes {
+		key := constants.SearchSuggestKeyPrefix + prefix
+		pipe.ZIncrBy(ctx, key, weight, phrase)
+		if weight < 0 {
+			pipe.ZRemRangeByScore(ctx, key, "-inf", "0")
+		} else {
+			pipe.ZRemRangeByRank(ctx, key, 0, -constants.SearchSuggestSetSize-1)
+		}
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis update suggestions failed: %w", err)
+	}
+	return nil
+}
+
+// GetSuggestions 按权重从高到低返回前缀的联想建议
+func (v *videoRedis) GetSuggestions(ctx context.Context, prefix string, limit int64) ([]string, error) {
+	suggestions, err := v.client.ZRevRange(ctx, constants.SearchSuggestKeyPrefix+prefix, 0, limit-1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis get suggestions failed: %w", err)
+	}
+	return suggestions, nil
+}
+
+func (v *videoRedis) IncrTrendingSearch(ctx context.Context, keyword string) error {
+	if err := v.client.ZIncrBy(ctx, constants.TrendingSearchKey, 1, keyword).Err(); err != nil {
+		return fmt.Errorf("redis incr trending search failed: %w", err)
+	}
+	return nil
+}
+
+func (v *videoRedis) GetTrendingSearches(ctx context.Context, limit int64) ([]*model.SearchTrend, error) {
+	items, err := v.client.ZRevRangeWithScores(ctx, constants.TrendingSearchKey, 0, limit-1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis get trending searches failed: %w", err)
+	}
+	trends := make([]*model.SearchTrend, 0, len(items))
+	for _, item := range items {
+		keyword, ok := item.Member.(string)
+		if !ok {
+			continue
+		}
+		trends = append(trends, &model.SearchTrend{Keyword: keyword, Score: item.Score})
+	}
+	return trends, nil
+}
+
+// decayTrendingScript 按距离上次衰减的时间把热搜榜所有分数乘以衰减系数，并移除分数过低和排名靠后的搜索词
+// 距离上次衰减不足最小间隔时什么也不做，多个实例同时执行也只会衰减一次
+// KEYS: 热搜榜, 上次衰减时间  ARGV: 当前时间(秒), 最小间隔(秒), 半衰期(秒), 最低分数, 最大数量
+var decayTrendingScript = redis.NewScript(`
+local last = tonumber(redis.call('GET', KEYS[2]))
+local now = tonumber(ARGV[1])
+if not last then
+	redis.call('SET', KEYS[2], ARGV[1])
+	return 0
+end
+local elapsed = now - last
+if elapsed < tonumber(ARGV[2]) then
+	return 0
+end
+redis.call('SET', KEYS[2], ARGV[1])
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	local factor = math.pow(0.5, elapsed / tonumber(ARGV[3]))
+	redis.call('ZUNIONSTORE', KEYS[1], 1, KEYS[1], 'WEIGHTS', tostring(factor))
+	redis.call('ZREMRANGEBYSCORE', KEYS[1], '-inf', '(' .. ARGV[4])
+	redis.call('ZREMRANGEBYRANK', KEYS[1], 0, -tonumber(ARGV[5]) - 1)
+end
+return 1
+`)
+
+// DecayTrendingSearches 衰减热搜榜，返回本次是否执行了衰减
+func (v *videoRedis) DecayTrendingSearches(ctx context.Context, now time.Time) (bool, error) {
+	decayed, err := decayTrendingScript.Run(ctx, v.client,
+		[]string{constants.TrendingSearchKey, constants.TrendingSearchDecayedAtKey},
+		now.Unix(),
+		int64(constants.TrendingSearchDecayInterval/time.Second),
+		int64(constants.TrendingSearchHalfLife/time.Second),
+		constants.TrendingSearchMinScore,
+		constants.TrendingSearchMaxSize,
+	).Int()
+	if err != nil {
+		return false, fmt.Errorf("redis decay trending searches failed: %w", err)
+	}
+	return decayed == 1, nil
+}
+
+// AddSearchHistory 把搜索词移到用户搜索历史的最前面，只保留最近的 SearchHistoryMaxSize 个
+func (v *videoRedis) AddSearchHistory(ctx context.Context, uid int64, keyword string) error {
+	key := fmt.Sprintf("%s%d", constants.SearchHistoryKeyPrefix, uid)
+	pipe := v.client.TxPipeline()
+	pipe.LRem(ctx, key, 0, keyword)
+	pipe.LPush(ctx, key, keyword)
+	pipe.LTrim(ctx, key, 0, constants.SearchHistoryMaxSize-1)
+	pipe.Expire(ctx, key, constants.SearchHistoryTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis add search history failed: %w", err)
+	}
+	return nil
+}
+
+func (v *videoRedis) GetSearchHistory(ctx context.Context, uid int64) ([]string, error) {
+	keywords, err := v.client.LRange(ctx, fmt.Sprintf("%s%d", constants.SearchHistoryKeyPrefix, uid), 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis get search history failed: %w", err)
+	}
+	return keywords, nil
+}
+
+func (v *videoRedis) RemoveSearchHistory(ctx context.Context, uid int64, keyword string) error {
+	if err := v.client.LRem(ctx, fmt.Sprintf("%s%d", constants.SearchHistoryKeyPrefix, uid), 0, keyword).Err(); err != nil {
+		return fmt.Errorf("redis remove search history failed: %w", err)
+	}
+	return nil
+}
+
+func (v *videoRedis) ClearSearchHistory(ctx context.Context, uid int64) error {
+	if err := v.client.Del(ctx, fmt.Sprintf("%s%d", constants.SearchHistoryKeyPrefix, uid)).Err(); err != nil {
+		return fmt.Errorf("redis clear search history failed: %w", err)
+	}
+	return nil
+}
+
+func uploadKey(uploadID int64) string {
+	return fmt.Sprintf("%s%d", constants.VideoUploadKeyPrefix, uploadID)
+}
+
+// SetUploadSession 保存上传会话，已上传分片的记录一起续期
+func (v *videoRedis) SetUploadSession(ctx context.Context, session *model.UploadSession, ttl time.Duration) error {
+	data, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("json marshal upload session failed: %w", err)
+	}
+	key := uploadKey(session.UploadID)
+	pipe := v.client.TxPipeline()
+	pipe.Set(ctx, key, data, ttl)
+	pipe.Expire(ctx, key+":chunks", ttl)
+	if _, err = pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis set upload session failed: %w", err)
+	}
+	return nil
+}
+
+// GetUploadSession 会话不存在或已经过期时返回 nil
+func (v *videoRedis) GetUploadSession(ctx context.Context, uploadID int64) (*model.UploadSession, error) {
+	data, err := v.client.Get(ctx, uploadKey(uploadID)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("redis get upload session failed: %w", err)
+	}
+	var session model.UploadSession
+	if err = json.Unmarshal(data, &session); err != nil {
+		return nil, fmt.Errorf("json unmarshal upload session failed: %w", err)
+	}
+	return &session, nil
+}
+
+// AddUploadedChunk 记录已经上传的分片，同一个分片重复上传时覆盖之前的记录
+func (v *videoRedis) AddUploadedChunk(ctx context.Context, uploadID int64, chunk *model.UploadedChunk, ttl time.Duration) error {
+	data, err := json.Marshal(chunk)
+	if err != nil {
+		return fmt.Errorf("json marshal uploaded chunk failed: %w", err)
+	}
+	key := uploadKey(uploadID) + ":chunks"
+	pipe := v.client.TxPipeline()
+	pipe.HSet(ctx, key, strconv.FormatInt(chunk.PartNumber, 10), data)
+	pipe.Expire(ctx, key, ttl)
+	if _, err = pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis add uploaded chunk failed: %w", err)
+	}
+	return nil
+}
+
+func (v *videoRedis) GetUploadedChunks(ctx context.Context, uploadID int64) ([]*model.UploadedChunk, error) {
+	values, err := v.client.HVals(ctx, uploadKey(uploadID)+":chunks").Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis get uploaded chunks failed: %w", err)
+	}
+	chunks := make([]*model.UploadedChunk, 0, len(values))
+	for _, value := range values {
+		var chunk model.UploadedChunk
+		if err = json.Unmarshal([]byte(value), &chunk); err != nil {
+			return nil, fmt.Errorf("json unmarshal uploaded chunk failed: %w", err)
+		}
+		chunks = append(chunks, &chunk)
+	}
+	return chunks, nil
+}
+
+// LockUpload 合并分片前加锁，返回 false 表示已经有请求在合并
+func (v *videoRedis) LockUpload(ctx context.Context, uploadID int64, ttl time.Duration) (bool, error) {
+	ok, err := v.client.SetNX(ctx, uploadKey(uploadID)+":lock", 1, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis lock upload failed: %w", err)
+	}
+	return ok, nil
+}
+
+func (v *videoRedis) UnlockUpload(ctx context.Context, uploadID int64) error {
+	if err := v.client.Del(ctx, uploadKey(uploadID)+":lock").Err(); err != nil {
+		return fmt.Errorf("redis unlock upload failed: %w", err)
+	}
+	return nil
+}
+
+func (v *videoRedis) IsUploadLocked(ctx context.Context, uploadID int64) (bool, error) {
+	n, err := v.client.Exists(ctx, uploadKey(uploadID)+":lock").Result()
+	if err != nil {
+		return false, fmt.Errorf("redis check upload lock failed: %w", err)
+	}
+	return n > 0, nil
+}
+
+// DeleteUploadSession 删除上传会话、分片记录和合并锁
+func (v *videoRedis) DeleteUploadSession(ctx context.Context, uploadID int64) error {
+	key := uploadKey(uploadID)
+	if err := v.client.Del(ctx, key, key+":chunks", key+":lock").Err(); err != nil {
+		return fmt.Errorf("redis delete upload session failed: %w", err)
+	}
+	return nil
+}
+
+func (v *videoRedis) SetPlaybackURL(ctx context.Context, objectKey, url string, ttl time.Duration) error {
+	if err := v.client.Set(ctx, constants.VideoPlaybackURLKeyPrefix+objectKey, url, ttl).Err(); err != nil {
+		return fmt.Errorf("redis set playback url failed: %w", err)
+	}
+	return nil
+}
+
+// GetPlaybackURL 没有缓存时返回空字符串
+func (v *videoRedis) GetPlaybackURL(ctx context.Context, objectKey string) (string, error) {
+	url, err := v.client.Get(ctx, constants.VideoPlaybackURLKeyPrefix+objectKey).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return "", nil
+		}
+		return "", fmt.Errorf("redis get playback url failed: %w", err)
+	}
+	return url, nil
+}
+
+// GetHotScores 批量读取视频在热榜中的热度，不在热榜中的视频为 0
+func (v *videoRedis) GetHotScores(ctx context.Context, videoIDs []int64) ([]float64, error) {
+	if len(videoIDs) == 0 {
+		return nil, nil
+	}
+	members := make([]string, 0, len(videoIDs))
+	for _, id := range videoIDs {
+		members = append(members, strconv.FormatInt(id, 10))
+	}
+	scores, err := v.client.ZMScore(ctx, constants.HotRankKey, members...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis get hot scores failed: %w", err)
+	}
+	return scores, nil
+}
+
+// SetPageSnapshot 按顺序保存分页快照中的视频 ID
+func (v *videoRedis) SetPageSnapshot(ctx context.Context, snapshotID string, videoIDs []int64, ttl time.Duration) error {
+	members := make([]interface{}, 0, len(videoIDs))
+	for _, id := range videoIDs {
+		members = append(members, id)
+	}
+	key := constants.VideoPageSnapshotKeyPrefix + snapshotID
+	pipe := v.client.TxPipeline()
+	pipe.RPush(ctx, key, members...)
+	pipe.Expire(ctx, key, ttl)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis set page snapshot failed: %w", err)
+	}
+	return nil
+}
+
+// GetPageSnapshot 读取快照中 [start, stop] 位置的视频 ID，快照不存在或已经过期时返回空切片
+func (v *videoRedis) GetPageSnapshot(ctx context.Context, snapshotID string, start, stop int64) ([]int64, error) {
+	values, err := v.client.LRange(ctx, constants.VideoPageSnapshotKeyPrefix+snapshotID, start, stop).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis get page snapshot failed: %w", err)
+	}
+	ids := make([]int64, 0, len(values))
+	for _, value := range values {
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse video id %q in page snapshot failed: %w", value, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
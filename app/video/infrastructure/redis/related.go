@@ -0,0 +1,100 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+)
+
+func relatedVideoKey(videoID int64) string {
+	return constants.RelatedVideoKeyPrefix + strconv.FormatInt(videoID, 10)
+}
+
+func watchHistoryKey(uid int64) string {
+	return constants.WatchHistoryKeyPrefix + strconv.FormatInt(uid, 10)
+}
+
+// LockRelatedRefresh 抢占这一轮计算相关视频的锁，锁到期前其他实例不会重复计算
+func (v *videoRedis) LockRelatedRefresh(ctx context.Context, ttl time.Duration) (bool, error) {
+	ok, err := v.client.SetNX(ctx, constants.RelatedVideoLockKey, 1, ttl).Result()
+	if err != nil {
+		return false, fmt.Errorf("redis lock related videos refresh failed: %w", err)
+	}
+	return ok, nil
+}
+
+// SetRelatedVideos 整体替换视频的相关视频，related 为空时删除之前的结果
+func (v *videoRedis) SetRelatedVideos(ctx context.Context, videoID int64, related []*model.RelatedCandidate, ttl time.Duration) error {
+	key := relatedVideoKey(videoID)
+	pipe := v.client.TxPipeline()
+	pipe.Del(ctx, key)
+	if len(related) > 0 {
+		members := make([]redis.Z, 0, len(related))
+		for _, r := range related {
+			members = append(members, redis.Z{Score: r.Score, Member: r.VideoID})
+		}
+		pipe.ZAdd(ctx, key, members...)
+		pipe.Expire(ctx, key, ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis set related videos failed: %w", err)
+	}
+	return nil
+}
+
+func (v *videoRedis) GetRelatedVideos(ctx context.Context, videoID int64) ([]int64, error) {
+	values, err := v.client.ZRevRange(ctx, relatedVideoKey(videoID), 0, -1).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis get related videos failed: %w", err)
+	}
+	ids := make([]int64, 0, len(values))
+	for _, value := range values {
+		id, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse related video id %q failed: %w", value, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// AddWatchHistory 记录用户看过的视频，只保留最近的 WatchHistoryMaxSize 个
+func (v *videoRedis) AddWatchHistory(ctx context.Context, uid, videoID int64, watchedAt time.Time) error {
+	key := watchHistoryKey(uid)
+	pipe := v.client.TxPipeline()
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(watchedAt.Unix()), Member: videoID})
+	pipe.ZRemRangeByRank(ctx, key, 0, -constants.WatchHistoryMaxSize-1)
+	pipe.Expire(ctx, key, constants.WatchHistoryTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("redis add watch history failed: %w", err)
+	}
+	return nil
+}
+
+func (v *videoRedis) FilterWatched(ctx context.Context, uid int64, videoIDs []int64) ([]int64, error) {
+	if len(videoIDs) == 0 {
+		return nil, nil
+	}
+	members := make([]string, 0, len(videoIDs))
+	for _, id := range videoIDs {
+		members = append(members, strconv.FormatInt(id, 10))
+	}
+	// 没有看过的视频分数为 0
+	scores, err := v.client.ZMScore(ctx, watchHistoryKey(uid), members...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("redis filter watched videos failed: %w", err)
+	}
+	unwatched := make([]int64, 0, len(videoIDs))
+	for i, score := range scores {
+		if score == 0 {
+			unwatched = append(unwatched, videoIDs[i])
+		}
+	}
+	return unwatched, nil
+}
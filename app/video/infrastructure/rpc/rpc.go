@@ -0,0 +1,54 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/repository"
+	userrpc "github.com/LingeringAutumn/Yijie/kitex_gen/user"
+	"github.com/LingeringAutumn/Yijie/kitex_gen/user/userservice"
+	"github.com/LingeringAutumn/Yijie/pkg/utils"
+)
+
+type videoRPC struct {
+	user userservice.Client
+}
+
+func NewVideoRPC(user userservice.Client) repository.VideoRPC {
+	return &videoRPC{user: user}
+}
+
+func (rpc *videoRPC) CheckPrivacy(ctx context.Context, viewerID, ownerID int64, action string) (bool, error) {
+	req := &userrpc.CheckPrivacyRequest{
+		ViewerId: viewerID,
+		OwnerId:  ownerID,
+		Action:   action,
+	}
+	resp, err := rpc.user.CheckPrivacy(ctx, req)
+	if err = utils.ProcessRpcError("user.CheckPrivacy", resp, err); err != nil {
+		return false, err
+	}
+	return resp.Allowed, nil
+}
+
+func (rpc *videoRPC) ListFollowers(ctx context.Context, uid, cursor, limit int64) ([]int64, int64, int64, error) {
+	req := &userrpc.ListFollowersRequest{
+		UserId: uid,
+		Limit:  limit,
+	}
+	if cursor != 0 {
+		req.Cursor = &cursor
+	}
+	resp, err := rpc.user.ListFollowers(ctx, req)
+	if err = utils.ProcessRpcError("user.ListFollowers", resp, err); err != nil {
+		return nil, 0, 0, err
+	}
+	return resp.FollowerIds, resp.GetNextCursor(), resp.Total, nil
+}
+
+func (rpc *videoRPC) ListFollowing(ctx context.Context, uid int64) ([]int64, error) {
+	resp, err := rpc.user.ListFollowing(ctx, &userrpc.ListFollowingRequest{UserId: uid})
+	if err = utils.ProcessRpcError("user.ListFollowing", resp, err); err != nil {
+		return nil, err
+	}
+	return resp.FollowingIds, nil
+}
@@ -0,0 +1,265 @@
+package searchindex
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/app/video/domain/repository"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+	"github.com/LingeringAutumn/Yijie/pkg/search"
+	"github.com/LingeringAutumn/Yijie/pkg/utils"
+)
+
+var fields = []search.Field{
+	{Name: constants.SearchFieldTitle, Boost: constants.SearchTitleBoost},
+	{Name: constants.SearchFieldDescription, Boost: constants.SearchDescriptionBoost},
+	{Name: constants.SearchFieldTags, Boost: constants.SearchTagsBoost},
+}
+
+// IndexedVideo 是排序和过滤需要的视频属性，写入后不再修改，更新时整体替换
+type IndexedVideo struct {
+	Tags      []string
+	CreatedAt int64
+	Views     int64
+}
+
+// snapshotFile 是快照文件的内容，gob 编码后再 gzip 压缩
+type snapshotFile struct {
+	TakenAt time.Time
+	Videos  map[int64]*IndexedVideo
+	Index   *search.Snapshot
+}
+
+type videoSearchEngine struct {
+	mu     sync.RWMutex // 保证 videos 和 index 一致，快照时不会拍到只更新了一半的视频
+	index  *search.Index
+	videos map[int64]*IndexedVideo
+	dir    string
+}
+
+func NewVideoSearchEngine(snapshotDir string) repository.VideoSearchEngine {
+	return &videoSearchEngine{
+		index:  search.NewIndex(fields),
+		videos: make(map[int64]*IndexedVideo),
+		dir:    snapshotDir,
+	}
+}
+
+func (e *videoSearchEngine) Index(video *model.VideoProfile) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.videos[video.VideoID] = &IndexedVideo{
+		Tags:      video.Tags,
+		CreatedAt: video.CreatedAt,
+		Views:     video.Views,
+	}
+	e.index.Add(search.Document{
+		ID: video.VideoID,
+		Fields: map[string]string{
+			constants.SearchFieldTitle:       video.Title,
+			constants.SearchFieldDescription: video.Description,
+			constants.SearchFieldTags:        strings.Join(video.Tags, " "),
+		},
+	})
+}
+
+func (e *videoSearchEngine) Remove(videoID int64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	delete(e.videos, videoID)
+	e.index.Remove(videoID)
+}
+
+func (e *videoSearchEngine) UpdateViews(videoID, views int64) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if v, ok := e.videos[videoID]; ok && v.Views != views {
+		updated := *v
+		updated.Views = views
+		e.videos[videoID] = &updated
+	}
+}
+
+func (e *videoSearchEngine) Len() int {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return len(e.videos)
+}
+
+// Search 返回一页视频 ID 和命中总数
+// 相关度排序只使用 BM25 分数，分数相同时新发布的在前；关键词以 * 结尾时按前缀匹配
+func (e *videoSearchEngine) Search(query *model.VideoSearchQuery) ([]int64, int64) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	filter := func(id int64) bool {
+		v, ok := e.videos[id]
+		return ok && matchTags(v.Tags, query.Tags, query.TagMode)
+	}
+	var hits []search.Hit
+	if query.Keyword == "" {
+		for id := range e.videos {
+			if filter(id) {
+				hits = append(hits, search.Hit{ID: id})
+			}
+		}
+	} else {
+		hits = e.index.Search(search.Query{Text: query.Keyword, Filter: filter})
+	}
+
+	newer := func(a, b search.Hit) bool {
+		if e.videos[a.ID].CreatedAt != e.videos[b.ID].CreatedAt {
+			return e.videos[a.ID].CreatedAt > e.videos[b.ID].CreatedAt
+		}
+		return a.ID > b.ID
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		a, b := hits[i], hits[j]
+		switch query.Sort {
+		case constants.VideoSearchSortNewest:
+		case constants.VideoSearchSortMostViewed:
+			if e.videos[a.ID].Views != e.videos[b.ID].Views {
+				return e.videos[a.ID].Views > e.videos[b.ID].Views
+			}
+		default:
+			if a.Score != b.Score {
+				return a.Score > b.Score
+			}
+		}
+		return newer(a, b)
+	})
+
+	total := int64(len(hits))
+	start := (query.PageNum - 1) * query.PageSize
+	if start < 0 || start >= total {
+		return nil, total
+	}
+	end := start + query.PageSize
+	if end > total {
+		end = total
+	}
+	ids := make([]int64, 0, end-start)
+	for _, hit := range hits[start:end] {
+		ids = append(ids, hit.ID)
+	}
+	return ids, total
+}
+
+// SaveSnapshot 把索引保存到本地目录，并上传到 MinIO 供新实例或者本地文件丢失时使用
+func (e *videoSearchEngine) SaveSnapshot(ctx context.Context) error {
+	e.mu.RLock()
+	snap := &snapshotFile{
+		TakenAt: time.Now(),
+		Videos:  make(map[int64]*IndexedVideo, len(e.videos)),
+		Index:   e.index.Snapshot(),
+	}
+	for id, v := range e.videos {
+		snap.Videos[id] = v
+	}
+	e.mu.RUnlock()
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := gob.NewEncoder(zw).Encode(snap); err != nil {
+		return fmt.Errorf("encode search index snapshot failed: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("compress search index snapshot failed: %w", err)
+	}
+	data := buf.Bytes()
+
+	// 先写临时文件再重命名，避免进程中途退出留下不完整的快照
+	if err := os.MkdirAll(e.dir, 0o755); err != nil {
+		return fmt.Errorf("create snapshot dir failed: %w", err)
+	}
+	path := filepath.Join(e.dir, constants.SearchSnapshotObject)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("write search index snapshot failed: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("rename search index snapshot failed: %w", err)
+	}
+
+	if utils.MinioClientGlobal == nil {
+		return nil
+	}
+	err := utils.MinioClientGlobal.UploadFile(constants.IndexBucket, constants.SearchSnapshotObject,
+		constants.Location, "application/gzip", data)
+	if err != nil {
+		return fmt.Errorf("upload search index snapshot failed: %w", err)
+	}
+	return nil
+}
+
+// LoadSnapshot 优先从本地目录加载快照，本地没有时从 MinIO 下载，返回快照的拍摄时间
+// 两处都没有可用的快照时返回 os.ErrNotExist
+func (e *videoSearchEngine) LoadSnapshot(ctx context.Context) (time.Time, error) {
+	data, err := os.ReadFile(filepath.Join(e.dir, constants.SearchSnapshotObject))
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			logger.Warnf("read local search index snapshot failed: %v", err)
+		}
+		if utils.MinioClientGlobal == nil {
+			return time.Time{}, os.ErrNotExist
+		}
+		if data, err = utils.MinioClientGlobal.GetFile(constants.IndexBucket, constants.SearchSnapshotObject); err != nil {
+			return time.Time{}, err
+		}
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("decompress search index snapshot failed: %w", err)
+	}
+	var snap snapshotFile
+	if err = gob.NewDecoder(zr).Decode(&snap); err != nil {
+		return time.Time{}, fmt.Errorf("decode search index snapshot failed: %w", err)
+	}
+	index, ok := search.Restore(snap.Index, fields)
+	if !ok {
+		return time.Time{}, fmt.Errorf("search index snapshot fields changed: %w", os.ErrNotExist)
+	}
+	if snap.Videos == nil {
+		snap.Videos = make(map[int64]*IndexedVideo)
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.index = index
+	e.videos = snap.Videos
+	return snap.TakenAt, nil
+}
+
+// matchTags 判断视频标签是否满足过滤条件，any 模式命中一个即可，all 模式需要全部命中
+func matchTags(videoTags, wanted []string, mode string) bool {
+	if len(wanted) == 0 {
+		return true
+	}
+	have := make(map[string]struct{}, len(videoTags))
+	for _, tag := range videoTags {
+		have[tag] = struct{}{}
+	}
+	matched := 0
+	for _, tag := range wanted {
+		if _, ok := have[tag]; ok {
+			matched++
+		}
+	}
+	if mode == constants.VideoTagModeAll {
+		return matched == len(wanted)
+	}
+	return matched > 0
+}
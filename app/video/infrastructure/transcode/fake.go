@@ -0,0 +1,54 @@
+package transcode
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+)
+
+// FakeTranscoder 不依赖 ffmpeg，用于测试：Probe 返回预设的结果，Transcode 把输入原样复制到输出
+type FakeTranscoder struct {
+	ProbeResult  *model.ProbeResult
+	ProbeErr     error
+	TranscodeErr error
+
+	mu       sync.Mutex
+	Profiles []string // 依次转码过的清晰度
+}
+
+func NewFakeTranscoder(probe *model.ProbeResult) *FakeTranscoder {
+	return &FakeTranscoder{ProbeResult: probe}
+}
+
+func (t *FakeTranscoder) Probe(ctx context.Context, path string) (*model.ProbeResult, error) {
+	if t.ProbeErr != nil {
+		return nil, t.ProbeErr
+	}
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	result := *t.ProbeResult
+	return &result, nil
+}
+
+func (t *FakeTranscoder) Transcode(ctx context.Context, input, output string, profile *model.RenditionProfile, progress func(seconds float64)) error {
+	if t.TranscodeErr != nil {
+		return t.TranscodeErr
+	}
+	data, err := os.ReadFile(input)
+	if err != nil {
+		return err
+	}
+	if err = os.WriteFile(output, data, 0o644); err != nil {
+		return err
+	}
+	t.mu.Lock()
+	t.Profiles = append(t.Profiles, profile.Name)
+	t.mu.Unlock()
+	if progress != nil {
+		progress(t.ProbeResult.DurationSeconds)
+	}
+	return nil
+}
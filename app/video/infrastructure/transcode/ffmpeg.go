@@ -0,0 +1,131 @@
+package transcode
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/app/video/domain/repository"
+)
+
+// maxStderrLen 命令失败时错误信息中最多保留的 stderr 长度，ffmpeg 的输出很长，只有末尾有用
+const maxStderrLen = 512
+
+type ffmpegTranscoder struct {
+	ffmpeg  string
+	ffprobe string
+}
+
+// NewFFmpegTranscoder 调用外部的 ffprobe 和 ffmpeg 命令
+func NewFFmpegTranscoder(ffmpegPath, ffprobePath string) repository.VideoTranscoder {
+	return &ffmpegTranscoder{ffmpeg: ffmpegPath, ffprobe: ffprobePath}
+}
+
+// probeOutput 是 ffprobe -print_format json 输出中用到的部分，数值字段是字符串
+type probeOutput struct {
+	Format struct {
+		FormatName string `json:"format_name"`
+		Duration   string `json:"duration"`
+		BitRate    string `json:"bit_rate"`
+	} `json:"format"`
+	Streams []struct {
+		CodecType string `json:"codec_type"`
+		CodecName string `json:"codec_name"`
+		Width     int64  `json:"width"`
+		Height    int64  `json:"height"`
+	} `json:"streams"`
+}
+
+func (t *ffmpegTranscoder) Probe(ctx context.Context, path string) (*model.ProbeResult, error) {
+	out, err := run(ctx, t.ffprobe, "-v", "error", "-print_format", "json", "-show_format", "-show_streams", path)
+	if err != nil {
+		return nil, err
+	}
+	var probe probeOutput
+	if err = json.Unmarshal(out, &probe); err != nil {
+		return nil, fmt.Errorf("parse ffprobe output failed: %w", err)
+	}
+
+	result := &model.ProbeResult{FormatName: probe.Format.FormatName}
+	// 某些容器没有时长或码率，解析失败时保持 0，由校验步骤拒绝
+	result.DurationSeconds, _ = strconv.ParseFloat(probe.Format.Duration, 64)
+	result.Bitrate, _ = strconv.ParseInt(probe.Format.BitRate, 10, 64)
+	for _, s := range probe.Streams {
+		switch {
+		case s.CodecType == "video" && result.VideoCodec == "":
+			result.VideoCodec = s.CodecName
+			result.Width = s.Width
+			result.Height = s.Height
+		case s.CodecType == "audio" && result.AudioCodec == "":
+			result.AudioCodec = s.CodecName
+		}
+	}
+	return result, nil
+}
+
+// Transcode 转成 H.264/AAC 的 MP4，moov 放在文件开头以便边下边播
+// ffmpeg 通过 -progress 把进度以 key=value 的形式逐行写到 stdout
+func (t *ffmpegTranscoder) Transcode(ctx context.Context, input, output string, profile *model.RenditionProfile, progress func(seconds float64)) error {
+	cmd := exec.CommandContext(ctx, t.ffmpeg,
+		"-y", "-v", "error", "-nostats", "-progress", "pipe:1",
+		"-i", input,
+		"-vf", fmt.Sprintf("scale=-2:%d", profile.Height),
+		"-c:v", "libx264", "-preset", "veryfast",
+		"-b:v", strconv.FormatInt(profile.VideoBitrate, 10),
+		"-maxrate", strconv.FormatInt(profile.VideoBitrate, 10),
+		"-bufsize", strconv.FormatInt(profile.VideoBitrate*2, 10),
+		"-c:a", "aac", "-b:a", strconv.FormatInt(profile.AudioBitrate, 10),
+		"-movflags", "+faststart",
+		output,
+	)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("%s failed: %w", t.ffmpeg, err)
+	}
+	if err = cmd.Start(); err != nil {
+		return fmt.Errorf("%s failed: %w", t.ffmpeg, err)
+	}
+	scanner := bufio.NewScanner(stdout)
+	for scanner.Scan() {
+		// out_time_us 是已经输出的时长，单位微秒，开头几行可能是 N/A
+		value, ok := strings.CutPrefix(scanner.Text(), "out_time_us=")
+		if !ok || progress == nil {
+			continue
+		}
+		if us, err := strconv.ParseInt(value, 10, 64); err == nil && us > 0 {
+			progress(float64(us) / 1e6)
+		}
+	}
+	if err = cmd.Wait(); err != nil {
+		return fmt.Errorf("%s failed: %w: %s", t.ffmpeg, err, tail(stderr.String()))
+	}
+	return nil
+}
+
+// run 执行命令并返回 stdout，失败时把 stderr 的末尾附在错误中
+func run(ctx context.Context, name string, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s failed: %w: %s", name, err, tail(stderr.String()))
+	}
+	return stdout.Bytes(), nil
+}
+
+func tail(stderr string) string {
+	msg := strings.TrimSpace(stderr)
+	if len(msg) > maxStderrLen {
+		msg = msg[len(msg)-maxStderrLen:]
+	}
+	return msg
+}
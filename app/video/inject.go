@@ -1,15 +1,22 @@
 package video
 
 import (
+	"github.com/LingeringAutumn/Yijie/app/video/controllers/mq"
 	"github.com/LingeringAutumn/Yijie/app/video/controllers/rpc"
+	"github.com/LingeringAutumn/Yijie/app/video/domain/repository"
 	"github.com/LingeringAutumn/Yijie/app/video/domain/service"
 	"github.com/LingeringAutumn/Yijie/app/video/infrastructure/mysql"
+	"github.com/LingeringAutumn/Yijie/app/video/infrastructure/objectstore"
 	"github.com/LingeringAutumn/Yijie/app/video/infrastructure/redis"
+	videoRpcPkg "github.com/LingeringAutumn/Yijie/app/video/infrastructure/rpc"
+	"github.com/LingeringAutumn/Yijie/app/video/infrastructure/searchindex"
+	"github.com/LingeringAutumn/Yijie/app/video/infrastructure/transcode"
 	"github.com/LingeringAutumn/Yijie/app/video/usecase"
 	"github.com/LingeringAutumn/Yijie/config"
 	"github.com/LingeringAutumn/Yijie/kitex_gen/video"
 	"github.com/LingeringAutumn/Yijie/pkg/base/client"
 	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
 	"github.com/LingeringAutumn/Yijie/pkg/utils"
 )
 
@@ -19,7 +26,7 @@ type Components struct {
 	Service *service.VideoService
 }
 
-// InjectComponents 构建视频服务所有依赖并返回组件集合
+// InjectComponents 构建视频服务所有依赖并返回组件集合，MinIO 需要提前初始化
 func InjectComponents() *Components {
 	// 初始化数据库存储
 	gormDB, err := client.InitMySQL()
@@ -40,8 +47,28 @@ func InjectComponents() *Components {
 		panic(err)
 	}
 
+	// 初始化用户服务客户端，用于查询隐私设置
+	c, err := client.InitUserRPC()
+	if err != nil {
+		logger.Fatalf("video.rpc.user InitUserRPC failed, err is %v", err)
+	}
+	videoRpc := videoRpcPkg.NewVideoRPC(*c)
+
+	// 使用内置搜索引擎时创建索引，索引在 StartBackgroundTasks 中加载
+	var engine repository.VideoSearchEngine
+	if config.GetSearchEngine() == constants.SearchEngineEmbedded {
+		engine = searchindex.NewVideoSearchEngine(config.GetSearchSnapshotDir())
+	}
+
+	// 分页游标的签名密钥
+	cursor, err := utils.NewCursorSigner()
+	if err != nil {
+		panic(err)
+	}
+
 	db := mysql.NewVideoDB(gormDB)
-	svc := service.NewVideoService(db, redisRepo, sf)
+	store := objectstore.NewMinioStore(utils.MinioClientGlobal)
+	svc := service.NewVideoService(db, redisRepo, videoRpc, store, sf, cursor, engine)
 	uc := usecase.NewVideoUseCase(db, redisRepo, sf, svc)
 	handler := rpc.NewVideoHandler(uc)
 
@@ -50,3 +77,23 @@ func InjectComponents() *Components {
 		Service: svc,
 	}
 }
+
+// InjectUploadTaskHandler 构建视频处理 worker 的依赖，MinIO 需要提前初始化
+func InjectUploadTaskHandler() *mq.UploadTaskHandler {
+	gormDB, err := client.InitMySQL()
+	if err != nil {
+		panic(err)
+	}
+
+	// 处理状态变化后需要删除视频缓存并通知视频服务更新搜索索引
+	redisClient, err := client.InitRedis(constants.RedisDBUser)
+	if err != nil {
+		panic(err)
+	}
+
+	db := mysql.NewVideoDB(gormDB)
+	store := objectstore.NewMinioStore(utils.MinioClientGlobal)
+	transcoder := transcode.NewFFmpegTranscoder(config.GetFFmpegPath(), config.GetFFprobePath())
+	svc := service.NewProcessingService(db, redis.NewVideoRedis(redisClient), store, service.DefaultPipeline(transcoder), config.GetVideoWorkDir())
+	return mq.NewUploadTaskHandler(svc, config.GetVideoWorkerConcurrency())
+}
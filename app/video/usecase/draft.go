@@ -0,0 +1,81 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+)
+
+// ListDrafts 获取当前用户的草稿列表
+func (uc *videoUseCase) ListDrafts(ctx context.Context, pageNum int64, pageSize int64) ([]*model.VideoProfile, error) {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get user id failed: %w", err)
+	}
+	drafts, err := uc.svc.ListDrafts(ctx, uid, pageNum, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	uc.svc.SignPlaybackURLs(ctx, drafts)
+	return drafts, nil
+}
+
+// ScheduleVideo 设置草稿的发布时间，发布时间不晚于当前时间时立即发布
+func (uc *videoUseCase) ScheduleVideo(ctx context.Context, videoID int64, publishAt int64) (*model.VideoProfile, error) {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get user id failed: %w", err)
+	}
+	profile, err := uc.svc.CheckVideoOwner(ctx, videoID, uid)
+	if err != nil {
+		return nil, err
+	}
+	if err = uc.svc.CheckDraft(profile); err != nil {
+		return nil, err
+	}
+
+	at := time.Unix(publishAt, 0)
+	if at.After(time.Now()) {
+		err = uc.svc.ScheduleDraft(ctx, videoID, at)
+	} else {
+		_, err = uc.svc.PublishDraft(ctx, videoID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if profile, err = uc.svc.GetVideoDB(ctx, videoID); err != nil {
+		return nil, err
+	}
+	uc.svc.SignPlaybackURLs(ctx, []*model.VideoProfile{profile})
+	return profile, nil
+}
+
+// canView 草稿和还没有处理完成的视频只有作者本人可见，其余视频按可见范围判断
+// 查询关注关系失败时按不可见处理
+func (uc *videoUseCase) canView(ctx context.Context, profile *model.VideoProfile) bool {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		uid = 0 // 未登录
+	}
+	if uid != 0 && uid == profile.UserID {
+		return true
+	}
+	if profile.Status == constants.VideoStatusDraft || !isReady(profile) {
+		return false
+	}
+	allowed, err := uc.svc.CheckVisibility(ctx, uid, profile)
+	if err != nil {
+		logger.Errorf("check visibility of video %d for user %d failed: %v", profile.VideoID, uid, err)
+		return false
+	}
+	return allowed
+}
+
+// isReady 判断视频是否处理完成，处理流程上线之前写入的缓存没有处理状态，视为已就绪
+func isReady(profile *model.VideoProfile) bool {
+	return profile.ProcessingStatus == "" || profile.ProcessingStatus == constants.VideoProcessingStatusReady
+}
@@ -0,0 +1,28 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+)
+
+// GetFeed 获取当前用户的关注流，过滤掉还没有处理完成或者已经不可见的视频
+func (uc *videoUseCase) GetFeed(ctx context.Context, cursor string, limit int64) ([]*model.VideoProfile, string, error) {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		return nil, "", fmt.Errorf("get user id failed: %w", err)
+	}
+	videos, nextCursor, err := uc.svc.GetFeed(ctx, uid, cursor, limit)
+	if err != nil {
+		return nil, "", err
+	}
+	visible := videos[:0]
+	for _, v := range videos {
+		if uc.canView(ctx, v) {
+			visible = append(visible, v)
+		}
+	}
+	uc.svc.SignPlaybackURLs(ctx, visible)
+	return visible, nextCursor, nil
+}
@@ -0,0 +1,33 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+)
+
+// ListSearchHistory 获取当前用户的搜索历史
+func (uc *videoUseCase) ListSearchHistory(ctx context.Context) ([]string, error) {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get user id failed: %w", err)
+	}
+	return uc.svc.ListSearchHistory(ctx, uid)
+}
+
+// DeleteSearchHistoryItem 删除当前用户的一条搜索历史
+func (uc *videoUseCase) DeleteSearchHistoryItem(ctx context.Context, keyword string) error {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		return fmt.Errorf("get user id failed: %w", err)
+	}
+	return uc.svc.DeleteSearchHistoryItem(ctx, uid, keyword)
+}
+
+// ClearSearchHistory 清空当前用户的搜索历史
+func (uc *videoUseCase) ClearSearchHistory(ctx context.Context) error {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		return fmt.Errorf("get user id failed: %w", err)
+	}
+	return uc.svc.ClearSearchHistory(ctx, uid)
+}
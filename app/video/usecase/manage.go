@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+)
+
+// UpdateVideo 作者编辑视频的标题、描述、封面、标签和可见范围
+func (uc *videoUseCase) UpdateVideo(ctx context.Context, update *model.VideoUpdate) (*model.VideoProfile, error) {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get user id failed: %w", err)
+	}
+	if err = uc.svc.ValidateVideoUpdate(update); err != nil {
+		return nil, err
+	}
+	old, err := uc.svc.CheckVideoOwner(ctx, update.VideoID, uid)
+	if err != nil {
+		return nil, err
+	}
+	if err = uc.svc.UpdateVideo(ctx, update); err != nil {
+		return nil, err
+	}
+
+	// 缓存失效，下次读取时回源
+	if err = uc.svc.DeleteVideoRedis(ctx, update.VideoID); err != nil {
+		logger.Errorf("delete cache of video %d failed: %v", update.VideoID, err)
+	}
+	profile, err := uc.svc.GetVideoDB(ctx, update.VideoID)
+	if err != nil {
+		return nil, err
+	}
+	if err = uc.svc.InvalidateSearchCache(ctx); err != nil {
+		logger.Errorf("invalidate search cache after editing video %d failed: %v", update.VideoID, err)
+	}
+	if err = uc.svc.RefreshSearchIndex(ctx, update.VideoID); err != nil {
+		logger.Errorf("reindex video %d failed: %v", update.VideoID, err)
+	}
+	uc.relistVideo(ctx, old, profile)
+
+	profile.Views, _ = uc.svc.GetViews(ctx, update.VideoID)
+	profile.Likes, _ = uc.svc.GetLikes(ctx, update.VideoID)
+	uc.svc.SignPlaybackURLs(ctx, []*model.VideoProfile{profile})
+	return profile, nil
+}
+
+// relistVideo 编辑后同步热榜、搜索联想和关注流：改为公开的视频加入热榜和联想，改为不公开的移出，改标题时替换联想中的标题
+// 从私密或不公开改为粉丝能看到时推送到粉丝的关注流，按改动的时间排序
+func (uc *videoUseCase) relistVideo(ctx context.Context, old, profile *model.VideoProfile) {
+	if profile.Status == constants.VideoStatusPublished && !uc.svc.InFeed(old.Visibility) && uc.svc.InFeed(profile.Visibility) {
+		uc.asyncDeliverFeed(profile.UserID, profile.VideoID, time.Now())
+	}
+	wasListed, listed := uc.svc.IsListed(old), uc.svc.IsListed(profile)
+	if wasListed && (!listed || profile.Title != old.Title) {
+		if err := uc.svc.RemoveTitleSuggestion(ctx, old.Title); err != nil {
+			logger.Errorf("remove old title of video %d from suggestions failed: %v", old.VideoID, err)
+		}
+	}
+	if listed && (!wasListed || profile.Title != old.Title) {
+		if err := uc.svc.AddTitleSuggestion(ctx, profile.Title); err != nil {
+			logger.Errorf("add new title of video %d to suggestions failed: %v", old.VideoID, err)
+		}
+	}
+	switch {
+	case wasListed && !listed:
+		if err := uc.svc.RemoveHotRank(ctx, old.VideoID); err != nil {
+			logger.Errorf("remove video %d from hot rank failed: %v", old.VideoID, err)
+		}
+	case !wasListed && listed:
+		if err := uc.svc.UpdateHotRank(ctx, old.VideoID, profile.HotScore); err != nil {
+			logger.Errorf("add video %d to hot rank failed: %v", old.VideoID, err)
+		}
+	}
+}
+
+// DeleteVideo 作者删除视频：逻辑删除，清理热榜和缓存，视频文件延迟删除
+func (uc *videoUseCase) DeleteVideo(ctx context.Context, videoID int64) error {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		return fmt.Errorf("get user id failed: %w", err)
+	}
+	profile, err := uc.svc.CheckVideoOwner(ctx, videoID, uid)
+	if err != nil {
+		return err
+	}
+	if err = uc.svc.DeleteVideo(ctx, videoID); err != nil {
+		return err
+	}
+
+	// 以下清理失败不影响删除结果：详情查询以数据库为准，热榜和搜索缓存会随过期或下一次删除被修正
+	if err = uc.svc.RemoveHotRank(ctx, videoID); err != nil {
+		logger.Errorf("remove video %d from hot rank failed: %v", videoID, err)
+	}
+	if err = uc.svc.DeleteVideoRedis(ctx, videoID); err != nil {
+		logger.Errorf("delete cache of video %d failed: %v", videoID, err)
+	}
+	if err = uc.svc.InvalidateSearchCache(ctx); err != nil {
+		logger.Errorf("invalidate search cache of video %d failed: %v", videoID, err)
+	}
+	if err = uc.svc.RefreshSearchIndex(ctx, videoID); err != nil {
+		logger.Errorf("remove video %d from search index failed: %v", videoID, err)
+	}
+	if uc.svc.IsListed(profile) {
+		if err = uc.svc.RemoveTitleSuggestion(ctx, profile.Title); err != nil {
+			logger.Errorf("remove title of video %d from suggestions failed: %v", videoID, err)
+		}
+	}
+	if err = uc.svc.ScheduleObjectPurge(ctx, videoID, profile.VideoURL); err != nil {
+		logger.Errorf("schedule purge of video %d failed: %v", videoID, err)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+)
+
+// GetVideoProcessingStatus 获取视频的处理状态，只有作者本人可以查看
+func (uc *videoUseCase) GetVideoProcessingStatus(ctx context.Context, videoID int64) (*model.VideoProcessing, error) {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get user id failed: %w", err)
+	}
+	profile, err := uc.svc.CheckVideoOwner(ctx, videoID, uid)
+	if err != nil {
+		return nil, err
+	}
+	return uc.svc.GetProcessingStatus(ctx, profile)
+}
@@ -0,0 +1,38 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// RelatedVideos 获取视频详情页的相关视频，未登录时按游客处理，看不到的视频没有相关视频
+func (uc *videoUseCase) RelatedVideos(ctx context.Context, videoID int64, limit int64) ([]*model.VideoProfile, error) {
+	viewer, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		viewer = 0
+	}
+	source, err := uc.svc.GetVideoRedis(ctx, videoID)
+	if err != nil {
+		if source, err = uc.svc.GetVideoDB(ctx, videoID); err != nil {
+			return nil, err
+		}
+	}
+	if !uc.canView(ctx, source) {
+		return nil, errno.Errorf(errno.DBNotFound, "video %d not found", videoID)
+	}
+
+	videos, err := uc.svc.RelatedVideos(ctx, viewer, videoID, limit)
+	if err != nil {
+		return nil, err
+	}
+	visible := videos[:0]
+	for _, v := range videos {
+		if uc.canView(ctx, v) {
+			visible = append(visible, v)
+		}
+	}
+	uc.svc.SignPlaybackURLs(ctx, visible)
+	return visible, nil
+}
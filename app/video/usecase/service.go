@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/IBM/sarama"
@@ -12,6 +13,8 @@ import (
 	"github.com/LingeringAutumn/Yijie/app/video/infrastructure/kafka"
 	"github.com/LingeringAutumn/Yijie/config"
 	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
 	"github.com/LingeringAutumn/Yijie/pkg/utils"
 )
 
@@ -29,6 +32,16 @@ func (uc *videoUseCase) SubmitVideo(ctx context.Context, video *model.Video, vid
 		return 0, "", fmt.Errorf("generate video id failed: %w", err)
 	}
 	video.VideoID = videoId
+	uc.svc.PrepareSubmission(video)
+	if video.Tags, err = uc.svc.NormalizeTags(video.Tags); err != nil {
+		return 0, "", err
+	}
+	if err = uc.svc.ProbeVideo(video, videoData); err != nil {
+		return 0, "", err
+	}
+	if videoData, err = uc.svc.FastStart(video, videoData); err != nil {
+		return 0, "", err
+	}
 
 	// 3. 上传视频文件至 MinIO
 	objectKey := fmt.Sprintf("%d.mp4", videoId)
@@ -43,51 +56,47 @@ func (uc *videoUseCase) SubmitVideo(ctx context.Context, video *model.Video, vid
 		return 0, "", fmt.Errorf("upload to MinIO failed: %w", err)
 	}
 
-	// 4. 构建视频访问 URL
-	videoUrl = fmt.Sprintf("%s/%s/%s", config.Minio.Endpoint, constants.VideoBucket, objectKey)
-	video.VideoURL = videoUrl
-
-	// 5. 构造 Kafka 配置
-	kafkaCfg, err := kafka.NewProducerConfig()
-	if err != nil {
-		return 0, "", fmt.Errorf("build kafka config failed: %w", err)
-	}
-	producer, err := sarama.NewSyncProducer([]string{config.Kafka.Broker}, kafkaCfg)
+	videoUrl, err = uc.createVideo(ctx, video, objectKey)
 	if err != nil {
-		return 0, "", fmt.Errorf("create kafka producer failed: %w", err)
+		return 0, "", err
 	}
-	defer producer.Close()
+	return videoId, videoUrl, nil
+}
 
-	// 6. 构建 Kafka 消息
-	task := map[string]interface{}{
-		"video_id": videoId,
-		"user_id":  uid,
-		"object":   objectKey,
+// createVideo 视频文件已经放进 video 桶后，创建视频记录并发送处理任务，不是草稿时初始化热度、加入索引并推送到关注流
+// 处理任务在视频和处理记录提交之后才发送，worker 开始处理时记录一定已经存在
+func (uc *videoUseCase) createVideo(ctx context.Context, video *model.Video, objectKey string) (videoUrl string, err error) {
+	videoId, uid := video.VideoID, video.UserID
+
+	// 4. 数据库只保存对象名，返回带签名的播放地址
+	video.VideoURL = objectKey
+	if videoUrl, err = uc.svc.PlaybackURL(ctx, videoId, objectKey); err != nil {
+		return "", fmt.Errorf("generate playback url failed: %w", err)
 	}
-	taskBytes, err := json.Marshal(task)
-	if err != nil {
-		return 0, "", fmt.Errorf("marshal kafka task failed: %w", err)
+
+	// 5. 存入数据库
+	if err = uc.svc.StoreVideo(ctx, video); err != nil {
+		return "", fmt.Errorf("store video meta failed: %w", err)
 	}
-	err = producer.SendMessages([]*sarama.ProducerMessage{
-		{
-			Topic: config.Kafka.Topic,
-			Value: sarama.ByteEncoder(taskBytes),
-		},
-	})
-	if err != nil {
-		return 0, "", fmt.Errorf("send kafka message failed: %w", err)
+
+	// 6. 发送处理任务，视频已经写入，不能再返回错误让调用方回滚上传；发送失败时把视频标记为处理失败
+	if err := uc.sendProcessingTask(video, objectKey); err != nil {
+		logger.Errorf("send processing task of video %d failed: %v", videoId, err)
+		if err = uc.svc.FailProcessing(ctx, videoId, "send processing task failed"); err != nil {
+			logger.Errorf("mark video %d processing failed: %v", videoId, err)
+		}
 	}
 
-	// 7. 存入数据库
-	if err = uc.svc.StoreVideo(ctx, video); err != nil {
-		return 0, "", fmt.Errorf("store video meta failed: %w", err)
+	// 草稿在发布时才初始化统计数据和热度
+	if video.Status == constants.VideoStatusDraft {
+		return videoUrl, nil
 	}
 
-	// 8. 初始化热度值
+	// 7. 初始化热度值
 	createdAt := time.Now()
 	hot := utils.DefaultComputeHotScore(0, 0, createdAt)
 
-	// 9. 写入 video_stats（含热度）
+	// 8. 写入 video_stats（含热度）
 	stat := &model.VideoStat{
 		VideoID:  videoId,
 		Views:    0,
@@ -95,20 +104,69 @@ func (uc *videoUseCase) SubmitVideo(ctx context.Context, video *model.Video, vid
 		Comments: 0,
 		HotScore: hot,
 	}
+	// 视频已经写入，不能再返回错误让调用方回滚上传；没有统计数据时查询按 0 处理
 	if err := uc.svc.StoreVideoStats(ctx, stat); err != nil {
-		return 0, "", fmt.Errorf("store video stats failed: %w", err)
+		logger.Errorf("store stats of video %d failed: %v", videoId, err)
+	}
+
+	if err = uc.svc.RefreshSearchIndex(ctx, videoId); err != nil {
+		logger.Errorf("index video %d failed: %v", videoId, err)
+	}
+	// 不公开、私密和仅粉丝可见的视频不进热榜和搜索联想
+	if uc.svc.IsListed(&model.VideoProfile{Status: video.Status, Visibility: video.Visibility}) {
+		// 🔥 同步热度写入 Redis ZSet 排行榜
+		_ = uc.svc.UpdateHotRank(ctx, videoId, hot)
+		if err = uc.svc.AddTitleSuggestion(ctx, video.Title); err != nil {
+			logger.Errorf("add title of video %d to suggestions failed: %v", videoId, err)
+		}
+	}
+	if uc.svc.InFeed(video.Visibility) {
+		uc.asyncDeliverFeed(uid, videoId, createdAt)
 	}
 
-	// 🔥 同步热度写入 Redis ZSet 排行榜
-	_ = uc.svc.UpdateHotRank(ctx, videoId, hot)
+	return videoUrl, nil
+}
 
-	return videoId, videoUrl, nil
+// sendProcessingTask 把视频处理任务发送到 Kafka
+func (uc *videoUseCase) sendProcessingTask(video *model.Video, objectKey string) error {
+	kafkaCfg, err := kafka.NewProducerConfig()
+	if err != nil {
+		return fmt.Errorf("build kafka config failed: %w", err)
+	}
+	producer, err := sarama.NewSyncProducer([]string{config.Kafka.Broker}, kafkaCfg)
+	if err != nil {
+		return fmt.Errorf("create kafka producer failed: %w", err)
+	}
+	defer producer.Close()
+
+	task := map[string]interface{}{
+		"video_id": video.VideoID,
+		"user_id":  video.UserID,
+		"object":   objectKey,
+	}
+	taskBytes, err := json.Marshal(task)
+	if err != nil {
+		return fmt.Errorf("marshal kafka task failed: %w", err)
+	}
+	err = producer.SendMessages([]*sarama.ProducerMessage{
+		{
+			Topic: config.Kafka.Topic,
+			Value: sarama.ByteEncoder(taskBytes),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("send kafka message failed: %w", err)
+	}
+	return nil
 }
 
 func (uc *videoUseCase) GetVideo(ctx context.Context, videoId int64) (*model.VideoProfile, error) {
 	// 1. 优先从 Redis 获取缓存
 	videoProfile, err := uc.svc.GetVideoRedis(ctx, videoId)
 	if err == nil && videoProfile != nil {
+		if !uc.canView(ctx, videoProfile) {
+			return nil, errno.Errorf(errno.DBNotFound, "video %d not found", videoId)
+		}
 		views, _ := uc.svc.GetViews(ctx, videoId)
 		likes, _ := uc.svc.GetLikes(ctx, videoId) // ✅ 新增：获取点赞数
 		videoProfile.Views = views
@@ -119,7 +177,13 @@ func (uc *videoUseCase) GetVideo(ctx context.Context, videoId int64) (*model.Vid
 			videoProfile.HotScore = dbProfile.HotScore
 		}
 
-		uc.asyncIncrViews(videoId, videoProfile.CreatedAt)
+		if videoProfile.VideoURL, err = uc.svc.PlaybackURL(ctx, videoId, videoProfile.VideoURL); err != nil {
+			return nil, err
+		}
+		if videoProfile.Status != constants.VideoStatusDraft && isReady(videoProfile) {
+			uc.asyncIncrViews(videoId, videoProfile.CreatedAt, uc.svc.IsListed(videoProfile))
+			uc.asyncRecordWatch(ctx, videoId)
+		}
 		return videoProfile, nil
 	}
 
@@ -128,6 +192,9 @@ func (uc *videoUseCase) GetVideo(ctx context.Context, videoId int64) (*model.Vid
 	if err != nil {
 		return nil, err
 	}
+	if !uc.canView(ctx, videoProfile) {
+		return nil, errno.Errorf(errno.DBNotFound, "video %d not found", videoId)
+	}
 	_ = uc.svc.SetVideoRedis(ctx, videoProfile)
 
 	views, _ := uc.svc.GetViews(ctx, videoId)
@@ -135,27 +202,67 @@ func (uc *videoUseCase) GetVideo(ctx context.Context, videoId int64) (*model.Vid
 	videoProfile.Views = views
 	videoProfile.Likes = likes //
 
-	uc.asyncIncrViews(videoId, videoProfile.CreatedAt)
+	if videoProfile.VideoURL, err = uc.svc.PlaybackURL(ctx, videoId, videoProfile.VideoURL); err != nil {
+		return nil, err
+	}
+	if videoProfile.Status != constants.VideoStatusDraft && isReady(videoProfile) {
+		uc.asyncIncrViews(videoId, videoProfile.CreatedAt, uc.svc.IsListed(videoProfile))
+		uc.asyncRecordWatch(ctx, videoId)
+	}
 	return videoProfile, nil
 }
 
-func (uc *videoUseCase) SearchVideo(ctx context.Context, keyword string, tags []string, pageNum int64, pageSize int64) ([]*model.VideoProfile, error) {
-	videoProfile, err := uc.svc.SearchVideo(ctx, keyword, tags, pageNum, pageSize)
+func (uc *videoUseCase) SearchVideo(ctx context.Context, query *model.VideoSearchQuery) (*model.VideoSearchResult, error) {
+	var err error
+	query.Keyword = strings.TrimSpace(query.Keyword)
+	if query.Tags, err = uc.svc.NormalizeTags(query.Tags); err != nil {
+		return nil, err
+	}
+	if query.TagMode, err = uc.svc.NormalizeTagMode(query.TagMode); err != nil {
+		return nil, err
+	}
+	if query.Sort, err = uc.svc.NormalizeSearchSort(query.Sort); err != nil {
+		return nil, err
+	}
+	result, err := uc.svc.SearchVideo(ctx, query)
 	if err != nil {
 		return nil, err
 	}
-	return videoProfile, nil
+	uc.svc.SignPlaybackURLs(ctx, result.Videos)
+	// 只记录有结果的搜索，翻页不重复计数
+	firstPage := query.Cursor == "" && query.PageNum <= 1
+	if query.Keyword != "" && firstPage && result.Total > 0 {
+		if err = uc.svc.RecordSearch(ctx, query.Keyword); err != nil {
+			logger.Errorf("record search keyword %q failed: %v", query.Keyword, err)
+		}
+	}
+	// 登录用户的搜索记入搜索历史，需要查询隐私设置，放到后台执行
+	if query.Keyword != "" && firstPage {
+		if uid, err := uc.svc.GetUserId(ctx); err == nil {
+			uc.asyncRecordSearchHistory(uid, query.Keyword)
+		}
+	}
+	return result, nil
 }
 
-func (uc *videoUseCase) TrendVideo(ctx context.Context, pageNum int64, pageSize int64) ([]*model.VideoProfile, error) {
-	videoProfile, err := uc.svc.TrendVideo(ctx, pageNum, pageSize)
+func (uc *videoUseCase) TrendVideo(ctx context.Context, cursor string, pageNum int64, pageSize int64) ([]*model.VideoProfile, string, error) {
+	videoProfile, nextCursor, err := uc.svc.TrendVideo(ctx, cursor, pageNum, pageSize)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	return videoProfile, nil
+	// 投稿后立即进入热榜，处理完成之前只有作者本人能看到
+	visible := videoProfile[:0]
+	for _, v := range videoProfile {
+		if uc.canView(ctx, v) {
+			visible = append(visible, v)
+		}
+	}
+	uc.svc.SignPlaybackURLs(ctx, visible)
+	return visible, nextCursor, nil
 }
 
-func (uc *videoUseCase) asyncIncrViews(videoId int64, createdAtUnix int64) {
+// asyncIncrViews 后台增加播放量并更新热度，ranked 为 false 的视频不在热榜中，只更新数据库中的热度
+func (uc *videoUseCase) asyncIncrViews(videoId int64, createdAtUnix int64, ranked bool) {
 	go func() {
 		defer func() {
 			if r := recover(); r != nil {
@@ -170,11 +277,59 @@ func (uc *videoUseCase) asyncIncrViews(videoId int64, createdAtUnix int64) {
 		likes := int64(0) // 点赞缓存未实现，暂填0
 		createdAt := time.Unix(createdAtUnix, 0)
 		hot := utils.ComputeHotScore(views, likes, createdAt)
-		_ = uc.svc.UpdateHotRank(context.Background(), videoId, hot)
+		if ranked {
+			_ = uc.svc.UpdateHotRank(context.Background(), videoId, hot)
+		}
 		_ = uc.svc.UpdateHotScore(context.Background(), videoId, hot)
 	}()
 }
 
+// asyncRecordWatch 后台记录登录用户看过的视频，推荐相关视频时排除
+func (uc *videoUseCase) asyncRecordWatch(ctx context.Context, videoId int64) {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		return // 未登录
+	}
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Errorf("panic recovered in async record watch: %v", r)
+			}
+		}()
+		if err := uc.svc.RecordWatch(context.Background(), uid, videoId); err != nil {
+			logger.Errorf("record watch of video %d for user %d failed: %v", videoId, uid, err)
+		}
+	}()
+}
+
+// asyncDeliverFeed 后台把新视频推送到粉丝的关注流，粉丝较多时不影响投稿的响应时间
+func (uc *videoUseCase) asyncDeliverFeed(uid, videoId int64, publishedAt time.Time) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Errorf("panic recovered in async deliver feed: %v", r)
+			}
+		}()
+		if err := uc.svc.DeliverFeed(context.Background(), uid, videoId, publishedAt); err != nil {
+			logger.Errorf("deliver video %d to feeds failed: %v", videoId, err)
+		}
+	}()
+}
+
+// asyncRecordSearchHistory 后台记录搜索历史，不影响搜索的响应时间
+func (uc *videoUseCase) asyncRecordSearchHistory(uid int64, keyword string) {
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				logger.Errorf("panic recovered in async record search history: %v", r)
+			}
+		}()
+		if err := uc.svc.RecordSearchHistory(context.Background(), uid, keyword); err != nil {
+			logger.Errorf("record search history for user %d failed: %v", uid, err)
+		}
+	}()
+}
+
 // UpdateVideoHot 根据播放量/点赞数/创建时间，更新热度
 func (uc *videoUseCase) UpdateVideoHot(ctx context.Context, videoID int64) error {
 	views, _ := uc.svc.GetViews(ctx, videoID)
@@ -184,11 +339,18 @@ func (uc *videoUseCase) UpdateVideoHot(ctx context.Context, videoID int64) error
 	if err != nil {
 		return err
 	}
+	// 草稿不参与热榜
+	if profile.Status == constants.VideoStatusDraft {
+		return nil
+	}
 	createdAt := time.Unix(profile.CreatedAt, 0)
 	hot := utils.ComputeHotScore(views, likes, createdAt)
 
-	if err := uc.svc.UpdateHotRank(ctx, videoID, hot); err != nil {
-		return err
+	// 不公开的视频只更新热度，不进入热榜
+	if uc.svc.IsPublic(profile) {
+		if err := uc.svc.UpdateHotRank(ctx, videoID, hot); err != nil {
+			return err
+		}
 	}
 	if err := uc.svc.UpdateHotScore(ctx, videoID, hot); err != nil {
 		return err
@@ -196,3 +358,18 @@ func (uc *videoUseCase) UpdateVideoHot(ctx context.Context, videoID int64) error
 
 	return nil
 }
+
+// GetVideoObject 返回视频文件的对象名，供网关代理播放，看不到的视频按不存在处理，不计播放量
+func (uc *videoUseCase) GetVideoObject(ctx context.Context, videoId int64) (objectKey string, err error) {
+	videoProfile, err := uc.svc.GetVideoRedis(ctx, videoId)
+	if err != nil || videoProfile == nil {
+		if videoProfile, err = uc.svc.GetVideoDB(ctx, videoId); err != nil {
+			return "", err
+		}
+		_ = uc.svc.SetVideoRedis(ctx, videoProfile)
+	}
+	if !uc.canView(ctx, videoProfile) {
+		return "", errno.Errorf(errno.DBNotFound, "video %d not found", videoId)
+	}
+	return uc.svc.VideoObjectKey(videoProfile), nil
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
     ctx "context"
+    "errors"
     "testing"
     "time"
 
@@ -10,40 +11,98 @@ import (
 
     "github.com/LingeringAutumn/Yijie/app/video/domain/model"
     "github.com/LingeringAutumn/Yijie/app/video/domain/service"
+    "github.com/LingeringAutumn/Yijie/pkg/constants"
 )
 
 func TestVideoUseCase_GetVideo(t *testing.T) {
     defer mockey.UnPatchAll()
 
     videoID := int64(1001)
+    ownerID := int64(1)
+    viewerID := int64(2)
     createdAt := time.Now().Unix()
+    published := func(visibility, processing string) *model.VideoProfile {
+        return &model.VideoProfile{
+            VideoID:          videoID,
+            UserID:           ownerID,
+            VideoURL:         "1001.mp4",
+            CreatedAt:        createdAt,
+            Status:           constants.VideoStatusPublished,
+            Visibility:       visibility,
+            ProcessingStatus: processing,
+        }
+    }
+    draft := published(constants.VideoVisibilityPublic, constants.VideoProcessingStatusReady)
+    draft.Status = constants.VideoStatusDraft
 
     testCases := []struct {
         Name               string
+        Viewer             int64 // 0 表示未登录
         MockGetRedisResult *model.VideoProfile
-        MockGetRedisError  error
+        MockGetDBResult    *model.VideoProfile
+        MockVisible        bool
         MockViews          int64
         MockLikes          int64
-        MockGetDBResult    *model.VideoProfile
-        MockGetDBError     error
         ExpectedHotScore   float64
-        ExpectedErr        error
+        ExpectedFound      bool
+        ExpectedWatched    bool
     }{
         {
-            Name: "CacheHit",
-            MockGetRedisResult: &model.VideoProfile{
-                VideoID:   videoID,
-                CreatedAt: createdAt,
-            },
-            MockGetRedisError: nil,
-            MockViews:         100,
-            MockLikes:         20,
-            MockGetDBResult: &model.VideoProfile{
-                HotScore: 123.45,
-            },
-            MockGetDBError:   nil,
-            ExpectedHotScore: 123.45,
-            ExpectedErr:      nil,
+            Name:               "CacheHit",
+            Viewer:             viewerID,
+            MockGetRedisResult: published(constants.VideoVisibilityPublic, constants.VideoProcessingStatusReady),
+            MockGetDBResult:    &model.VideoProfile{HotScore: 123.45},
+            MockVisible:        true,
+            MockViews:          100,
+            MockLikes:          20,
+            ExpectedHotScore:   123.45,
+            ExpectedFound:      true,
+            ExpectedWatched:    true,
+        },
+        {
+            Name:            "CacheMiss",
+            Viewer:          0,
+            MockGetDBResult: published(constants.VideoVisibilityPublic, ""),
+            MockVisible:     true,
+            MockViews:       7,
+            MockLikes:       3,
+            ExpectedFound:   true,
+            ExpectedWatched: true,
+        },
+        {
+            Name:               "DraftHiddenFromOthers",
+            Viewer:             viewerID,
+            MockGetRedisResult: draft,
+            MockVisible:        true,
+        },
+        {
+            Name:               "NotReadyHiddenFromOthers",
+            Viewer:             viewerID,
+            MockGetRedisResult: published(constants.VideoVisibilityPublic, constants.VideoProcessingStatusProcessing),
+            MockVisible:        true,
+        },
+        {
+            Name:               "PrivateHiddenFromOthers",
+            Viewer:             viewerID,
+            MockGetRedisResult: published(constants.VideoVisibilityPrivate, constants.VideoProcessingStatusReady),
+            MockVisible:        false,
+        },
+        {
+            Name:               "OwnerCanSeePrivate",
+            Viewer:             ownerID,
+            MockGetRedisResult: published(constants.VideoVisibilityPrivate, constants.VideoProcessingStatusReady),
+            MockGetDBResult:    &model.VideoProfile{HotScore: 1},
+            ExpectedHotScore:   1,
+            ExpectedFound:      true,
+            ExpectedWatched:    true,
+        },
+        {
+            Name:               "OwnerCanSeeDraft",
+            Viewer:             ownerID,
+            MockGetRedisResult: draft,
+            MockGetDBResult:    &model.VideoProfile{},
+            ExpectedFound:      true,
+            ExpectedWatched:    false, // 草稿不计播放
         },
     }
 
@@ -52,21 +111,44 @@ func TestVideoUseCase_GetVideo(t *testing.T) {
             mockSvc := new(service.VideoService)
             uc := &videoUseCase{svc: mockSvc}
 
-            mockey.Mock((*service.VideoService).GetVideoRedis).Return(tc.MockGetRedisResult, tc.MockGetRedisError).Build()
+            var redisErr error
+            if tc.MockGetRedisResult == nil {
+                redisErr = errors.New("cache miss")
+            }
+            var uidErr error
+            if tc.Viewer == 0 {
+                uidErr = errors.New("not logged in")
+            }
+            mockey.Mock((*service.VideoService).GetUserId).Return(tc.Viewer, uidErr).Build()
+            mockey.Mock((*service.VideoService).GetVideoRedis).Return(tc.MockGetRedisResult, redisErr).Build()
+            mockey.Mock((*service.VideoService).SetVideoRedis).Return(nil).Build()
+            mockey.Mock((*service.VideoService).GetVideoDB).Return(tc.MockGetDBResult, nil).Build()
             mockey.Mock((*service.VideoService).GetViews).Return(tc.MockViews, nil).Build()
             mockey.Mock((*service.VideoService).GetLikes).Return(tc.MockLikes, nil).Build()
-            mockey.Mock((*service.VideoService).GetVideoDB).Return(tc.MockGetDBResult, tc.MockGetDBError).Build()
+            mockey.Mock((*service.VideoService).CheckVisibility).Return(tc.MockVisible, nil).Build()
+            mockey.Mock((*service.VideoService).PlaybackURL).Return("https://minio/1001.mp4?sig", nil).Build()
+            incrViews := mockey.Mock((*videoUseCase).asyncIncrViews).To(func(_ *videoUseCase, _, _ int64, _ bool) {}).Build()
+            recordWatch := mockey.Mock((*videoUseCase).asyncRecordWatch).To(func(_ *videoUseCase, _ ctx.Context, _ int64) {}).Build()
 
             profile, err := uc.GetVideo(ctx.Background(), videoID)
-            if tc.ExpectedErr != nil {
-                convey.So(err.Error(), convey.ShouldEqual, tc.ExpectedErr.Error())
-            } else {
-                convey.So(err, convey.ShouldBeNil)
+            if !tc.ExpectedFound {
+                convey.So(err, convey.ShouldNotBeNil)
+                convey.So(profile, convey.ShouldBeNil)
+                convey.So(recordWatch.Times(), convey.ShouldEqual, 0)
+                return
             }
+            convey.So(err, convey.ShouldBeNil)
             convey.So(profile.VideoID, convey.ShouldEqual, videoID)
+            convey.So(profile.VideoURL, convey.ShouldEqual, "https://minio/1001.mp4?sig")
             convey.So(profile.Views, convey.ShouldEqual, tc.MockViews)
             convey.So(profile.Likes, convey.ShouldEqual, tc.MockLikes)
             convey.So(profile.HotScore, convey.ShouldEqual, tc.ExpectedHotScore)
+            watched := 0
+            if tc.ExpectedWatched {
+                watched = 1
+            }
+            convey.So(incrViews.Times(), convey.ShouldEqual, watched)
+            convey.So(recordWatch.Times(), convey.ShouldEqual, watched)
         })
     }
 }
@@ -0,0 +1,17 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+)
+
+// SuggestSearch 搜索联想
+func (uc *videoUseCase) SuggestSearch(ctx context.Context, prefix string, limit int64) ([]string, error) {
+	return uc.svc.SuggestSearch(ctx, prefix, int(limit))
+}
+
+// TrendingSearches 获取热搜榜
+func (uc *videoUseCase) TrendingSearches(ctx context.Context, limit int64) ([]*model.SearchTrend, error) {
+	return uc.svc.TrendingSearches(ctx, int(limit))
+}
@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// ListVideosByTag 按标签浏览已发布视频
+func (uc *videoUseCase) ListVideosByTag(ctx context.Context, tag string, pageNum int64, pageSize int64) ([]*model.VideoProfile, error) {
+	tags, err := uc.svc.NormalizeTags([]string{tag})
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return nil, errno.ParamVerifyError.WithMessage("tag should not be empty")
+	}
+	videos, err := uc.svc.ListVideosByTag(ctx, tags[0], pageNum, pageSize)
+	if err != nil {
+		return nil, err
+	}
+	uc.svc.SignPlaybackURLs(ctx, videos)
+	return videos, nil
+}
+
+// PopularTags 获取热门标签
+func (uc *videoUseCase) PopularTags(ctx context.Context, limit int64) ([]*model.TagCount, error) {
+	return uc.svc.PopularTags(ctx, int(limit))
+}
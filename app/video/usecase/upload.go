@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// InitVideoUpload 校验视频信息并发起分片上传
+func (uc *videoUseCase) InitVideoUpload(ctx context.Context, video *model.Video, fileSize int64) (*model.UploadProgress, error) {
+	if err := uc.prepareUpload(ctx, video); err != nil {
+		return nil, err
+	}
+	session, err := uc.svc.InitUpload(ctx, video.UserID, video, fileSize)
+	if err != nil {
+		return nil, err
+	}
+	return &model.UploadProgress{Session: session}, nil
+}
+
+// InitDirectUpload 校验视频信息并生成直传表单
+func (uc *videoUseCase) InitDirectUpload(ctx context.Context, video *model.Video, fileSize int64) (*model.UploadSession, *model.PresignedUpload, error) {
+	if err := uc.prepareUpload(ctx, video); err != nil {
+		return nil, nil, err
+	}
+	return uc.svc.InitDirectUpload(ctx, video.UserID, video, fileSize)
+}
+
+// UploadVideoChunk 上传一个分片
+func (uc *videoUseCase) UploadVideoChunk(ctx context.Context, uploadID, partNumber int64, checksum string, data []byte) error {
+	session, err := uc.uploadSession(ctx, uploadID)
+	if err != nil {
+		return err
+	}
+	return uc.svc.UploadChunk(ctx, session, partNumber, checksum, data)
+}
+
+// GetVideoUpload 获取上传进度，客户端断线后据此续传
+func (uc *videoUseCase) GetVideoUpload(ctx context.Context, uploadID int64) (*model.UploadProgress, error) {
+	session, err := uc.uploadSession(ctx, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.svc.GetUploadProgress(ctx, session)
+}
+
+// CompleteVideoUpload 合并分片，文件校验通过后创建视频
+func (uc *videoUseCase) CompleteVideoUpload(ctx context.Context, uploadID int64) (videoId int64, videoUrl string, err error) {
+	return uc.completeUpload(ctx, uploadID, false)
+}
+
+// CompleteDirectUpload 确认文件已经直传到对象存储，校验通过后创建视频
+func (uc *videoUseCase) CompleteDirectUpload(ctx context.Context, uploadID int64) (videoId int64, videoUrl string, err error) {
+	return uc.completeUpload(ctx, uploadID, true)
+}
+
+// AbortVideoUpload 取消分片上传
+func (uc *videoUseCase) AbortVideoUpload(ctx context.Context, uploadID int64) error {
+	session, err := uc.uploadSession(ctx, uploadID)
+	if err != nil {
+		return err
+	}
+	return uc.svc.AbortUpload(ctx, session)
+}
+
+func (uc *videoUseCase) uploadSession(ctx context.Context, uploadID int64) (*model.UploadSession, error) {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get user id failed: %w", err)
+	}
+	return uc.svc.GetUploadSession(ctx, uploadID, uid)
+}
+
+// prepareUpload 在发起上传前校验视频信息，文件上传完成后才会创建视频
+func (uc *videoUseCase) prepareUpload(ctx context.Context, video *model.Video) error {
+	uid, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		return fmt.Errorf("get user id failed: %w", err)
+	}
+	video.UserID = uid
+	uc.svc.PrepareSubmission(video)
+	video.Tags, err = uc.svc.NormalizeTags(video.Tags)
+	return err
+}
+
+func (uc *videoUseCase) completeUpload(ctx context.Context, uploadID int64, direct bool) (videoId int64, videoUrl string, err error) {
+	session, err := uc.uploadSession(ctx, uploadID)
+	if err != nil {
+		return 0, "", err
+	}
+	if session.Direct != direct {
+		return 0, "", errno.ParamVerifyError.WithMessage(fmt.Sprintf("upload %d is not a %s upload", uploadID, uploadKind(direct)))
+	}
+	video, objectKey, err := uc.svc.CompleteUpload(ctx, session)
+	if err != nil {
+		return 0, "", err
+	}
+	if videoUrl, err = uc.createVideo(ctx, video, objectKey); err != nil {
+		uc.svc.RollbackUpload(ctx, session, objectKey)
+		return 0, "", err
+	}
+	uc.svc.FinishUpload(ctx, session)
+	return video.VideoID, videoUrl, nil
+}
+
+func uploadKind(direct bool) string {
+	if direct {
+		return "direct"
+	}
+	return "chunked"
+}
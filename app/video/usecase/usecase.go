@@ -12,9 +12,32 @@ import (
 type VideoUseCase interface {
 	SubmitVideo(ctx context.Context, video *model.Video, videoData []byte) (videoId int64, videoUrl string, err error)
 	GetVideo(ctx context.Context, videoId int64) (*model.VideoProfile, error)
-	SearchVideo(ctx context.Context, keyword string, tags []string, pageNum int64, pageSize int64) ([]*model.VideoProfile, error)
-	TrendVideo(ctx context.Context, pageNum int64, pageSize int64) ([]*model.VideoProfile, error)
+	SearchVideo(ctx context.Context, query *model.VideoSearchQuery) (*model.VideoSearchResult, error)
+	TrendVideo(ctx context.Context, cursor string, pageNum int64, pageSize int64) (videos []*model.VideoProfile, nextCursor string, err error)
 	UpdateVideoHot(ctx context.Context, videoID int64) error
+	UpdateVideo(ctx context.Context, update *model.VideoUpdate) (*model.VideoProfile, error)
+	DeleteVideo(ctx context.Context, videoID int64) error
+	ListDrafts(ctx context.Context, pageNum int64, pageSize int64) ([]*model.VideoProfile, error)
+	ScheduleVideo(ctx context.Context, videoID int64, publishAt int64) (*model.VideoProfile, error)
+	ListVideosByTag(ctx context.Context, tag string, pageNum int64, pageSize int64) ([]*model.VideoProfile, error)
+	PopularTags(ctx context.Context, limit int64) ([]*model.TagCount, error)
+	SuggestSearch(ctx context.Context, prefix string, limit int64) ([]string, error)
+	TrendingSearches(ctx context.Context, limit int64) ([]*model.SearchTrend, error)
+	ListSearchHistory(ctx context.Context) ([]string, error)
+	DeleteSearchHistoryItem(ctx context.Context, keyword string) error
+	ClearSearchHistory(ctx context.Context) error
+	GetVideoProcessingStatus(ctx context.Context, videoID int64) (*model.VideoProcessing, error)
+	InitVideoUpload(ctx context.Context, video *model.Video, fileSize int64) (*model.UploadProgress, error)
+	UploadVideoChunk(ctx context.Context, uploadID, partNumber int64, checksum string, data []byte) error
+	GetVideoUpload(ctx context.Context, uploadID int64) (*model.UploadProgress, error)
+	CompleteVideoUpload(ctx context.Context, uploadID int64) (videoId int64, videoUrl string, err error)
+	AbortVideoUpload(ctx context.Context, uploadID int64) error
+	InitDirectUpload(ctx context.Context, video *model.Video, fileSize int64) (*model.UploadSession, *model.PresignedUpload, error)
+	CompleteDirectUpload(ctx context.Context, uploadID int64) (videoId int64, videoUrl string, err error)
+	GetVideoObject(ctx context.Context, videoId int64) (objectKey string, err error)
+	ListUserVideos(ctx context.Context, userID int64, cursor string, limit int64, sort string) (videos []*model.VideoProfile, nextCursor string, err error)
+	GetFeed(ctx context.Context, cursor string, limit int64) (videos []*model.VideoProfile, nextCursor string, err error)
+	RelatedVideos(ctx context.Context, videoID int64, limit int64) ([]*model.VideoProfile, error)
 }
 
 type videoUseCase struct {
@@ -0,0 +1,21 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/LingeringAutumn/Yijie/app/video/domain/model"
+)
+
+// ListUserVideos 按游标浏览作者的视频，未登录时按游客处理
+func (uc *videoUseCase) ListUserVideos(ctx context.Context, userID int64, cursor string, limit int64, sort string) ([]*model.VideoProfile, string, error) {
+	viewer, err := uc.svc.GetUserId(ctx)
+	if err != nil {
+		viewer = 0
+	}
+	videos, nextCursor, err := uc.svc.ListUserVideos(ctx, viewer, userID, cursor, limit, sort)
+	if err != nil {
+		return nil, "", err
+	}
+	uc.svc.SignPlaybackURLs(ctx, videos)
+	return videos, nextCursor, nil
+}
@@ -5,8 +5,10 @@ import (
 
 	"github.com/LingeringAutumn/Yijie/pkg/utils"
 
+	"github.com/LingeringAutumn/Yijie/app/gateway/captcha"
 	"github.com/LingeringAutumn/Yijie/app/gateway/router"
 	"github.com/LingeringAutumn/Yijie/app/gateway/rpc"
+	"github.com/LingeringAutumn/Yijie/app/gateway/stream"
 	"github.com/LingeringAutumn/Yijie/config"
 	"github.com/LingeringAutumn/Yijie/pkg/constants"
 	"github.com/LingeringAutumn/Yijie/pkg/logger"
@@ -23,6 +25,13 @@ func init() {
 	logger.Init(serviceName, config.GetLoggerLevel())
 	// 初始化 RPC 相关配置
 	rpc.Init()
+	// 初始化图形验证码使用的 Redis
+	captcha.Init()
+	// 初始化代理播放使用的 MinIO 和统计播放流量使用的 Redis
+	if err := utils.InitMinioClient(config.Minio.Endpoint, config.Minio.AccessKey, config.Minio.SecretKey); err != nil {
+		logger.Fatalf("Gateway: new minio client failed, err: %v", err)
+	}
+	stream.Init()
 }
 
 func main() {
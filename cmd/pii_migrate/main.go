@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"flag"
+
+	"github.com/LingeringAutumn/Yijie/app/user/infrastructure/mysql"
+	"github.com/LingeringAutumn/Yijie/config"
+	"github.com/LingeringAutumn/Yijie/pkg/base/client"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+	"github.com/LingeringAutumn/Yijie/pkg/utils"
+)
+
+// pii_migrate 是一次性的迁移命令: 升级 users 表结构，并把存量的明文邮箱、手机号加密
+// 主密钥轮换之后也可以再跑一次，把旧版本密钥加密的数据改用新密钥
+// 命令可以重复执行，已经是最新密文的行会被跳过
+var serviceName = constants.UserServiceName
+
+var batchSize = flag.Int("batch", constants.PIIMigrateBatchSize, "number of users processed per batch")
+
+func init() {
+	config.Init(serviceName)
+	logger.Init(serviceName, config.GetLoggerLevel())
+}
+
+func main() {
+	flag.Parse()
+	ctx := context.Background()
+
+	gormDB, err := client.InitMySQL()
+	if err != nil {
+		logger.Fatalf("PII migrate: init mysql failed, err: %v", err)
+	}
+	piiCipher, err := utils.NewPIICipher()
+	if err != nil {
+		logger.Fatalf("PII migrate: init pii cipher failed, err: %v", err)
+	}
+
+	migrator := mysql.NewPIIMigrator(gormDB, piiCipher)
+	if err = migrator.EnsureSchema(ctx); err != nil {
+		logger.Fatalf("PII migrate: upgrade schema failed, err: %v", err)
+	}
+	migrated, err := migrator.Run(ctx, *batchSize)
+	if err != nil {
+		logger.Fatalf("PII migrate: migrated %d users before failure, err: %v", migrated, err)
+	}
+	logger.Infof("PII migrate: done, %d users migrated", migrated)
+}
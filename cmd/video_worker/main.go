@@ -0,0 +1,70 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/IBM/sarama"
+
+	"github.com/LingeringAutumn/Yijie/app/video"
+	"github.com/LingeringAutumn/Yijie/app/video/infrastructure/kafka"
+	"github.com/LingeringAutumn/Yijie/config"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/logger"
+	"github.com/LingeringAutumn/Yijie/pkg/utils"
+)
+
+// video_worker 消费投稿时发送到 Kafka 的处理任务：从 MinIO 下载原始视频，探测、校验、转码后把各清晰度上传回 MinIO
+// 可以启动多个进程，同一个消费者组内的进程分摊各个分区
+var serviceName = constants.VideoWorkerServiceName
+
+func init() {
+	config.Init(serviceName)
+	logger.Init(serviceName, config.GetLoggerLevel())
+}
+
+func main() {
+	logger.Infof("starting video worker")
+	err := utils.InitMinioClient(config.Minio.Endpoint, config.Minio.AccessKey, config.Minio.SecretKey)
+	if err != nil {
+		logger.Fatalf("VideoWorker: new minio client failed, err: %v", err)
+	}
+	handler := video.InjectUploadTaskHandler()
+
+	kafkaCfg, err := kafka.NewConsumerConfig()
+	if err != nil {
+		logger.Fatalf("VideoWorker: build kafka config failed, err: %v", err)
+	}
+	group, err := sarama.NewConsumerGroup([]string{config.Kafka.Broker}, config.Kafka.ConsumerGroup, kafkaCfg)
+	if err != nil {
+		logger.Fatalf("VideoWorker: create consumer group failed, err: %v", err)
+	}
+	defer group.Close()
+
+	go func() {
+		for err := range group.Errors() {
+			logger.Errorf("VideoWorker: consumer group error: %v", err)
+		}
+	}()
+
+	// 收到退出信号后取消 ctx，正在处理的视频不会被标记为失败，消息也不会提交
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	logger.Infof("VideoWorker: consuming topic %s as group %s", config.Kafka.Topic, config.Kafka.ConsumerGroup)
+	// 每次重平衡后 Consume 都会返回，需要循环调用
+	for ctx.Err() == nil {
+		err = group.Consume(ctx, []string{config.Kafka.Topic}, handler)
+		if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+			break
+		}
+		if err != nil {
+			logger.Errorf("VideoWorker: consume failed, err: %v", err)
+			time.Sleep(constants.VideoProcessingRetryBackoff)
+		}
+	}
+	logger.Infof("VideoWorker: exiting")
+}
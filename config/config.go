@@ -3,6 +3,9 @@ package config
 import (
 	"errors"
 	"os"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/bytedance/gopkg/util/logger"
 
@@ -22,7 +25,14 @@ var (
 	Redis        *redis
 	Kafka        *kafka
 	Minio        *minio
+	Invite       *invite
+	PII          *pii
+	Search       *search
+	VideoWorker  *videoWorker
 	runtimeViper = viper.New()
+
+	// inviteLock 保护 Invite，它会在配置变更时被后台协程替换
+	inviteLock sync.RWMutex
 )
 
 const (
@@ -65,6 +75,41 @@ func Init(service string) {
 		configMapping(service) // 重新映射配置
 	})
 	runtimeViper.WatchConfig()
+
+	// etcd 中的配置不会触发 OnConfigChange，只能定时拉取
+	go watchRemoteConfig(service)
+}
+
+// watchRemoteConfig 定时从 etcd 拉取配置并重新映射
+func watchRemoteConfig(service string) {
+	ticker := time.NewTicker(constants.ConfigWatchInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := runtimeViper.WatchRemoteConfig(); err != nil {
+			logger.Errorf("config.watchRemoteConfig: watch remote config error: %v", err)
+			continue
+		}
+		// 邀请码配置先单独替换，configMapping 会覆盖 Invite，这样才能记录 required 的变化
+		if err := reloadInvite(runtimeViper); err != nil {
+			logger.Errorf("config.watchRemoteConfig: reload invite config error: %v", err)
+		}
+		configMapping(service)
+	}
+}
+
+// reloadInvite 用 v 中的配置替换 Invite
+func reloadInvite(v *viper.Viper) error {
+	c := new(invite)
+	if err := v.UnmarshalKey("invite", c); err != nil {
+		return err
+	}
+	inviteLock.Lock()
+	defer inviteLock.Unlock()
+	if Invite != nil && Invite.Required != c.Required {
+		logger.Infof("config: invite required changed to %v", c.Required)
+	}
+	Invite = c
+	return nil
 }
 
 // configMapping 用于将配置映射到全局变量
@@ -74,15 +119,37 @@ func configMapping(srv string) {
 		// 由于这个函数会在配置重载时被再次触发，所以需要判断日志记录方式
 		logger.Fatalf("config.configMapping: config: unmarshal error: %v", err)
 	}
+	loadSecrets(c)
 	Snowflake = &c.Snowflake
 	Server = &c.Server
 	Mysql = &c.MySQL
 	Redis = &c.Redis
 	Kafka = &c.Kafka
 	Minio = &c.Minio
+	inviteLock.Lock()
+	Invite = &c.Invite
+	inviteLock.Unlock()
+	PII = &c.PII
+	Search = &c.Search
+	VideoWorker = &c.VideoWorker
 	Service = getService(srv)
 }
 
+// loadSecrets 用环境变量中的密钥覆盖配置，环境变量为空时保留 etcd 中的值
+func loadSecrets(c *config) {
+	for i := range c.PII.MasterKeys {
+		if key := os.Getenv(constants.PIIMasterKeyEnvPrefix + strconv.Itoa(c.PII.MasterKeys[i].Version)); key != "" {
+			c.PII.MasterKeys[i].Key = key
+		}
+	}
+	if key := os.Getenv(constants.PIIBlindIndexKeyEnv); key != "" {
+		c.PII.BlindIndexKey = key
+	}
+	if key := os.Getenv(constants.SearchCursorKeyEnv); key != "" {
+		c.Search.CursorKey = key
+	}
+}
+
 func getService(name string) *service {
 	addrList := runtimeViper.GetStringSlice("services." + name + ".addr")
 
@@ -107,3 +174,86 @@ func GetDataCenterID() int64 {
 	}
 	return Snowflake.DatacenterID
 }
+
+// IsInviteRequired 返回注册时是否需要邀请码，配置未加载时默认不需要
+func IsInviteRequired() bool {
+	inviteLock.RLock()
+	defer inviteLock.RUnlock()
+	if Invite == nil {
+		return false
+	}
+	return Invite.Required
+}
+
+// IsInviteAdmin 判断用户是否有权限批量生成邀请码
+func IsInviteAdmin(uid int64) bool {
+	inviteLock.RLock()
+	defer inviteLock.RUnlock()
+	if Invite == nil {
+		return false
+	}
+	for _, id := range Invite.AdminUids {
+		if id == uid {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSearchEngine 返回视频搜索使用的引擎，未配置时使用 MySQL
+func GetSearchEngine() string {
+	if Search == nil || Search.Engine == "" {
+		return constants.SearchEngineMySQL
+	}
+	return Search.Engine
+}
+
+func GetSearchSnapshotDir() string {
+	if Search == nil || Search.SnapshotDir == "" {
+		return constants.DefaultSearchSnapshotDir
+	}
+	return Search.SnapshotDir
+}
+
+func GetSearchSnapshotInterval() time.Duration {
+	if Search == nil || Search.SnapshotInterval <= 0 {
+		return constants.DefaultSearchSnapshotInterval
+	}
+	return Search.SnapshotInterval
+}
+
+func GetPlaybackURLTTL() time.Duration {
+	if Minio == nil || Minio.PlaybackURLTTL <= 0 {
+		return constants.DefaultVideoPlaybackURLTTL
+	}
+	return Minio.PlaybackURLTTL
+}
+
+func GetFFmpegPath() string {
+	if VideoWorker == nil || VideoWorker.FFmpegPath == "" {
+		return constants.DefaultFFmpegPath
+	}
+	return VideoWorker.FFmpegPath
+}
+
+func GetFFprobePath() string {
+	if VideoWorker == nil || VideoWorker.FFprobePath == "" {
+		return constants.DefaultFFprobePath
+	}
+	return VideoWorker.FFprobePath
+}
+
+// GetVideoWorkDir 返回视频处理的临时目录，未配置时使用系统临时目录
+func GetVideoWorkDir() string {
+	if VideoWorker == nil || VideoWorker.WorkDir == "" {
+		return os.TempDir()
+	}
+	return VideoWorker.WorkDir
+}
+
+func GetVideoWorkerConcurrency() int {
+	if VideoWorker == nil || VideoWorker.Concurrency <= 0 {
+		return constants.DefaultVideoWorkerConcurrency
+	}
+	return VideoWorker.Concurrency
+}
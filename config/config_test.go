@@ -0,0 +1,51 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+	"github.com/spf13/viper"
+)
+
+func TestReloadInvite(t *testing.T) {
+	convey.Convey("reloadInvite", t, func() {
+		v := viper.New()
+		v.SetConfigType("yaml")
+		load := func(yaml string) {
+			convey.So(v.ReadConfig(strings.NewReader(yaml)), convey.ShouldBeNil)
+			convey.So(reloadInvite(v), convey.ShouldBeNil)
+		}
+
+		load("invite:\n  required: false\n  admin-uids: [1]\n")
+		convey.So(IsInviteRequired(), convey.ShouldBeFalse)
+		convey.So(IsInviteAdmin(1), convey.ShouldBeTrue)
+
+		convey.Convey("toggling required takes effect without restart", func() {
+			load("invite:\n  required: true\n  admin-uids: [2]\n")
+			convey.So(IsInviteRequired(), convey.ShouldBeTrue)
+			convey.So(IsInviteAdmin(1), convey.ShouldBeFalse)
+			convey.So(IsInviteAdmin(2), convey.ShouldBeTrue)
+
+			load("invite:\n  required: false\n")
+			convey.So(IsInviteRequired(), convey.ShouldBeFalse)
+		})
+	})
+}
+
+func TestLoadSecrets(t *testing.T) {
+	convey.Convey("loadSecrets", t, func() {
+		c := &config{
+			PII:    pii{MasterKeys: []piiKey{{Version: 1}, {Version: 2, Key: "from-etcd"}}, BlindIndexKey: "from-etcd"},
+			Search: search{CursorKey: "from-etcd"},
+		}
+		t.Setenv("PII_MASTER_KEY_V1", "env-v1")
+		t.Setenv("SEARCH_CURSOR_KEY", "env-cursor")
+		loadSecrets(c)
+
+		convey.So(c.PII.MasterKeys[0].Key, convey.ShouldEqual, "env-v1")
+		convey.So(c.PII.MasterKeys[1].Key, convey.ShouldEqual, "from-etcd")
+		convey.So(c.PII.BlindIndexKey, convey.ShouldEqual, "from-etcd")
+		convey.So(c.Search.CursorKey, convey.ShouldEqual, "env-cursor")
+	})
+}
@@ -2,8 +2,10 @@
 CREATE TABLE users (
                        id BIGINT PRIMARY KEY AUTO_INCREMENT COMMENT '用户ID，主键，自增',
                        username VARCHAR(255) UNIQUE NOT NULL COMMENT '用户名，最多 10 个中文字符或 30 个英文字符',
-                       email VARCHAR(255) UNIQUE NOT NULL COMMENT '用户邮箱，唯一，最大长度 100',
-                       phone VARCHAR(255) UNIQUE COMMENT '手机号，唯一，11 位数字，可为空',
+                       email VARCHAR(512) NOT NULL COMMENT '用户邮箱密文，信封加密存储',
+                       email_bidx CHAR(64) UNIQUE COMMENT '邮箱盲索引(HMAC-SHA256)，用于唯一性校验和按邮箱查询',
+                       phone VARCHAR(512) COMMENT '手机号密文，信封加密存储，可为空',
+                       phone_bidx CHAR(64) UNIQUE COMMENT '手机号盲索引(HMAC-SHA256)，没有手机号时为 NULL',
                        password_hash VARCHAR(255) NOT NULL COMMENT '密码哈希值，存储加密后的密码',
                        avatar_url TEXT COMMENT '用户头像 URL，可为空',
                        bio TEXT COMMENT '个人简介，可为空',
@@ -67,12 +69,71 @@ CREATE TABLE user_activity_logs (
                                     user_id BIGINT NOT NULL COMMENT '用户ID，关联 users 表',
                                     action VARCHAR(255) NOT NULL COMMENT '操作类型，如登录、发布帖子、点赞',
                                     target_id BIGINT UNSIGNED COMMENT '操作目标ID，如帖子ID、评论ID，可为空',
-                                    target_type ENUM('post', 'comment', 'reaction', 'message', 'other') NOT NULL COMMENT '目标类型',
+                                    target_type ENUM('post', 'comment', 'reaction', 'message', 'security', 'other') NOT NULL COMMENT '目标类型，安全事件为 security',
+                                    ip VARCHAR(64) COMMENT '操作来源 IP，可为空',
+                                    user_agent VARCHAR(512) COMMENT '操作来源 User-Agent，可为空',
+                                    is_new_device BOOLEAN NOT NULL DEFAULT FALSE COMMENT '是否来自从未见过的设备/IP 组合',
                                     created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '操作时间',
                                     FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
-                                    INDEX idx_target_id (target_id)
+                                    INDEX idx_target_id (target_id),
+                                    INDEX idx_logs_user_type_created (user_id, target_type, created_at)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户活动日志表';
 
+-- 用户登录设备表，记录见过的设备/IP 组合，用于识别新设备登录
+CREATE TABLE user_login_devices (
+                                    id BIGINT PRIMARY KEY AUTO_INCREMENT COMMENT '记录ID，主键，自增',
+                                    user_id BIGINT NOT NULL COMMENT '用户ID，关联 users 表',
+                                    device_hash CHAR(64) NOT NULL COMMENT '设备指纹，sha256(User-Agent + IP)',
+                                    ip VARCHAR(64) COMMENT '首次出现时的 IP',
+                                    user_agent VARCHAR(512) COMMENT '首次出现时的 User-Agent',
+                                    first_seen_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '首次登录时间',
+                                    last_seen_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '最近一次登录时间',
+                                    FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
+                                    UNIQUE KEY uniq_user_device (user_id, device_hash)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户登录设备表';
+
+-- 邀请码表，管理员批量生成或用户使用自己的邀请额度生成
+CREATE TABLE invite_codes (
+                              id BIGINT PRIMARY KEY AUTO_INCREMENT COMMENT '邀请码ID，主键，自增',
+                              code VARCHAR(32) NOT NULL COMMENT '邀请码',
+                              creator_id BIGINT NOT NULL COMMENT '生成者用户ID，关联 users 表',
+                              batch_id BIGINT NOT NULL DEFAULT 0 COMMENT '批次ID，同一次批量生成的邀请码相同，用户自己生成的为 0',
+                              max_uses INT UNSIGNED NOT NULL DEFAULT 1 COMMENT '最大可用次数',
+                              used_count INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '已使用次数',
+                              expires_at TIMESTAMP NULL COMMENT '过期时间，为 NULL 表示永不过期',
+                              created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                              FOREIGN KEY (creator_id) REFERENCES users(id) ON DELETE CASCADE,
+                              UNIQUE KEY uniq_invite_code (code),
+                              INDEX idx_invite_codes_creator (creator_id),
+                              INDEX idx_invite_codes_batch (batch_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='邀请码表';
+
+-- 邀请关系表，记录谁邀请了谁
+CREATE TABLE invite_records (
+                                id BIGINT PRIMARY KEY AUTO_INCREMENT COMMENT '记录ID，主键，自增',
+                                code_id BIGINT NOT NULL COMMENT '使用的邀请码ID，关联 invite_codes 表',
+                                inviter_id BIGINT NOT NULL COMMENT '邀请人用户ID',
+                                invitee_id BIGINT NOT NULL COMMENT '被邀请人用户ID',
+                                created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '注册时间',
+                                FOREIGN KEY (code_id) REFERENCES invite_codes(id) ON DELETE CASCADE,
+                                FOREIGN KEY (inviter_id) REFERENCES users(id) ON DELETE CASCADE,
+                                FOREIGN KEY (invitee_id) REFERENCES users(id) ON DELETE CASCADE,
+                                UNIQUE KEY uniq_invitee (invitee_id),
+                                INDEX idx_invite_records_inviter (inviter_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='邀请关系表';
+
+-- 用户隐私设置表，没有记录时使用默认设置（全部公开）
+CREATE TABLE user_privacy_settings (
+                                       user_id BIGINT PRIMARY KEY COMMENT '用户ID，关联 users 表',
+                                       profile_visibility ENUM('public', 'followers', 'private') NOT NULL DEFAULT 'public' COMMENT '个人资料可见范围',
+                                       hide_liked_videos BOOLEAN NOT NULL DEFAULT FALSE COMMENT '是否对他人隐藏点赞过的视频',
+                                       who_can_message ENUM('everyone', 'followers', 'nobody') NOT NULL DEFAULT 'everyone' COMMENT '谁可以给我发私信',
+                                       who_can_follow ENUM('everyone', 'nobody') NOT NULL DEFAULT 'everyone' COMMENT '谁可以关注我',
+                                       disable_search_history BOOLEAN NOT NULL DEFAULT FALSE COMMENT '不记录搜索历史',
+                                       updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '最后更新时间',
+                                       FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户隐私设置表';
+
 -- 索引优化
 CREATE INDEX idx_logs_user ON user_activity_logs(user_id);
 CREATE INDEX idx_relationships_user ON relationships(user_id, target_id);
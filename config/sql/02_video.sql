@@ -5,17 +5,21 @@ CREATE TABLE videos (
                         title VARCHAR(255) NOT NULL COMMENT '视频标题',
                         description TEXT COMMENT '视频描述，可为空',
                         cover_url VARCHAR(255) COMMENT '封面图地址，可为空',
-                        video_url VARCHAR(255) NOT NULL COMMENT '视频文件URL',
+                        video_url VARCHAR(255) NOT NULL COMMENT '视频文件在 video 桶中的对象名，播放地址按请求签名生成',
                         duration_seconds INT UNSIGNED COMMENT '视频时长（单位：秒）',
                         status ENUM('published', 'deleted', 'draft') DEFAULT 'published' COMMENT '视频状态',
+                        visibility ENUM('public', 'unlisted', 'private', 'followers') NOT NULL DEFAULT 'public' COMMENT '可见范围：公开/不公开(凭链接观看)/仅自己/仅粉丝，只有公开视频进入热榜和搜索',
+                        publish_at TIMESTAMP NULL COMMENT '草稿的定时发布时间，为 NULL 表示未设置',
                         created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '发布时间',
                         updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
                         deleted_at TIMESTAMP NULL COMMENT '逻辑删除时间',
                         FOREIGN KEY (user_id) REFERENCES users(id) ON DELETE CASCADE,
                         INDEX idx_user_id (user_id),
                         INDEX idx_status (status),
+                        INDEX idx_status_publish_at (status, publish_at),
                         INDEX idx_created_at (created_at),
-                        FULLTEXT INDEX idx_title_description (title, description)
+                        INDEX idx_updated_at (updated_at),
+                        FULLTEXT INDEX idx_title_description (title, description) WITH PARSER ngram -- ngram 分词以支持中文
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='视频内容表';
 
 -- 视频统计表，记录播放量、点赞、评论等
@@ -43,3 +47,46 @@ CREATE TABLE video_tags (
                             UNIQUE KEY uniq_video_tag (video_id, tag)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='视频标签表';
 
+
+-- 视频处理状态表，记录上传后转码任务的进度，没有记录的视频是处理流程上线之前上传的，视为已就绪
+CREATE TABLE video_processing (
+                                  video_id BIGINT PRIMARY KEY COMMENT '视频ID，关联 videos 表',
+                                  status ENUM('uploaded', 'processing', 'ready', 'failed') NOT NULL COMMENT '处理状态：uploaded → processing → ready/failed，failed 可以重新处理',
+                                  progress TINYINT UNSIGNED NOT NULL DEFAULT 0 COMMENT '处理进度，0-100',
+                                  fail_reason VARCHAR(255) NOT NULL DEFAULT '' COMMENT '处理失败的原因',
+                                  attempts INT UNSIGNED NOT NULL DEFAULT 0 COMMENT '已尝试处理的次数',
+                                  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '开始处理的时间',
+                                  updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+                                  FOREIGN KEY (video_id) REFERENCES videos(video_id) ON DELETE CASCADE,
+                                  INDEX idx_status (status)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='视频处理状态表';
+
+-- 视频文件元数据表，投稿时解析 MP4 文件得到
+CREATE TABLE video_metadata (
+                                video_id BIGINT PRIMARY KEY COMMENT '视频ID，关联 videos 表',
+                                major_brand VARCHAR(8) NOT NULL DEFAULT '' COMMENT 'ftyp 主品牌，如 isom',
+                                duration_ms BIGINT UNSIGNED NOT NULL COMMENT '实际时长（毫秒）',
+                                width INT UNSIGNED NOT NULL COMMENT '宽度',
+                                height INT UNSIGNED NOT NULL COMMENT '高度',
+                                video_codec VARCHAR(16) NOT NULL COMMENT '视频编码，如 h264',
+                                audio_codec VARCHAR(16) NOT NULL DEFAULT '' COMMENT '音频编码，没有音频轨时为空',
+                                bitrate BIGINT UNSIGNED NOT NULL COMMENT '总码率（bit/s）',
+                                size BIGINT UNSIGNED NOT NULL COMMENT '文件大小（字节）',
+                                faststart TINYINT(1) NOT NULL DEFAULT 0 COMMENT '存储的文件 moov 是否位于开头，投稿时会把 moov 移到开头',
+                                created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+                                FOREIGN KEY (video_id) REFERENCES videos(video_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='视频文件元数据表';
+
+-- 视频转码产物表，每个清晰度一行
+CREATE TABLE video_renditions (
+                                  video_id BIGINT NOT NULL COMMENT '视频ID',
+                                  name VARCHAR(16) NOT NULL COMMENT '清晰度名称，如 720p',
+                                  object_key VARCHAR(255) NOT NULL COMMENT '转码产物在 video 桶中的对象名',
+                                  width INT UNSIGNED NOT NULL COMMENT '宽度',
+                                  height INT UNSIGNED NOT NULL COMMENT '高度',
+                                  bitrate BIGINT UNSIGNED NOT NULL COMMENT '码率（bit/s）',
+                                  size BIGINT UNSIGNED NOT NULL COMMENT '文件大小（字节）',
+                                  created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP COMMENT '生成时间',
+                                  PRIMARY KEY (video_id, name),
+                                  FOREIGN KEY (video_id) REFERENCES videos(video_id) ON DELETE CASCADE
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='视频转码产物表';
@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 type server struct {
 	Secret      string `mapstructure:"private-key"`
 	PublicKey   string `mapstructure:"public-key"`
@@ -55,19 +57,60 @@ type kafka struct {
 }
 
 type minio struct {
-	Endpoint  string `mapstructure:"endpoint"`   // eg: "127.0.0.1:9000"
-	AccessKey string `mapstructure:"access-key"` // MinIO 用户名
-	SecretKey string `mapstructure:"secret-key"` // MinIO 密码
-	UseSSL    bool   `mapstructure:"use-ssl"`    // 是否使用 HTTPS
+	Endpoint       string        `mapstructure:"endpoint"`         // eg: "127.0.0.1:9000"
+	AccessKey      string        `mapstructure:"access-key"`       // MinIO 用户名
+	SecretKey      string        `mapstructure:"secret-key"`       // MinIO 密码
+	UseSSL         bool          `mapstructure:"use-ssl"`          // 是否使用 HTTPS
+	PlaybackURLTTL time.Duration `mapstructure:"playback-url-ttl"` // 视频播放地址的有效期，最长 7 天
+}
+
+// invite 邀请码注册相关配置，required 可以在运行时通过 etcd 修改
+type invite struct {
+	Required  bool    `mapstructure:"required"`   // 注册时是否必须填写邀请码
+	AdminUids []int64 `mapstructure:"admin-uids"` // 可以批量生成邀请码的管理员
+}
+
+// piiKey 是一个版本的主密钥，key 为 base64 编码的 32 字节 AES-256 密钥
+type piiKey struct {
+	Version int    `mapstructure:"version"`
+	Key     string `mapstructure:"key"`
+}
+
+// pii 邮箱、手机号等个人敏感信息的加密配置
+// 轮换密钥时新增一个版本并修改 active-version，旧版本需要保留到存量数据重新加密完成
+type pii struct {
+	ActiveVersion int      `mapstructure:"active-version"`  // 新数据使用的主密钥版本
+	MasterKeys    []piiKey `mapstructure:"master-keys"`     // 所有可用的主密钥
+	BlindIndexKey string   `mapstructure:"blind-index-key"` // 计算盲索引的 HMAC 密钥，base64 编码
+}
+
+// search 视频搜索配置，engine 可选 mysql(默认) 或 embedded，修改 engine 后需要重启视频服务
+type search struct {
+	Engine           string        `mapstructure:"engine"`            // 搜索引擎
+	SnapshotDir      string        `mapstructure:"snapshot-dir"`      // 内置引擎的索引快照保存目录
+	SnapshotInterval time.Duration `mapstructure:"snapshot-interval"` // 内置引擎保存索引快照的间隔
+	CursorKey        string        `mapstructure:"cursor-key"`        // 热榜和搜索分页游标的 HMAC 签名密钥，base64 编码
+}
+
+// videoWorker 视频处理 worker 配置
+type videoWorker struct {
+	FFmpegPath  string `mapstructure:"ffmpeg-path"`  // ffmpeg 可执行文件路径
+	FFprobePath string `mapstructure:"ffprobe-path"` // ffprobe 可执行文件路径
+	WorkDir     string `mapstructure:"work-dir"`     // 处理时存放临时文件的目录，为空时使用系统临时目录
+	Concurrency int    `mapstructure:"concurrency"`  // 同时处理的视频数
 }
 
 type config struct {
-	Server    server
-	Snowflake snowflake
-	MySQL     mySQL
-	Etcd      etcd
-	RabbitMQ  rabbitMQ
-	Redis     redis
-	Kafka     kafka
-	Minio     minio
+	Server      server
+	Snowflake   snowflake
+	MySQL       mySQL
+	Etcd        etcd
+	RabbitMQ    rabbitMQ
+	Redis       redis
+	Kafka       kafka
+	Minio       minio
+	Invite      invite
+	PII         pii
+	Search      search
+	VideoWorker videoWorker `mapstructure:"video-worker"`
 }
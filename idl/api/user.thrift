@@ -7,6 +7,7 @@ struct RegisterRequest {
     2: required string password
     3: required string email
     4: required string phone,
+    5: optional string inviteCode,
 }
 
 struct RegisterResponse {
@@ -21,6 +22,7 @@ struct LoginRequest {
 
 struct LoginResponse {
     1: model.UserInfo user,
+    2: bool isNewDevice,
 }
 
 // 更新个人信息
@@ -42,11 +44,85 @@ struct GetUserProfileResponse{
     1:required model.UserProfileResp userProfileResp,
 }
 
+// 安全事件记录
+struct ListSecurityEventsRequest{
+    1: required i64 pageNum,
+    2: required i64 pageSize,
+}
+
+struct ListSecurityEventsResponse{
+    1: list<model.SecurityEvent> events,
+    2: i64 total,
+}
+
+// 邀请码
+struct MintInviteCodesRequest{
+    1: required i64 count,
+    2: required i64 maxUses,
+    3: i64 expireSeconds,
+}
+
+struct MintInviteCodesResponse{
+    1: list<model.InviteCode> codes,
+}
+
+struct ListInviteCodesRequest{
+    1: required i64 pageNum,
+    2: required i64 pageSize,
+}
+
+struct ListInviteCodesResponse{
+    1: list<model.InviteCode> codes,
+    2: i64 total,
+}
+
+struct CreateInviteCodeRequest{
+}
 
+struct CreateInviteCodeResponse{
+    1: model.InviteCode code,
+    2: i64 remainingQuota,
+}
+
+// 图形验证码，image 为 PNG 图片的 data URL
+struct GetCaptchaRequest{
+}
+
+struct GetCaptchaResponse{
+    1: string captchaId,
+    2: string image,
+}
+
+// 隐私设置
+struct GetPrivacySettingsRequest{
+}
+
+struct GetPrivacySettingsResponse{
+    1: model.PrivacySettings settings,
+}
+
+struct UpdatePrivacySettingsRequest{
+    1: required string profileVisibility,
+    2: required bool hideLikedVideos,
+    3: required string whoCanMessage,
+    4: required string whoCanFollow,
+    5: optional bool disableSearchHistory,
+}
+
+struct UpdatePrivacySettingsResponse{
+    1: model.PrivacySettings settings,
+}
 
 service UserService {
     RegisterResponse Register(1: RegisterRequest req)(api.post = "api/v1/user/register"),
     LoginResponse Login(1: LoginRequest req)(api.post = "api/v1/user/login")
     UpdateUserProfileResponse UpdateProfile(1:UpdateUserProfileRequest req)(api.put="api/v1/user/profile/update")
     GetUserProfileResponse GetProfile(1:GetUserProfileRequest req)(api.get="api/v1/user/profile/get")
+    ListSecurityEventsResponse ListSecurityEvents(1:ListSecurityEventsRequest req)(api.get="api/v1/user/security/events")
+    MintInviteCodesResponse MintInviteCodes(1:MintInviteCodesRequest req)(api.post="api/v1/user/invite/mint")
+    ListInviteCodesResponse ListInviteCodes(1:ListInviteCodesRequest req)(api.get="api/v1/user/invite/list")
+    CreateInviteCodeResponse CreateInviteCode(1:CreateInviteCodeRequest req)(api.post="api/v1/user/invite/create")
+    GetCaptchaResponse GetCaptcha(1:GetCaptchaRequest req)(api.get="api/v1/user/captcha")
+    GetPrivacySettingsResponse GetPrivacySettings(1:GetPrivacySettingsRequest req)(api.get="api/v1/user/privacy/get")
+    UpdatePrivacySettingsResponse UpdatePrivacySettings(1:UpdatePrivacySettingsRequest req)(api.put="api/v1/user/privacy/update")
 }
@@ -39,6 +39,37 @@ struct Image{
     2:required string imageUrl,
 }
 
+// 安全事件(登录成功/失败等)
+struct SecurityEvent {
+    1: i64 id,
+    2: string action,
+    3: string ip,
+    4: string userAgent,
+    5: bool isNewDevice,
+    6: i64 createdAt,
+}
+
+// 邀请码
+struct InviteCode {
+    1: i64 id,
+    2: string code,
+    3: i64 creatorId,
+    4: i64 batchId,
+    5: i64 maxUses,
+    6: i64 usedCount,
+    7: i64 expiresAt,      // 0 表示永不过期
+    8: i64 createdAt,
+}
+
+// 隐私设置
+struct PrivacySettings {
+    1: string profileVisibility,   // public / followers / private
+    2: bool hideLikedVideos,
+    3: string whoCanMessage,       // everyone / followers / nobody
+    4: string whoCanFollow,        // everyone / nobody
+    5: optional bool disableSearchHistory,  // 不记录搜索历史，更新时不传表示保持原来的设置
+}
+
 // 视频
 struct Video {
     1: i64 video_id,              // 视频ID
@@ -46,13 +77,50 @@ struct Video {
     3: string title,                 // 视频标题
     4: string description,           // 视频描述
     5: string cover_url,             // 封面图地址
-    6: string video_url,             // 视频播放URL
+    6: string video_url,             // 视频播放URL，视频详情中是带签名的临时地址，其他接口中是对象名
     7: i64 duration_seconds,         // 视频时长
     8: i64 views,                    // 播放次数
     9: i64 likes,                    // 点赞次数
     10: i64 comments,                // 评论次数
     11: double hot_score,            // 热度分
     12: i64 created_at            // 发布时间
+    13: optional string status,      // 视频状态：published/draft，只有作者本人能看到草稿
+    14: optional i64 publish_at,     // 草稿的定时发布时间，0 表示未设置
+    15: optional list<string> tags,  // 视频标签
+    16: optional string processing_status, // 处理状态：uploaded/processing/ready/failed，只有作者本人能看到未就绪的视频
+    17: optional string visibility,  // 可见范围：public/unlisted/private/followers
+}
+
+struct TagCount {
+    1: string tag,                   // 标签
+    2: i64 count,                    // 使用该标签的已发布视频数
+}
+
+struct SearchTrend {
+    1: string keyword,               // 搜索词
+    2: double score,                 // 按时间衰减后的热度
 }
 
+struct VideoProcessingStatus {
+    1: i64 video_id,                 // 视频ID
+    2: string status,                // uploaded/processing/ready/failed
+    3: i64 progress,                 // 处理进度，0-100
+    4: string fail_reason,           // 处理失败的原因，只在 failed 时有值
+    5: i64 updated_at,               // 状态最后更新的时间
+}
 
+struct VideoUpload {
+    1: i64 upload_id,                // 上传ID
+    2: i64 file_size,                // 文件大小（字节）
+    3: i64 chunk_size,               // 分片大小（字节），除最后一片外每片都是这么大
+    4: i64 total_chunks,             // 分片总数，分片编号从 1 开始
+    5: list<i64> uploaded_chunks,    // 已经上传成功的分片编号，断线后据此续传
+    6: i64 expires_at,              // 会话过期时间，每上传一个分片都会延长
+}
+
+struct DirectUpload {
+    1: i64 upload_id,                // 上传ID
+    2: string url,                   // 表单上传地址
+    3: map<string, string> form_data, // 需要原样放进表单的字段，文件字段名为 file 并放在最后
+    4: i64 expires_at,               // 上传地址的过期时间，过期后需要重新初始化
+}
@@ -8,6 +8,7 @@ struct RegisterRequest {
     2: required string password,
     3: required string email,
     4: required string phone,
+    5: optional string inviteCode,
 }
 
 struct RegisterResponse {
@@ -20,11 +21,14 @@ struct LoginRequest {
     1: string username,
     2: string password,
     3: string confirm_password,
+    4: optional string ip,
+    5: optional string userAgent,
 }
 
 struct LoginResponse {
     1: model.BaseResp base,
     2: model.UserInfo user,
+    3: bool isNewDevice,
 }
 
 // 更新个人信息
@@ -49,9 +53,116 @@ struct GetUserProfileResponse{
     2: required model.UserProfileResp userProfileResp,
 }
 
+// 安全事件记录
+struct ListSecurityEventsRequest{
+    1: required i64 pageNum,
+    2: required i64 pageSize,
+}
+
+struct ListSecurityEventsResponse{
+    1: model.BaseResp base,
+    2: list<model.SecurityEvent> events,
+    3: i64 total,
+}
+
+// 邀请码
+struct MintInviteCodesRequest{
+    1: required i64 count,
+    2: required i64 maxUses,
+    3: i64 expireSeconds,
+}
+
+struct MintInviteCodesResponse{
+    1: model.BaseResp base,
+    2: list<model.InviteCode> codes,
+}
+
+struct ListInviteCodesRequest{
+    1: required i64 pageNum,
+    2: required i64 pageSize,
+}
+
+struct ListInviteCodesResponse{
+    1: model.BaseResp base,
+    2: list<model.InviteCode> codes,
+    3: i64 total,
+}
+
+struct CreateInviteCodeRequest{
+}
+
+struct CreateInviteCodeResponse{
+    1: model.BaseResp base,
+    2: model.InviteCode code,
+    3: i64 remainingQuota,
+}
+
+// 隐私设置
+struct GetPrivacySettingsRequest{
+}
+
+struct GetPrivacySettingsResponse{
+    1: model.BaseResp base,
+    2: model.PrivacySettings settings,
+}
+
+struct UpdatePrivacySettingsRequest{
+    1: required model.PrivacySettings settings,
+}
+
+struct UpdatePrivacySettingsResponse{
+    1: model.BaseResp base,
+    2: model.PrivacySettings settings,
+}
+
+// 供其他服务判断 viewer 能否对 owner 执行 action，viewerId 为 0 表示未登录用户
+struct CheckPrivacyRequest{
+    1: required i64 viewerId,
+    2: required i64 ownerId,
+    3: required string action,    // view_profile / view_likes / message / follow / record_search / view_followed
+}
+
+struct CheckPrivacyResponse{
+    1: model.BaseResp base,
+    2: bool allowed,
+}
+
+// 供视频服务推送关注流：按关系 ID 翻页列出用户的粉丝
+struct ListFollowersRequest{
+    1: required i64 userId,
+    2: optional i64 cursor,       // 上一页返回的 nextCursor，不传表示第一页
+    3: required i64 limit,
+}
+
+struct ListFollowersResponse{
+    1: model.BaseResp base,
+    2: list<i64> followerIds,
+    3: optional i64 nextCursor,   // 没有更多粉丝时不返回
+    4: i64 total,                 // 粉丝总数
+}
+
+// 供视频服务合并关注流：列出用户关注的全部用户
+struct ListFollowingRequest{
+    1: required i64 userId,
+}
+
+struct ListFollowingResponse{
+    1: model.BaseResp base,
+    2: list<i64> followingIds,
+}
+
 service UserService {
     RegisterResponse Register(1: RegisterRequest req),
     LoginResponse Login(1: LoginRequest req),
     UpdateUserProfileResponse UpdateProfile(1:UpdateUserProfileRequest req)
     GetUserProfileResponse GetProfile(1:GetUserProfileRequest req)
+    ListSecurityEventsResponse ListSecurityEvents(1:ListSecurityEventsRequest req)
+    MintInviteCodesResponse MintInviteCodes(1:MintInviteCodesRequest req)
+    ListInviteCodesResponse ListInviteCodes(1:ListInviteCodesRequest req)
+    CreateInviteCodeResponse CreateInviteCode(1:CreateInviteCodeRequest req)
+    GetPrivacySettingsResponse GetPrivacySettings(1:GetPrivacySettingsRequest req)
+    UpdatePrivacySettingsResponse UpdatePrivacySettings(1:UpdatePrivacySettingsRequest req)
+    CheckPrivacyResponse CheckPrivacy(1:CheckPrivacyRequest req)
+    ListFollowersResponse ListFollowers(1:ListFollowersRequest req)
+    ListFollowingResponse ListFollowing(1:ListFollowingRequest req)
 }
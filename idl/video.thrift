@@ -12,6 +12,9 @@ struct VideoSubmissionRequest {
     4: optional string cover_url          // 封面图URL（可选）
     5: required i64 duration_seconds      // 视频时长（单位：秒）
     6: required binary video
+    7: optional bool is_draft             // 是否保存为草稿
+    8: optional i64 publish_at            // 定时发布时间（秒级时间戳），晚于当前时间时保存为草稿并到时自动发布
+    9: optional list<string> tags        // 视频标签，最多 10 个
 }
 
 /**
@@ -21,7 +24,7 @@ struct VideoSubmissionRequest {
 struct VideoSubmissionResponse {
     1: required model.BaseResp base_resp  // 通用响应结构
     2: required i64 video_id           // 成功创建后的视频ID
-    3: required string video_url          // 视频可访问URL
+    3: required string video_url          // 视频播放URL，带签名，过期后通过视频详情重新获取
 }
 
 /**
@@ -42,13 +45,16 @@ struct VideoDetailResponse {
 
 /**
  * 视频搜索请求结构
- * 支持关键词模糊匹配，分页获取
+ * 基于全文索引匹配标题/描述，支持标签过滤、多种排序，分页获取
  */
 struct VideoSearchRequest {
-    1: required string keyword            // 搜索关键词（匹配标题/描述）
-    2: optional list<string> tags         // 可选标签（预留扩展）
+    1: required string keyword            // 搜索关键词（全文匹配标题/描述），为空时只按标签过滤
+    2: optional list<string> tags         // 可选标签，按 tag_mode 过滤
     3: required i64 page_num              // 第几页，从1开始
     4: required i64 page_size             // 每页多少条数据
+    5: optional string tag_mode           // 标签匹配方式：any 命中任一标签（默认），all 命中全部标签
+    6: optional string sort               // 排序方式：relevance 综合相关度（默认），newest 最新发布，most_viewed 最多播放
+    7: optional string cursor             // 上一页返回的 next_cursor，传入时忽略 page_num，翻页期间结果顺序固定
 }
 
 /**
@@ -57,6 +63,8 @@ struct VideoSearchRequest {
 struct VideoSearchResponse {
     1: required model.BaseResp base_resp
     2: required list<model.Video> videos  // 匹配的视频列表
+    3: required i64 total                 // 命中总数，用于分页
+    4: optional string next_cursor        // 下一页的游标，没有更多结果时不返回，最多翻到前 500 个结果
 }
 
 /**
@@ -66,6 +74,7 @@ struct VideoSearchResponse {
 struct VideoTrendingRequest {
     1: required i64 page_num              // 第几页
     2: required i64 page_size             // 每页条数
+    3: optional string cursor             // 上一页返回的 next_cursor，传入时忽略 page_num，翻页期间热榜顺序固定
 }
 
 /**
@@ -74,6 +83,7 @@ struct VideoTrendingRequest {
 struct VideoTrendingResponse {
     1: required model.BaseResp base_resp
     2: required list<model.Video> videos  // 热门视频列表
+    3: optional string next_cursor        // 下一页的游标，没有更多视频时不返回，最多翻到热榜前 500 个视频
 }
 
 /**
@@ -91,10 +101,417 @@ struct VideoHotUpdateResponse{
 }
 
 
+/**
+ * 编辑视频请求结构
+ * 只有作者本人可以编辑，未传的字段保持不变
+ */
+struct VideoUpdateRequest {
+    1: required i64 video_id              // 视频ID
+    2: optional string title              // 新标题
+    3: optional string description        // 新描述
+    4: optional string cover_url          // 新封面图URL
+    5: optional list<string> tags         // 新标签，传空列表表示清空标签
+    6: optional string visibility         // 新可见范围：public/unlisted/private/followers
+}
+
+/**
+ * 编辑视频响应结构
+ */
+struct VideoUpdateResponse {
+    1: required model.BaseResp base_resp
+    2: required model.Video video         // 编辑后的视频数据
+}
+
+/**
+ * 删除视频请求结构
+ * 只有作者本人可以删除，视频被逻辑删除，视频文件延迟从 MinIO 清理
+ */
+struct VideoDeleteRequest {
+    1: required i64 video_id              // 视频ID
+}
+
+/**
+ * 删除视频响应结构
+ */
+struct VideoDeleteResponse {
+    1: required model.BaseResp base_resp
+}
+
+/**
+ * 草稿列表请求结构
+ * 返回当前用户的草稿，包括已设置定时发布的草稿
+ */
+struct VideoDraftListRequest {
+    1: required i64 page_num              // 第几页，从1开始
+    2: required i64 page_size             // 每页多少条数据
+}
+
+/**
+ * 草稿列表响应结构
+ */
+struct VideoDraftListResponse {
+    1: required model.BaseResp base_resp
+    2: required list<model.Video> videos  // 草稿列表
+}
+
+/**
+ * 定时发布请求结构
+ * 设置草稿的发布时间，发布时间不晚于当前时间时立即发布
+ */
+struct VideoScheduleRequest {
+    1: required i64 video_id              // 草稿视频ID
+    2: required i64 publish_at            // 发布时间（秒级时间戳）
+}
+
+/**
+ * 定时发布响应结构
+ */
+struct VideoScheduleResponse {
+    1: required model.BaseResp base_resp
+    2: required model.Video video         // 设置后的视频数据
+}
+
+/**
+ * 按标签浏览请求结构
+ */
+struct VideoTagListRequest {
+    1: required string tag                // 标签
+    2: required i64 page_num              // 第几页，从1开始
+    3: required i64 page_size             // 每页多少条数据
+}
+
+/**
+ * 按标签浏览响应结构
+ */
+struct VideoTagListResponse {
+    1: required model.BaseResp base_resp
+    2: required list<model.Video> videos  // 带有该标签的视频，新发布的在前
+}
+
+/**
+ * 热门标签请求结构
+ */
+struct PopularTagsRequest {
+    1: optional i64 limit                 // 返回数量，默认 20，最多 100
+}
+
+/**
+ * 热门标签响应结构
+ */
+struct PopularTagsResponse {
+    1: required model.BaseResp base_resp
+    2: required list<model.TagCount> tags // 按视频数从多到少排序
+}
+
+/**
+ * 搜索联想请求结构
+ * 根据输入的前缀返回补全建议，来源是视频标题和热门搜索词
+ */
+struct SearchSuggestRequest {
+    1: required string prefix             // 已输入的内容
+    2: optional i64 limit                 // 返回数量，默认 10，最多 20
+}
+
+/**
+ * 搜索联想响应结构
+ */
+struct SearchSuggestResponse {
+    1: required model.BaseResp base_resp
+    2: required list<string> suggestions  // 补全建议，越常用的越靠前
+}
+
+/**
+ * 热搜榜请求结构
+ */
+struct TrendingSearchesRequest {
+    1: optional i64 limit                 // 返回数量，默认 10，最多 50
+}
+
+/**
+ * 热搜榜响应结构
+ */
+struct TrendingSearchesResponse {
+    1: required model.BaseResp base_resp
+    2: required list<model.SearchTrend> searches // 按热度从高到低排序，越早的搜索权重越低
+}
+
+/**
+ * 搜索历史请求结构
+ */
+struct SearchHistoryListRequest {
+}
+
+/**
+ * 搜索历史响应结构
+ */
+struct SearchHistoryListResponse {
+    1: required model.BaseResp base_resp
+    2: required list<string> keywords     // 最近的搜索词，最近的在前
+}
+
+/**
+ * 删除一条搜索历史请求结构
+ */
+struct SearchHistoryDeleteRequest {
+    1: required string keyword            // 要删除的搜索词
+}
+
+/**
+ * 删除一条搜索历史响应结构
+ */
+struct SearchHistoryDeleteResponse {
+    1: required model.BaseResp base_resp
+}
+
+/**
+ * 清空搜索历史请求结构
+ */
+struct SearchHistoryClearRequest {
+}
+
+/**
+ * 清空搜索历史响应结构
+ */
+struct SearchHistoryClearResponse {
+    1: required model.BaseResp base_resp
+}
+
+/**
+ * 视频处理状态请求结构
+ */
+struct VideoProcessingStatusRequest {
+    1: required i64 video_id              // 视频ID，只能查询自己的视频
+}
+
+/**
+ * 视频处理状态响应结构
+ */
+struct VideoProcessingStatusResponse {
+    1: required model.BaseResp base_resp
+    2: required model.VideoProcessingStatus status
+}
+
+/**
+ * 初始化分片上传请求结构
+ * 视频信息在初始化时提交，所有分片上传完成并校验通过后才创建视频
+ */
+struct InitVideoUploadRequest {
+    1: required string title              // 视频标题
+    2: required string description        // 视频描述，可为空
+    3: optional string cover_url          // 封面图URL（可选）
+    4: required i64 duration_seconds      // 视频时长（单位：秒）
+    5: optional bool is_draft             // 是否保存为草稿
+    6: optional i64 publish_at            // 定时发布时间（秒级时间戳）
+    7: optional list<string> tags        // 视频标签，最多 10 个
+    8: required i64 file_size             // 文件大小（字节）
+}
+
+/**
+ * 初始化分片上传响应结构
+ */
+struct InitVideoUploadResponse {
+    1: required model.BaseResp base_resp
+    2: required model.VideoUpload upload
+}
+
+/**
+ * 上传分片请求结构
+ */
+struct UploadVideoChunkRequest {
+    1: required i64 upload_id             // 上传ID
+    2: required i64 part_number           // 分片编号，从 1 开始
+    3: required string checksum           // 分片内容的 SHA-256，十六进制
+    4: required binary data               // 分片内容
+}
+
+/**
+ * 上传分片响应结构
+ */
+struct UploadVideoChunkResponse {
+    1: required model.BaseResp base_resp
+}
+
+/**
+ * 查询分片上传进度请求结构
+ */
+struct GetVideoUploadRequest {
+    1: required i64 upload_id             // 上传ID
+}
+
+/**
+ * 查询分片上传进度响应结构
+ */
+struct GetVideoUploadResponse {
+    1: required model.BaseResp base_resp
+    2: required model.VideoUpload upload
+}
+
+/**
+ * 完成分片上传请求结构
+ */
+struct CompleteVideoUploadRequest {
+    1: required i64 upload_id             // 上传ID
+}
+
+/**
+ * 完成分片上传响应结构
+ */
+struct CompleteVideoUploadResponse {
+    1: required model.BaseResp base_resp
+    2: required i64 video_id              // 成功创建后的视频ID
+    3: required string video_url          // 视频播放URL，带签名，过期后通过视频详情重新获取
+}
+
+/**
+ * 取消分片上传请求结构
+ */
+struct AbortVideoUploadRequest {
+    1: required i64 upload_id             // 上传ID
+}
+
+/**
+ * 取消分片上传响应结构
+ */
+struct AbortVideoUploadResponse {
+    1: required model.BaseResp base_resp
+}
+
+/**
+ * 初始化直传请求结构
+ * 客户端拿到预签名的表单后直接上传到对象存储，文件不经过网关
+ */
+struct InitDirectUploadRequest {
+    1: required string title              // 视频标题
+    2: required string description        // 视频描述，可为空
+    3: optional string cover_url          // 封面图URL（可选）
+    4: required i64 duration_seconds      // 视频时长（单位：秒）
+    5: optional bool is_draft             // 是否保存为草稿
+    6: optional i64 publish_at            // 定时发布时间（秒级时间戳）
+    7: optional list<string> tags        // 视频标签，最多 10 个
+    8: required i64 file_size             // 文件大小（字节），上传的文件必须与之一致
+}
+
+/**
+ * 初始化直传响应结构
+ */
+struct InitDirectUploadResponse {
+    1: required model.BaseResp base_resp
+    2: required model.DirectUpload upload
+}
+
+/**
+ * 完成直传请求结构
+ */
+struct CompleteDirectUploadRequest {
+    1: required i64 upload_id             // 上传ID
+}
+
+/**
+ * 完成直传响应结构
+ */
+struct CompleteDirectUploadResponse {
+    1: required model.BaseResp base_resp
+    2: required i64 video_id              // 成功创建后的视频ID
+    3: required string video_url          // 视频播放URL，带签名，过期后通过视频详情重新获取
+}
+
+/**
+ * 获取视频文件请求结构，网关代理播放时使用
+ */
+struct GetVideoObjectRequest {
+    1: required i64 video_id              // 视频ID
+}
+
+/**
+ * 获取视频文件响应结构
+ */
+struct GetVideoObjectResponse {
+    1: required model.BaseResp base_resp
+    2: required string bucket             // 存储桶
+    3: required string object_key         // 对象名
+}
+
+/**
+ * 作者视频列表请求结构
+ * 按游标翻页，作者本人还能看到自己的草稿、未处理完成和不公开的视频
+ */
+struct UserVideoListRequest {
+    1: required i64 user_id               // 作者用户ID
+    2: optional string cursor             // 上一页返回的 next_cursor，不传表示第一页
+    3: optional i64 limit                 // 每页数量，默认 20，最多 50
+    4: optional string sort               // 排序方式：newest(默认)/most_viewed/most_liked
+}
+
+/**
+ * 作者视频列表响应结构
+ */
+struct UserVideoListResponse {
+    1: required model.BaseResp base_resp
+    2: required list<model.Video> videos  // 作者的视频
+    3: optional string next_cursor        // 下一页的游标，没有更多视频时不返回
+}
+
+/**
+ * 关注流请求结构，需要登录
+ * 按发布时间倒序返回关注的作者发布的视频，按游标翻页
+ */
+struct FeedRequest {
+    1: optional string cursor             // 上一页返回的 next_cursor，不传表示第一页
+    2: optional i64 limit                 // 每页数量，默认 20，最多 50
+}
+
+/**
+ * 关注流响应结构
+ */
+struct FeedResponse {
+    1: required model.BaseResp base_resp
+    2: required list<model.Video> videos  // 关注的作者发布的视频，不可见的视频会被过滤，一页可能少于 limit 个
+    3: optional string next_cursor        // 下一页的游标，没有更多视频时不返回
+}
+
+/**
+ * 相关视频请求结构，登录用户看过的视频不会出现在结果中
+ */
+struct RelatedVideosRequest {
+    1: required i64 video_id              // 视频ID
+    2: optional i64 limit                 // 返回数量，默认 10，最多 30
+}
+
+/**
+ * 相关视频响应结构
+ */
+struct RelatedVideosResponse {
+    1: required model.BaseResp base_resp
+    2: required list<model.Video> videos  // 按相关度排序，相关视频不够时用热门视频补足
+}
+
 service VideoService {
     VideoSubmissionResponse SubmitVideo(1: VideoSubmissionRequest req)
     VideoDetailResponse GetVideo(1: VideoDetailRequest req)
     VideoSearchResponse SearchVideo(1: VideoSearchRequest req)
     VideoTrendingResponse TrendVideo(1: VideoTrendingRequest req)
     VideoHotUpdateResponse UpdateVideoHot(1:VideoHotUpdateRequest req)
+    VideoUpdateResponse UpdateVideo(1: VideoUpdateRequest req)
+    VideoDeleteResponse DeleteVideo(1: VideoDeleteRequest req)
+    VideoDraftListResponse ListDrafts(1: VideoDraftListRequest req)
+    VideoScheduleResponse ScheduleVideo(1: VideoScheduleRequest req)
+    VideoTagListResponse ListVideosByTag(1: VideoTagListRequest req)
+    PopularTagsResponse PopularTags(1: PopularTagsRequest req)
+    SearchSuggestResponse SuggestSearch(1: SearchSuggestRequest req)
+    TrendingSearchesResponse TrendingSearches(1: TrendingSearchesRequest req)
+    SearchHistoryListResponse ListSearchHistory(1: SearchHistoryListRequest req)
+    SearchHistoryDeleteResponse DeleteSearchHistoryItem(1: SearchHistoryDeleteRequest req)
+    SearchHistoryClearResponse ClearSearchHistory(1: SearchHistoryClearRequest req)
+    VideoProcessingStatusResponse GetVideoProcessingStatus(1: VideoProcessingStatusRequest req)
+    InitVideoUploadResponse InitVideoUpload(1: InitVideoUploadRequest req)
+    UploadVideoChunkResponse UploadVideoChunk(1: UploadVideoChunkRequest req)
+    GetVideoUploadResponse GetVideoUpload(1: GetVideoUploadRequest req)
+    CompleteVideoUploadResponse CompleteVideoUpload(1: CompleteVideoUploadRequest req)
+    AbortVideoUploadResponse AbortVideoUpload(1: AbortVideoUploadRequest req)
+    InitDirectUploadResponse InitDirectUpload(1: InitDirectUploadRequest req)
+    CompleteDirectUploadResponse CompleteDirectUpload(1: CompleteDirectUploadRequest req)
+    GetVideoObjectResponse GetVideoObject(1: GetVideoObjectRequest req)
+    UserVideoListResponse ListUserVideos(1: UserVideoListRequest req)
+    FeedResponse GetFeed(1: FeedRequest req)
+    RelatedVideosResponse RelatedVideos(1: RelatedVideosRequest req)
 }
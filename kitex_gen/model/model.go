@@ -548,120 +548,1089 @@ var fieldIDToName_Image = map[int16]string{
 	2: "imageUrl",
 }
 
+type SecurityEvent struct {
+	Id          int64  `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Action      string `thrift:"action,2" frugal:"2,default,string" json:"action"`
+	Ip          string `thrift:"ip,3" frugal:"3,default,string" json:"ip"`
+	UserAgent   string `thrift:"userAgent,4" frugal:"4,default,string" json:"userAgent"`
+	IsNewDevice bool   `thrift:"isNewDevice,5" frugal:"5,default,bool" json:"isNewDevice"`
+	CreatedAt   int64  `thrift:"createdAt,6" frugal:"6,default,i64" json:"createdAt"`
+}
+
+func NewSecurityEvent() *SecurityEvent {
+	return &SecurityEvent{}
+}
+
+func (p *SecurityEvent) InitDefault() {
+}
+
+func (p *SecurityEvent) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *SecurityEvent) GetAction() (v string) {
+	return p.Action
+}
+
+func (p *SecurityEvent) GetIp() (v string) {
+	return p.Ip
+}
+
+func (p *SecurityEvent) GetUserAgent() (v string) {
+	return p.UserAgent
+}
+
+func (p *SecurityEvent) GetIsNewDevice() (v bool) {
+	return p.IsNewDevice
+}
+
+func (p *SecurityEvent) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+func (p *SecurityEvent) SetId(val int64) {
+	p.Id = val
+}
+func (p *SecurityEvent) SetAction(val string) {
+	p.Action = val
+}
+func (p *SecurityEvent) SetIp(val string) {
+	p.Ip = val
+}
+func (p *SecurityEvent) SetUserAgent(val string) {
+	p.UserAgent = val
+}
+func (p *SecurityEvent) SetIsNewDevice(val bool) {
+	p.IsNewDevice = val
+}
+func (p *SecurityEvent) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+
+func (p *SecurityEvent) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SecurityEvent(%+v)", *p)
+}
+
+func (p *SecurityEvent) DeepEqual(ano *SecurityEvent) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Id) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Action) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Ip) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.UserAgent) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.IsNewDevice) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.CreatedAt) {
+		return false
+	}
+	return true
+}
+
+func (p *SecurityEvent) Field1DeepEqual(src int64) bool {
+
+	if p.Id != src {
+		return false
+	}
+	return true
+}
+func (p *SecurityEvent) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Action, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *SecurityEvent) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Ip, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *SecurityEvent) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.UserAgent, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *SecurityEvent) Field5DeepEqual(src bool) bool {
+
+	if p.IsNewDevice != src {
+		return false
+	}
+	return true
+}
+func (p *SecurityEvent) Field6DeepEqual(src int64) bool {
+
+	if p.CreatedAt != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_SecurityEvent = map[int16]string{
+	1: "id",
+	2: "action",
+	3: "ip",
+	4: "userAgent",
+	5: "isNewDevice",
+	6: "createdAt",
+}
+
+type InviteCode struct {
+	Id        int64  `thrift:"id,1" frugal:"1,default,i64" json:"id"`
+	Code      string `thrift:"code,2" frugal:"2,default,string" json:"code"`
+	CreatorId int64  `thrift:"creatorId,3" frugal:"3,default,i64" json:"creatorId"`
+	BatchId   int64  `thrift:"batchId,4" frugal:"4,default,i64" json:"batchId"`
+	MaxUses   int64  `thrift:"maxUses,5" frugal:"5,default,i64" json:"maxUses"`
+	UsedCount int64  `thrift:"usedCount,6" frugal:"6,default,i64" json:"usedCount"`
+	ExpiresAt int64  `thrift:"expiresAt,7" frugal:"7,default,i64" json:"expiresAt"`
+	CreatedAt int64  `thrift:"createdAt,8" frugal:"8,default,i64" json:"createdAt"`
+}
+
+func NewInviteCode() *InviteCode {
+	return &InviteCode{}
+}
+
+func (p *InviteCode) InitDefault() {
+}
+
+func (p *InviteCode) GetId() (v int64) {
+	return p.Id
+}
+
+func (p *InviteCode) GetCode() (v string) {
+	return p.Code
+}
+
+func (p *InviteCode) GetCreatorId() (v int64) {
+	return p.CreatorId
+}
+
+func (p *InviteCode) GetBatchId() (v int64) {
+	return p.BatchId
+}
+
+func (p *InviteCode) GetMaxUses() (v int64) {
+	return p.MaxUses
+}
+
+func (p *InviteCode) GetUsedCount() (v int64) {
+	return p.UsedCount
+}
+
+func (p *InviteCode) GetExpiresAt() (v int64) {
+	return p.ExpiresAt
+}
+
+func (p *InviteCode) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+func (p *InviteCode) SetId(val int64) {
+	p.Id = val
+}
+func (p *InviteCode) SetCode(val string) {
+	p.Code = val
+}
+func (p *InviteCode) SetCreatorId(val int64) {
+	p.CreatorId = val
+}
+func (p *InviteCode) SetBatchId(val int64) {
+	p.BatchId = val
+}
+func (p *InviteCode) SetMaxUses(val int64) {
+	p.MaxUses = val
+}
+func (p *InviteCode) SetUsedCount(val int64) {
+	p.UsedCount = val
+}
+func (p *InviteCode) SetExpiresAt(val int64) {
+	p.ExpiresAt = val
+}
+func (p *InviteCode) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+
+func (p *InviteCode) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InviteCode(%+v)", *p)
+}
+
+func (p *InviteCode) DeepEqual(ano *InviteCode) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Id) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Code) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.CreatorId) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.BatchId) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.MaxUses) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.UsedCount) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.ExpiresAt) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.CreatedAt) {
+		return false
+	}
+	return true
+}
+
+func (p *InviteCode) Field1DeepEqual(src int64) bool {
+
+	if p.Id != src {
+		return false
+	}
+	return true
+}
+func (p *InviteCode) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Code, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *InviteCode) Field3DeepEqual(src int64) bool {
+
+	if p.CreatorId != src {
+		return false
+	}
+	return true
+}
+func (p *InviteCode) Field4DeepEqual(src int64) bool {
+
+	if p.BatchId != src {
+		return false
+	}
+	return true
+}
+func (p *InviteCode) Field5DeepEqual(src int64) bool {
+
+	if p.MaxUses != src {
+		return false
+	}
+	return true
+}
+func (p *InviteCode) Field6DeepEqual(src int64) bool {
+
+	if p.UsedCount != src {
+		return false
+	}
+	return true
+}
+func (p *InviteCode) Field7DeepEqual(src int64) bool {
+
+	if p.ExpiresAt != src {
+		return false
+	}
+	return true
+}
+func (p *InviteCode) Field8DeepEqual(src int64) bool {
+
+	if p.CreatedAt != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_InviteCode = map[int16]string{
+	1: "id",
+	2: "code",
+	3: "creatorId",
+	4: "batchId",
+	5: "maxUses",
+	6: "usedCount",
+	7: "expiresAt",
+	8: "createdAt",
+}
+
+type PrivacySettings struct {
+	ProfileVisibility    string `thrift:"profileVisibility,1" frugal:"1,default,string" json:"profileVisibility"`
+	HideLikedVideos      bool   `thrift:"hideLikedVideos,2" frugal:"2,default,bool" json:"hideLikedVideos"`
+	WhoCanMessage        string `thrift:"whoCanMessage,3" frugal:"3,default,string" json:"whoCanMessage"`
+	WhoCanFollow         string `thrift:"whoCanFollow,4" frugal:"4,default,string" json:"whoCanFollow"`
+	DisableSearchHistory *bool  `thrift:"disableSearchHistory,5,optional" frugal:"5,optional,bool" json:"disableSearchHistory,omitempty"`
+}
+
+func NewPrivacySettings() *PrivacySettings {
+	return &PrivacySettings{}
+}
+
+func (p *PrivacySettings) InitDefault() {
+}
+
+func (p *PrivacySettings) GetProfileVisibility() (v string) {
+	return p.ProfileVisibility
+}
+
+func (p *PrivacySettings) GetHideLikedVideos() (v bool) {
+	return p.HideLikedVideos
+}
+
+func (p *PrivacySettings) GetWhoCanMessage() (v string) {
+	return p.WhoCanMessage
+}
+
+func (p *PrivacySettings) GetWhoCanFollow() (v string) {
+	return p.WhoCanFollow
+}
+
+var PrivacySettings_DisableSearchHistory_DEFAULT bool
+
+func (p *PrivacySettings) GetDisableSearchHistory() (v bool) {
+	if !p.IsSetDisableSearchHistory() {
+		return PrivacySettings_DisableSearchHistory_DEFAULT
+	}
+	return *p.DisableSearchHistory
+}
+func (p *PrivacySettings) SetProfileVisibility(val string) {
+	p.ProfileVisibility = val
+}
+func (p *PrivacySettings) SetHideLikedVideos(val bool) {
+	p.HideLikedVideos = val
+}
+func (p *PrivacySettings) SetWhoCanMessage(val string) {
+	p.WhoCanMessage = val
+}
+func (p *PrivacySettings) SetWhoCanFollow(val string) {
+	p.WhoCanFollow = val
+}
+func (p *PrivacySettings) SetDisableSearchHistory(val *bool) {
+	p.DisableSearchHistory = val
+}
+
+func (p *PrivacySettings) IsSetDisableSearchHistory() bool {
+	return p.DisableSearchHistory != nil
+}
+
+func (p *PrivacySettings) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PrivacySettings(%+v)", *p)
+}
+
+func (p *PrivacySettings) DeepEqual(ano *PrivacySettings) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ProfileVisibility) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.HideLikedVideos) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.WhoCanMessage) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.WhoCanFollow) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.DisableSearchHistory) {
+		return false
+	}
+	return true
+}
+
+func (p *PrivacySettings) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.ProfileVisibility, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *PrivacySettings) Field2DeepEqual(src bool) bool {
+
+	if p.HideLikedVideos != src {
+		return false
+	}
+	return true
+}
+func (p *PrivacySettings) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.WhoCanMessage, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *PrivacySettings) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.WhoCanFollow, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *PrivacySettings) Field5DeepEqual(src *bool) bool {
+
+	if p.DisableSearchHistory == src {
+		return true
+	} else if p.DisableSearchHistory == nil || src == nil {
+		return false
+	}
+	if *p.DisableSearchHistory != *src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_PrivacySettings = map[int16]string{
+	1: "profileVisibility",
+	2: "hideLikedVideos",
+	3: "whoCanMessage",
+	4: "whoCanFollow",
+	5: "disableSearchHistory",
+}
+
 type Video struct {
-	VideoId         int64   `thrift:"video_id,1" frugal:"1,default,i64" json:"video_id"`
-	UserId          int64   `thrift:"user_id,2" frugal:"2,default,i64" json:"user_id"`
-	Title           string  `thrift:"title,3" frugal:"3,default,string" json:"title"`
-	Description     string  `thrift:"description,4" frugal:"4,default,string" json:"description"`
-	CoverUrl        string  `thrift:"cover_url,5" frugal:"5,default,string" json:"cover_url"`
-	VideoUrl        string  `thrift:"video_url,6" frugal:"6,default,string" json:"video_url"`
-	DurationSeconds int64   `thrift:"duration_seconds,7" frugal:"7,default,i64" json:"duration_seconds"`
-	Views           int64   `thrift:"views,8" frugal:"8,default,i64" json:"views"`
-	Likes           int64   `thrift:"likes,9" frugal:"9,default,i64" json:"likes"`
-	Comments        int64   `thrift:"comments,10" frugal:"10,default,i64" json:"comments"`
-	HotScore        float64 `thrift:"hot_score,11" frugal:"11,default,double" json:"hot_score"`
-	CreatedAt       int64   `thrift:"created_at,12" frugal:"12,default,i64" json:"created_at"`
+	VideoId          int64    `thrift:"video_id,1" frugal:"1,default,i64" json:"video_id"`
+	UserId           int64    `thrift:"user_id,2" frugal:"2,default,i64" json:"user_id"`
+	Title            string   `thrift:"title,3" frugal:"3,default,string" json:"title"`
+	Description      string   `thrift:"description,4" frugal:"4,default,string" json:"description"`
+	CoverUrl         string   `thrift:"cover_url,5" frugal:"5,default,string" json:"cover_url"`
+	VideoUrl         string   `thrift:"video_url,6" frugal:"6,default,string" json:"video_url"`
+	DurationSeconds  int64    `thrift:"duration_seconds,7" frugal:"7,default,i64" json:"duration_seconds"`
+	Views            int64    `thrift:"views,8" frugal:"8,default,i64" json:"views"`
+	Likes            int64    `thrift:"likes,9" frugal:"9,default,i64" json:"likes"`
+	Comments         int64    `thrift:"comments,10" frugal:"10,default,i64" json:"comments"`
+	HotScore         float64  `thrift:"hot_score,11" frugal:"11,default,double" json:"hot_score"`
+	CreatedAt        int64    `thrift:"created_at,12" frugal:"12,default,i64" json:"created_at"`
+	Status           *string  `thrift:"status,13,optional" frugal:"13,optional,string" json:"status,omitempty"`
+	PublishAt        *int64   `thrift:"publish_at,14,optional" frugal:"14,optional,i64" json:"publish_at,omitempty"`
+	Tags             []string `thrift:"tags,15,optional" frugal:"15,optional,list<string>" json:"tags,omitempty"`
+	ProcessingStatus *string  `thrift:"processing_status,16,optional" frugal:"16,optional,string" json:"processing_status,omitempty"`
+	Visibility       *string  `thrift:"visibility,17,optional" frugal:"17,optional,string" json:"visibility,omitempty"`
+}
+
+func NewVideo() *Video {
+	return &Video{}
+}
+
+func (p *Video) InitDefault() {
+}
+
+func (p *Video) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *Video) GetUserId() (v int64) {
+	return p.UserId
+}
+
+func (p *Video) GetTitle() (v string) {
+	return p.Title
+}
+
+func (p *Video) GetDescription() (v string) {
+	return p.Description
+}
+
+func (p *Video) GetCoverUrl() (v string) {
+	return p.CoverUrl
+}
+
+func (p *Video) GetVideoUrl() (v string) {
+	return p.VideoUrl
+}
+
+func (p *Video) GetDurationSeconds() (v int64) {
+	return p.DurationSeconds
+}
+
+func (p *Video) GetViews() (v int64) {
+	return p.Views
+}
+
+func (p *Video) GetLikes() (v int64) {
+	return p.Likes
+}
+
+func (p *Video) GetComments() (v int64) {
+	return p.Comments
+}
+
+func (p *Video) GetHotScore() (v float64) {
+	return p.HotScore
+}
+
+func (p *Video) GetCreatedAt() (v int64) {
+	return p.CreatedAt
+}
+
+var Video_Status_DEFAULT string
+
+func (p *Video) GetStatus() (v string) {
+	if !p.IsSetStatus() {
+		return Video_Status_DEFAULT
+	}
+	return *p.Status
+}
+
+var Video_PublishAt_DEFAULT int64
+
+func (p *Video) GetPublishAt() (v int64) {
+	if !p.IsSetPublishAt() {
+		return Video_PublishAt_DEFAULT
+	}
+	return *p.PublishAt
+}
+
+var Video_Tags_DEFAULT []string
+
+func (p *Video) GetTags() (v []string) {
+	if !p.IsSetTags() {
+		return Video_Tags_DEFAULT
+	}
+	return p.Tags
+}
+
+var Video_ProcessingStatus_DEFAULT string
+
+func (p *Video) GetProcessingStatus() (v string) {
+	if !p.IsSetProcessingStatus() {
+		return Video_ProcessingStatus_DEFAULT
+	}
+	return *p.ProcessingStatus
+}
+
+var Video_Visibility_DEFAULT string
+
+func (p *Video) GetVisibility() (v string) {
+	if !p.IsSetVisibility() {
+		return Video_Visibility_DEFAULT
+	}
+	return *p.Visibility
+}
+func (p *Video) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *Video) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *Video) SetTitle(val string) {
+	p.Title = val
+}
+func (p *Video) SetDescription(val string) {
+	p.Description = val
+}
+func (p *Video) SetCoverUrl(val string) {
+	p.CoverUrl = val
+}
+func (p *Video) SetVideoUrl(val string) {
+	p.VideoUrl = val
+}
+func (p *Video) SetDurationSeconds(val int64) {
+	p.DurationSeconds = val
+}
+func (p *Video) SetViews(val int64) {
+	p.Views = val
+}
+func (p *Video) SetLikes(val int64) {
+	p.Likes = val
+}
+func (p *Video) SetComments(val int64) {
+	p.Comments = val
+}
+func (p *Video) SetHotScore(val float64) {
+	p.HotScore = val
+}
+func (p *Video) SetCreatedAt(val int64) {
+	p.CreatedAt = val
+}
+func (p *Video) SetStatus(val *string) {
+	p.Status = val
+}
+func (p *Video) SetPublishAt(val *int64) {
+	p.PublishAt = val
+}
+func (p *Video) SetTags(val []string) {
+	p.Tags = val
+}
+func (p *Video) SetProcessingStatus(val *string) {
+	p.ProcessingStatus = val
+}
+func (p *Video) SetVisibility(val *string) {
+	p.Visibility = val
+}
+
+func (p *Video) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *Video) IsSetPublishAt() bool {
+	return p.PublishAt != nil
+}
+
+func (p *Video) IsSetTags() bool {
+	return p.Tags != nil
+}
+
+func (p *Video) IsSetProcessingStatus() bool {
+	return p.ProcessingStatus != nil
+}
+
+func (p *Video) IsSetVisibility() bool {
+	return p.Visibility != nil
+}
+
+func (p *Video) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("Video(%+v)", *p)
+}
+
+func (p *Video) DeepEqual(ano *Video) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.VideoId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Title) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Description) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.CoverUrl) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.VideoUrl) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.DurationSeconds) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.Views) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.Likes) {
+		return false
+	}
+	if !p.Field10DeepEqual(ano.Comments) {
+		return false
+	}
+	if !p.Field11DeepEqual(ano.HotScore) {
+		return false
+	}
+	if !p.Field12DeepEqual(ano.CreatedAt) {
+		return false
+	}
+	if !p.Field13DeepEqual(ano.Status) {
+		return false
+	}
+	if !p.Field14DeepEqual(ano.PublishAt) {
+		return false
+	}
+	if !p.Field15DeepEqual(ano.Tags) {
+		return false
+	}
+	if !p.Field16DeepEqual(ano.ProcessingStatus) {
+		return false
+	}
+	if !p.Field17DeepEqual(ano.Visibility) {
+		return false
+	}
+	return true
+}
+
+func (p *Video) Field1DeepEqual(src int64) bool {
+
+	if p.VideoId != src {
+		return false
+	}
+	return true
+}
+func (p *Video) Field2DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *Video) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Title, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Video) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.Description, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Video) Field5DeepEqual(src string) bool {
+
+	if strings.Compare(p.CoverUrl, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Video) Field6DeepEqual(src string) bool {
+
+	if strings.Compare(p.VideoUrl, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Video) Field7DeepEqual(src int64) bool {
+
+	if p.DurationSeconds != src {
+		return false
+	}
+	return true
+}
+func (p *Video) Field8DeepEqual(src int64) bool {
+
+	if p.Views != src {
+		return false
+	}
+	return true
+}
+func (p *Video) Field9DeepEqual(src int64) bool {
+
+	if p.Likes != src {
+		return false
+	}
+	return true
+}
+func (p *Video) Field10DeepEqual(src int64) bool {
+
+	if p.Comments != src {
+		return false
+	}
+	return true
+}
+func (p *Video) Field11DeepEqual(src float64) bool {
+
+	if p.HotScore != src {
+		return false
+	}
+	return true
+}
+func (p *Video) Field12DeepEqual(src int64) bool {
+
+	if p.CreatedAt != src {
+		return false
+	}
+	return true
+}
+func (p *Video) Field13DeepEqual(src *string) bool {
+
+	if p.Status == src {
+		return true
+	} else if p.Status == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Status, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Video) Field14DeepEqual(src *int64) bool {
+
+	if p.PublishAt == src {
+		return true
+	} else if p.PublishAt == nil || src == nil {
+		return false
+	}
+	if *p.PublishAt != *src {
+		return false
+	}
+	return true
+}
+func (p *Video) Field15DeepEqual(src []string) bool {
+
+	if len(p.Tags) != len(src) {
+		return false
+	}
+	for i, v := range p.Tags {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *Video) Field16DeepEqual(src *string) bool {
+
+	if p.ProcessingStatus == src {
+		return true
+	} else if p.ProcessingStatus == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.ProcessingStatus, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *Video) Field17DeepEqual(src *string) bool {
+
+	if p.Visibility == src {
+		return true
+	} else if p.Visibility == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Visibility, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_Video = map[int16]string{
+	1:  "video_id",
+	2:  "user_id",
+	3:  "title",
+	4:  "description",
+	5:  "cover_url",
+	6:  "video_url",
+	7:  "duration_seconds",
+	8:  "views",
+	9:  "likes",
+	10: "comments",
+	11: "hot_score",
+	12: "created_at",
+	13: "status",
+	14: "publish_at",
+	15: "tags",
+	16: "processing_status",
+	17: "visibility",
+}
+
+type TagCount struct {
+	Tag   string `thrift:"tag,1" frugal:"1,default,string" json:"tag"`
+	Count int64  `thrift:"count,2" frugal:"2,default,i64" json:"count"`
+}
+
+func NewTagCount() *TagCount {
+	return &TagCount{}
+}
+
+func (p *TagCount) InitDefault() {
+}
+
+func (p *TagCount) GetTag() (v string) {
+	return p.Tag
+}
+
+func (p *TagCount) GetCount() (v int64) {
+	return p.Count
+}
+func (p *TagCount) SetTag(val string) {
+	p.Tag = val
+}
+func (p *TagCount) SetCount(val int64) {
+	p.Count = val
+}
+
+func (p *TagCount) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TagCount(%+v)", *p)
 }
 
-func NewVideo() *Video {
-	return &Video{}
+func (p *TagCount) DeepEqual(ano *TagCount) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Tag) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Count) {
+		return false
+	}
+	return true
 }
 
-func (p *Video) InitDefault() {
+func (p *TagCount) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Tag, src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *TagCount) Field2DeepEqual(src int64) bool {
 
-func (p *Video) GetVideoId() (v int64) {
-	return p.VideoId
+	if p.Count != src {
+		return false
+	}
+	return true
 }
 
-func (p *Video) GetUserId() (v int64) {
-	return p.UserId
+var fieldIDToName_TagCount = map[int16]string{
+	1: "tag",
+	2: "count",
 }
 
-func (p *Video) GetTitle() (v string) {
-	return p.Title
+type SearchTrend struct {
+	Keyword string  `thrift:"keyword,1" frugal:"1,default,string" json:"keyword"`
+	Score   float64 `thrift:"score,2" frugal:"2,default,double" json:"score"`
 }
 
-func (p *Video) GetDescription() (v string) {
-	return p.Description
+func NewSearchTrend() *SearchTrend {
+	return &SearchTrend{}
 }
 
-func (p *Video) GetCoverUrl() (v string) {
-	return p.CoverUrl
+func (p *SearchTrend) InitDefault() {
 }
 
-func (p *Video) GetVideoUrl() (v string) {
-	return p.VideoUrl
+func (p *SearchTrend) GetKeyword() (v string) {
+	return p.Keyword
 }
 
-func (p *Video) GetDurationSeconds() (v int64) {
-	return p.DurationSeconds
+func (p *SearchTrend) GetScore() (v float64) {
+	return p.Score
+}
+func (p *SearchTrend) SetKeyword(val string) {
+	p.Keyword = val
+}
+func (p *SearchTrend) SetScore(val float64) {
+	p.Score = val
 }
 
-func (p *Video) GetViews() (v int64) {
-	return p.Views
+func (p *SearchTrend) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchTrend(%+v)", *p)
 }
 
-func (p *Video) GetLikes() (v int64) {
-	return p.Likes
+func (p *SearchTrend) DeepEqual(ano *SearchTrend) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Keyword) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Score) {
+		return false
+	}
+	return true
 }
 
-func (p *Video) GetComments() (v int64) {
-	return p.Comments
+func (p *SearchTrend) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Keyword, src) != 0 {
+		return false
+	}
+	return true
 }
+func (p *SearchTrend) Field2DeepEqual(src float64) bool {
 
-func (p *Video) GetHotScore() (v float64) {
-	return p.HotScore
+	if p.Score != src {
+		return false
+	}
+	return true
 }
 
-func (p *Video) GetCreatedAt() (v int64) {
-	return p.CreatedAt
+var fieldIDToName_SearchTrend = map[int16]string{
+	1: "keyword",
+	2: "score",
 }
-func (p *Video) SetVideoId(val int64) {
-	p.VideoId = val
+
+type VideoProcessingStatus struct {
+	VideoId    int64  `thrift:"video_id,1" frugal:"1,default,i64" json:"video_id"`
+	Status     string `thrift:"status,2" frugal:"2,default,string" json:"status"`
+	Progress   int64  `thrift:"progress,3" frugal:"3,default,i64" json:"progress"`
+	FailReason string `thrift:"fail_reason,4" frugal:"4,default,string" json:"fail_reason"`
+	UpdatedAt  int64  `thrift:"updated_at,5" frugal:"5,default,i64" json:"updated_at"`
 }
-func (p *Video) SetUserId(val int64) {
-	p.UserId = val
+
+func NewVideoProcessingStatus() *VideoProcessingStatus {
+	return &VideoProcessingStatus{}
 }
-func (p *Video) SetTitle(val string) {
-	p.Title = val
+
+func (p *VideoProcessingStatus) InitDefault() {
 }
-func (p *Video) SetDescription(val string) {
-	p.Description = val
+
+func (p *VideoProcessingStatus) GetVideoId() (v int64) {
+	return p.VideoId
 }
-func (p *Video) SetCoverUrl(val string) {
-	p.CoverUrl = val
+
+func (p *VideoProcessingStatus) GetStatus() (v string) {
+	return p.Status
 }
-func (p *Video) SetVideoUrl(val string) {
-	p.VideoUrl = val
+
+func (p *VideoProcessingStatus) GetProgress() (v int64) {
+	return p.Progress
 }
-func (p *Video) SetDurationSeconds(val int64) {
-	p.DurationSeconds = val
+
+func (p *VideoProcessingStatus) GetFailReason() (v string) {
+	return p.FailReason
 }
-func (p *Video) SetViews(val int64) {
-	p.Views = val
+
+func (p *VideoProcessingStatus) GetUpdatedAt() (v int64) {
+	return p.UpdatedAt
 }
-func (p *Video) SetLikes(val int64) {
-	p.Likes = val
+func (p *VideoProcessingStatus) SetVideoId(val int64) {
+	p.VideoId = val
 }
-func (p *Video) SetComments(val int64) {
-	p.Comments = val
+func (p *VideoProcessingStatus) SetStatus(val string) {
+	p.Status = val
 }
-func (p *Video) SetHotScore(val float64) {
-	p.HotScore = val
+func (p *VideoProcessingStatus) SetProgress(val int64) {
+	p.Progress = val
 }
-func (p *Video) SetCreatedAt(val int64) {
-	p.CreatedAt = val
+func (p *VideoProcessingStatus) SetFailReason(val string) {
+	p.FailReason = val
+}
+func (p *VideoProcessingStatus) SetUpdatedAt(val int64) {
+	p.UpdatedAt = val
 }
 
-func (p *Video) String() string {
+func (p *VideoProcessingStatus) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("Video(%+v)", *p)
+	return fmt.Sprintf("VideoProcessingStatus(%+v)", *p)
 }
 
-func (p *Video) DeepEqual(ano *Video) bool {
+func (p *VideoProcessingStatus) DeepEqual(ano *VideoProcessingStatus) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -670,138 +1639,323 @@ func (p *Video) DeepEqual(ano *Video) bool {
 	if !p.Field1DeepEqual(ano.VideoId) {
 		return false
 	}
-	if !p.Field2DeepEqual(ano.UserId) {
+	if !p.Field2DeepEqual(ano.Status) {
 		return false
 	}
-	if !p.Field3DeepEqual(ano.Title) {
+	if !p.Field3DeepEqual(ano.Progress) {
 		return false
 	}
-	if !p.Field4DeepEqual(ano.Description) {
+	if !p.Field4DeepEqual(ano.FailReason) {
 		return false
 	}
-	if !p.Field5DeepEqual(ano.CoverUrl) {
+	if !p.Field5DeepEqual(ano.UpdatedAt) {
 		return false
 	}
-	if !p.Field6DeepEqual(ano.VideoUrl) {
+	return true
+}
+
+func (p *VideoProcessingStatus) Field1DeepEqual(src int64) bool {
+
+	if p.VideoId != src {
 		return false
 	}
-	if !p.Field7DeepEqual(ano.DurationSeconds) {
+	return true
+}
+func (p *VideoProcessingStatus) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Status, src) != 0 {
 		return false
 	}
-	if !p.Field8DeepEqual(ano.Views) {
+	return true
+}
+func (p *VideoProcessingStatus) Field3DeepEqual(src int64) bool {
+
+	if p.Progress != src {
 		return false
 	}
-	if !p.Field9DeepEqual(ano.Likes) {
+	return true
+}
+func (p *VideoProcessingStatus) Field4DeepEqual(src string) bool {
+
+	if strings.Compare(p.FailReason, src) != 0 {
 		return false
 	}
-	if !p.Field10DeepEqual(ano.Comments) {
+	return true
+}
+func (p *VideoProcessingStatus) Field5DeepEqual(src int64) bool {
+
+	if p.UpdatedAt != src {
 		return false
 	}
-	if !p.Field11DeepEqual(ano.HotScore) {
+	return true
+}
+
+var fieldIDToName_VideoProcessingStatus = map[int16]string{
+	1: "video_id",
+	2: "status",
+	3: "progress",
+	4: "fail_reason",
+	5: "updated_at",
+}
+
+type VideoUpload struct {
+	UploadId       int64   `thrift:"upload_id,1" frugal:"1,default,i64" json:"upload_id"`
+	FileSize       int64   `thrift:"file_size,2" frugal:"2,default,i64" json:"file_size"`
+	ChunkSize      int64   `thrift:"chunk_size,3" frugal:"3,default,i64" json:"chunk_size"`
+	TotalChunks    int64   `thrift:"total_chunks,4" frugal:"4,default,i64" json:"total_chunks"`
+	UploadedChunks []int64 `thrift:"uploaded_chunks,5" frugal:"5,default,list<i64>" json:"uploaded_chunks"`
+	ExpiresAt      int64   `thrift:"expires_at,6" frugal:"6,default,i64" json:"expires_at"`
+}
+
+func NewVideoUpload() *VideoUpload {
+	return &VideoUpload{}
+}
+
+func (p *VideoUpload) InitDefault() {
+}
+
+func (p *VideoUpload) GetUploadId() (v int64) {
+	return p.UploadId
+}
+
+func (p *VideoUpload) GetFileSize() (v int64) {
+	return p.FileSize
+}
+
+func (p *VideoUpload) GetChunkSize() (v int64) {
+	return p.ChunkSize
+}
+
+func (p *VideoUpload) GetTotalChunks() (v int64) {
+	return p.TotalChunks
+}
+
+func (p *VideoUpload) GetUploadedChunks() (v []int64) {
+	return p.UploadedChunks
+}
+
+func (p *VideoUpload) GetExpiresAt() (v int64) {
+	return p.ExpiresAt
+}
+func (p *VideoUpload) SetUploadId(val int64) {
+	p.UploadId = val
+}
+func (p *VideoUpload) SetFileSize(val int64) {
+	p.FileSize = val
+}
+func (p *VideoUpload) SetChunkSize(val int64) {
+	p.ChunkSize = val
+}
+func (p *VideoUpload) SetTotalChunks(val int64) {
+	p.TotalChunks = val
+}
+func (p *VideoUpload) SetUploadedChunks(val []int64) {
+	p.UploadedChunks = val
+}
+func (p *VideoUpload) SetExpiresAt(val int64) {
+	p.ExpiresAt = val
+}
+
+func (p *VideoUpload) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoUpload(%+v)", *p)
+}
+
+func (p *VideoUpload) DeepEqual(ano *VideoUpload) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field12DeepEqual(ano.CreatedAt) {
+	if !p.Field1DeepEqual(ano.UploadId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.FileSize) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ChunkSize) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.TotalChunks) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.UploadedChunks) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.ExpiresAt) {
 		return false
 	}
 	return true
 }
 
-func (p *Video) Field1DeepEqual(src int64) bool {
+func (p *VideoUpload) Field1DeepEqual(src int64) bool {
 
-	if p.VideoId != src {
+	if p.UploadId != src {
 		return false
 	}
 	return true
 }
-func (p *Video) Field2DeepEqual(src int64) bool {
+func (p *VideoUpload) Field2DeepEqual(src int64) bool {
 
-	if p.UserId != src {
+	if p.FileSize != src {
 		return false
 	}
 	return true
 }
-func (p *Video) Field3DeepEqual(src string) bool {
+func (p *VideoUpload) Field3DeepEqual(src int64) bool {
 
-	if strings.Compare(p.Title, src) != 0 {
+	if p.ChunkSize != src {
 		return false
 	}
 	return true
 }
-func (p *Video) Field4DeepEqual(src string) bool {
+func (p *VideoUpload) Field4DeepEqual(src int64) bool {
 
-	if strings.Compare(p.Description, src) != 0 {
+	if p.TotalChunks != src {
 		return false
 	}
 	return true
 }
-func (p *Video) Field5DeepEqual(src string) bool {
+func (p *VideoUpload) Field5DeepEqual(src []int64) bool {
 
-	if strings.Compare(p.CoverUrl, src) != 0 {
+	if len(p.UploadedChunks) != len(src) {
 		return false
 	}
+	for i, v := range p.UploadedChunks {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
 	return true
 }
-func (p *Video) Field6DeepEqual(src string) bool {
+func (p *VideoUpload) Field6DeepEqual(src int64) bool {
 
-	if strings.Compare(p.VideoUrl, src) != 0 {
+	if p.ExpiresAt != src {
 		return false
 	}
 	return true
 }
-func (p *Video) Field7DeepEqual(src int64) bool {
 
-	if p.DurationSeconds != src {
-		return false
+var fieldIDToName_VideoUpload = map[int16]string{
+	1: "upload_id",
+	2: "file_size",
+	3: "chunk_size",
+	4: "total_chunks",
+	5: "uploaded_chunks",
+	6: "expires_at",
+}
+
+type DirectUpload struct {
+	UploadId  int64             `thrift:"upload_id,1" frugal:"1,default,i64" json:"upload_id"`
+	Url       string            `thrift:"url,2" frugal:"2,default,string" json:"url"`
+	FormData  map[string]string `thrift:"form_data,3" frugal:"3,default,map<string:string>" json:"form_data"`
+	ExpiresAt int64             `thrift:"expires_at,4" frugal:"4,default,i64" json:"expires_at"`
+}
+
+func NewDirectUpload() *DirectUpload {
+	return &DirectUpload{}
+}
+
+func (p *DirectUpload) InitDefault() {
+}
+
+func (p *DirectUpload) GetUploadId() (v int64) {
+	return p.UploadId
+}
+
+func (p *DirectUpload) GetUrl() (v string) {
+	return p.Url
+}
+
+func (p *DirectUpload) GetFormData() (v map[string]string) {
+	return p.FormData
+}
+
+func (p *DirectUpload) GetExpiresAt() (v int64) {
+	return p.ExpiresAt
+}
+func (p *DirectUpload) SetUploadId(val int64) {
+	p.UploadId = val
+}
+func (p *DirectUpload) SetUrl(val string) {
+	p.Url = val
+}
+func (p *DirectUpload) SetFormData(val map[string]string) {
+	p.FormData = val
+}
+func (p *DirectUpload) SetExpiresAt(val int64) {
+	p.ExpiresAt = val
+}
+
+func (p *DirectUpload) String() string {
+	if p == nil {
+		return "<nil>"
 	}
-	return true
+	return fmt.Sprintf("DirectUpload(%+v)", *p)
 }
-func (p *Video) Field8DeepEqual(src int64) bool {
 
-	if p.Views != src {
+func (p *DirectUpload) DeepEqual(ano *DirectUpload) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UploadId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Url) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.FormData) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.ExpiresAt) {
 		return false
 	}
 	return true
 }
-func (p *Video) Field9DeepEqual(src int64) bool {
 
-	if p.Likes != src {
+func (p *DirectUpload) Field1DeepEqual(src int64) bool {
+
+	if p.UploadId != src {
 		return false
 	}
 	return true
 }
-func (p *Video) Field10DeepEqual(src int64) bool {
+func (p *DirectUpload) Field2DeepEqual(src string) bool {
 
-	if p.Comments != src {
+	if strings.Compare(p.Url, src) != 0 {
 		return false
 	}
 	return true
 }
-func (p *Video) Field11DeepEqual(src float64) bool {
+func (p *DirectUpload) Field3DeepEqual(src map[string]string) bool {
 
-	if p.HotScore != src {
+	if len(p.FormData) != len(src) {
 		return false
 	}
+	for k, v := range p.FormData {
+		_src := src[k]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
 	return true
 }
-func (p *Video) Field12DeepEqual(src int64) bool {
+func (p *DirectUpload) Field4DeepEqual(src int64) bool {
 
-	if p.CreatedAt != src {
+	if p.ExpiresAt != src {
 		return false
 	}
 	return true
 }
 
-var fieldIDToName_Video = map[int16]string{
-	1:  "video_id",
-	2:  "user_id",
-	3:  "title",
-	4:  "description",
-	5:  "cover_url",
-	6:  "video_url",
-	7:  "duration_seconds",
-	8:  "views",
-	9:  "likes",
-	10: "comments",
-	11: "hot_score",
-	12: "created_at",
+var fieldIDToName_DirectUpload = map[int16]string{
+	1: "upload_id",
+	2: "url",
+	3: "form_data",
+	4: "expires_at",
 }
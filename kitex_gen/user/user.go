@@ -12,10 +12,11 @@ import (
 )
 
 type RegisterRequest struct {
-	Username string `thrift:"username,1,required" frugal:"1,required,string" json:"username"`
-	Password string `thrift:"password,2,required" frugal:"2,required,string" json:"password"`
-	Email    string `thrift:"email,3,required" frugal:"3,required,string" json:"email"`
-	Phone    string `thrift:"phone,4,required" frugal:"4,required,string" json:"phone"`
+	Username   string  `thrift:"username,1,required" frugal:"1,required,string" json:"username"`
+	Password   string  `thrift:"password,2,required" frugal:"2,required,string" json:"password"`
+	Email      string  `thrift:"email,3,required" frugal:"3,required,string" json:"email"`
+	Phone      string  `thrift:"phone,4,required" frugal:"4,required,string" json:"phone"`
+	InviteCode *string `thrift:"inviteCode,5,optional" frugal:"5,optional,string" json:"inviteCode,omitempty"`
 }
 
 func NewRegisterRequest() *RegisterRequest {
@@ -40,6 +41,15 @@ func (p *RegisterRequest) GetEmail() (v string) {
 func (p *RegisterRequest) GetPhone() (v string) {
 	return p.Phone
 }
+
+var RegisterRequest_InviteCode_DEFAULT string
+
+func (p *RegisterRequest) GetInviteCode() (v string) {
+	if !p.IsSetInviteCode() {
+		return RegisterRequest_InviteCode_DEFAULT
+	}
+	return *p.InviteCode
+}
 func (p *RegisterRequest) SetUsername(val string) {
 	p.Username = val
 }
@@ -52,6 +62,13 @@ func (p *RegisterRequest) SetEmail(val string) {
 func (p *RegisterRequest) SetPhone(val string) {
 	p.Phone = val
 }
+func (p *RegisterRequest) SetInviteCode(val *string) {
+	p.InviteCode = val
+}
+
+func (p *RegisterRequest) IsSetInviteCode() bool {
+	return p.InviteCode != nil
+}
 
 func (p *RegisterRequest) String() string {
 	if p == nil {
@@ -78,6 +95,9 @@ func (p *RegisterRequest) DeepEqual(ano *RegisterRequest) bool {
 	if !p.Field4DeepEqual(ano.Phone) {
 		return false
 	}
+	if !p.Field5DeepEqual(ano.InviteCode) {
+		return false
+	}
 	return true
 }
 
@@ -109,12 +129,25 @@ func (p *RegisterRequest) Field4DeepEqual(src string) bool {
 	}
 	return true
 }
+func (p *RegisterRequest) Field5DeepEqual(src *string) bool {
+
+	if p.InviteCode == src {
+		return true
+	} else if p.InviteCode == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.InviteCode, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 var fieldIDToName_RegisterRequest = map[int16]string{
 	1: "username",
 	2: "password",
 	3: "email",
 	4: "phone",
+	5: "inviteCode",
 }
 
 type RegisterResponse struct {
@@ -195,9 +228,11 @@ var fieldIDToName_RegisterResponse = map[int16]string{
 }
 
 type LoginRequest struct {
-	Username        string `thrift:"username,1" frugal:"1,default,string" json:"username"`
-	Password        string `thrift:"password,2" frugal:"2,default,string" json:"password"`
-	ConfirmPassword string `thrift:"confirm_password,3" frugal:"3,default,string" json:"confirm_password"`
+	Username        string  `thrift:"username,1" frugal:"1,default,string" json:"username"`
+	Password        string  `thrift:"password,2" frugal:"2,default,string" json:"password"`
+	ConfirmPassword string  `thrift:"confirm_password,3" frugal:"3,default,string" json:"confirm_password"`
+	Ip              *string `thrift:"ip,4,optional" frugal:"4,optional,string" json:"ip,omitempty"`
+	UserAgent       *string `thrift:"userAgent,5,optional" frugal:"5,optional,string" json:"userAgent,omitempty"`
 }
 
 func NewLoginRequest() *LoginRequest {
@@ -218,6 +253,24 @@ func (p *LoginRequest) GetPassword() (v string) {
 func (p *LoginRequest) GetConfirmPassword() (v string) {
 	return p.ConfirmPassword
 }
+
+var LoginRequest_Ip_DEFAULT string
+
+func (p *LoginRequest) GetIp() (v string) {
+	if !p.IsSetIp() {
+		return LoginRequest_Ip_DEFAULT
+	}
+	return *p.Ip
+}
+
+var LoginRequest_UserAgent_DEFAULT string
+
+func (p *LoginRequest) GetUserAgent() (v string) {
+	if !p.IsSetUserAgent() {
+		return LoginRequest_UserAgent_DEFAULT
+	}
+	return *p.UserAgent
+}
 func (p *LoginRequest) SetUsername(val string) {
 	p.Username = val
 }
@@ -227,6 +280,20 @@ func (p *LoginRequest) SetPassword(val string) {
 func (p *LoginRequest) SetConfirmPassword(val string) {
 	p.ConfirmPassword = val
 }
+func (p *LoginRequest) SetIp(val *string) {
+	p.Ip = val
+}
+func (p *LoginRequest) SetUserAgent(val *string) {
+	p.UserAgent = val
+}
+
+func (p *LoginRequest) IsSetIp() bool {
+	return p.Ip != nil
+}
+
+func (p *LoginRequest) IsSetUserAgent() bool {
+	return p.UserAgent != nil
+}
 
 func (p *LoginRequest) String() string {
 	if p == nil {
@@ -250,6 +317,12 @@ func (p *LoginRequest) DeepEqual(ano *LoginRequest) bool {
 	if !p.Field3DeepEqual(ano.ConfirmPassword) {
 		return false
 	}
+	if !p.Field4DeepEqual(ano.Ip) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.UserAgent) {
+		return false
+	}
 	return true
 }
 
@@ -274,16 +347,43 @@ func (p *LoginRequest) Field3DeepEqual(src string) bool {
 	}
 	return true
 }
+func (p *LoginRequest) Field4DeepEqual(src *string) bool {
+
+	if p.Ip == src {
+		return true
+	} else if p.Ip == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Ip, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *LoginRequest) Field5DeepEqual(src *string) bool {
+
+	if p.UserAgent == src {
+		return true
+	} else if p.UserAgent == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.UserAgent, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 var fieldIDToName_LoginRequest = map[int16]string{
 	1: "username",
 	2: "password",
 	3: "confirm_password",
+	4: "ip",
+	5: "userAgent",
 }
 
 type LoginResponse struct {
-	Base *model.BaseResp `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
-	User *model.UserInfo `thrift:"user,2" frugal:"2,default,model.UserInfo" json:"user"`
+	Base        *model.BaseResp `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	User        *model.UserInfo `thrift:"user,2" frugal:"2,default,model.UserInfo" json:"user"`
+	IsNewDevice bool            `thrift:"isNewDevice,3" frugal:"3,default,bool" json:"isNewDevice"`
 }
 
 func NewLoginResponse() *LoginResponse {
@@ -310,12 +410,19 @@ func (p *LoginResponse) GetUser() (v *model.UserInfo) {
 	}
 	return p.User
 }
+
+func (p *LoginResponse) GetIsNewDevice() (v bool) {
+	return p.IsNewDevice
+}
 func (p *LoginResponse) SetBase(val *model.BaseResp) {
 	p.Base = val
 }
 func (p *LoginResponse) SetUser(val *model.UserInfo) {
 	p.User = val
 }
+func (p *LoginResponse) SetIsNewDevice(val bool) {
+	p.IsNewDevice = val
+}
 
 func (p *LoginResponse) IsSetBase() bool {
 	return p.Base != nil
@@ -344,6 +451,9 @@ func (p *LoginResponse) DeepEqual(ano *LoginResponse) bool {
 	if !p.Field2DeepEqual(ano.User) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.IsNewDevice) {
+		return false
+	}
 	return true
 }
 
@@ -361,10 +471,18 @@ func (p *LoginResponse) Field2DeepEqual(src *model.UserInfo) bool {
 	}
 	return true
 }
+func (p *LoginResponse) Field3DeepEqual(src bool) bool {
+
+	if p.IsNewDevice != src {
+		return false
+	}
+	return true
+}
 
 var fieldIDToName_LoginResponse = map[int16]string{
 	1: "base",
 	2: "user",
+	3: "isNewDevice",
 }
 
 type UpdateUserProfileRequest struct {
@@ -684,297 +802,1748 @@ var fieldIDToName_GetUserProfileResponse = map[int16]string{
 	2: "userProfileResp",
 }
 
-type UserService interface {
-	Register(ctx context.Context, req *RegisterRequest) (r *RegisterResponse, err error)
-
-	Login(ctx context.Context, req *LoginRequest) (r *LoginResponse, err error)
-
-	UpdateProfile(ctx context.Context, req *UpdateUserProfileRequest) (r *UpdateUserProfileResponse, err error)
-
-	GetProfile(ctx context.Context, req *GetUserProfileRequest) (r *GetUserProfileResponse, err error)
+type ListSecurityEventsRequest struct {
+	PageNum  int64 `thrift:"pageNum,1,required" frugal:"1,required,i64" json:"pageNum"`
+	PageSize int64 `thrift:"pageSize,2,required" frugal:"2,required,i64" json:"pageSize"`
 }
 
-type UserServiceRegisterArgs struct {
-	Req *RegisterRequest `thrift:"req,1" frugal:"1,default,RegisterRequest" json:"req"`
+func NewListSecurityEventsRequest() *ListSecurityEventsRequest {
+	return &ListSecurityEventsRequest{}
 }
 
-func NewUserServiceRegisterArgs() *UserServiceRegisterArgs {
-	return &UserServiceRegisterArgs{}
+func (p *ListSecurityEventsRequest) InitDefault() {
 }
 
-func (p *UserServiceRegisterArgs) InitDefault() {
+func (p *ListSecurityEventsRequest) GetPageNum() (v int64) {
+	return p.PageNum
 }
 
-var UserServiceRegisterArgs_Req_DEFAULT *RegisterRequest
-
-func (p *UserServiceRegisterArgs) GetReq() (v *RegisterRequest) {
-	if !p.IsSetReq() {
-		return UserServiceRegisterArgs_Req_DEFAULT
-	}
-	return p.Req
+func (p *ListSecurityEventsRequest) GetPageSize() (v int64) {
+	return p.PageSize
 }
-func (p *UserServiceRegisterArgs) SetReq(val *RegisterRequest) {
-	p.Req = val
+func (p *ListSecurityEventsRequest) SetPageNum(val int64) {
+	p.PageNum = val
 }
-
-func (p *UserServiceRegisterArgs) IsSetReq() bool {
-	return p.Req != nil
+func (p *ListSecurityEventsRequest) SetPageSize(val int64) {
+	p.PageSize = val
 }
 
-func (p *UserServiceRegisterArgs) String() string {
+func (p *ListSecurityEventsRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceRegisterArgs(%+v)", *p)
+	return fmt.Sprintf("ListSecurityEventsRequest(%+v)", *p)
 }
 
-func (p *UserServiceRegisterArgs) DeepEqual(ano *UserServiceRegisterArgs) bool {
+func (p *ListSecurityEventsRequest) DeepEqual(ano *ListSecurityEventsRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Req) {
+	if !p.Field1DeepEqual(ano.PageNum) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.PageSize) {
 		return false
 	}
 	return true
 }
 
-func (p *UserServiceRegisterArgs) Field1DeepEqual(src *RegisterRequest) bool {
+func (p *ListSecurityEventsRequest) Field1DeepEqual(src int64) bool {
 
-	if !p.Req.DeepEqual(src) {
+	if p.PageNum != src {
 		return false
 	}
 	return true
 }
+func (p *ListSecurityEventsRequest) Field2DeepEqual(src int64) bool {
 
-var fieldIDToName_UserServiceRegisterArgs = map[int16]string{
-	1: "req",
+	if p.PageSize != src {
+		return false
+	}
+	return true
 }
 
-type UserServiceRegisterResult struct {
-	Success *RegisterResponse `thrift:"success,0,optional" frugal:"0,optional,RegisterResponse" json:"success,omitempty"`
+var fieldIDToName_ListSecurityEventsRequest = map[int16]string{
+	1: "pageNum",
+	2: "pageSize",
 }
 
-func NewUserServiceRegisterResult() *UserServiceRegisterResult {
-	return &UserServiceRegisterResult{}
+type ListSecurityEventsResponse struct {
+	Base   *model.BaseResp        `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	Events []*model.SecurityEvent `thrift:"events,2" frugal:"2,default,list<model.SecurityEvent>" json:"events"`
+	Total  int64                  `thrift:"total,3" frugal:"3,default,i64" json:"total"`
 }
 
-func (p *UserServiceRegisterResult) InitDefault() {
+func NewListSecurityEventsResponse() *ListSecurityEventsResponse {
+	return &ListSecurityEventsResponse{}
 }
 
-var UserServiceRegisterResult_Success_DEFAULT *RegisterResponse
+func (p *ListSecurityEventsResponse) InitDefault() {
+}
 
-func (p *UserServiceRegisterResult) GetSuccess() (v *RegisterResponse) {
-	if !p.IsSetSuccess() {
-		return UserServiceRegisterResult_Success_DEFAULT
+var ListSecurityEventsResponse_Base_DEFAULT *model.BaseResp
+
+func (p *ListSecurityEventsResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ListSecurityEventsResponse_Base_DEFAULT
 	}
-	return p.Success
+	return p.Base
 }
-func (p *UserServiceRegisterResult) SetSuccess(x interface{}) {
-	p.Success = x.(*RegisterResponse)
+
+func (p *ListSecurityEventsResponse) GetEvents() (v []*model.SecurityEvent) {
+	return p.Events
 }
 
-func (p *UserServiceRegisterResult) IsSetSuccess() bool {
-	return p.Success != nil
+func (p *ListSecurityEventsResponse) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *ListSecurityEventsResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ListSecurityEventsResponse) SetEvents(val []*model.SecurityEvent) {
+	p.Events = val
+}
+func (p *ListSecurityEventsResponse) SetTotal(val int64) {
+	p.Total = val
 }
 
-func (p *UserServiceRegisterResult) String() string {
+func (p *ListSecurityEventsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListSecurityEventsResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceRegisterResult(%+v)", *p)
+	return fmt.Sprintf("ListSecurityEventsResponse(%+v)", *p)
 }
 
-func (p *UserServiceRegisterResult) DeepEqual(ano *UserServiceRegisterResult) bool {
+func (p *ListSecurityEventsResponse) DeepEqual(ano *ListSecurityEventsResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field0DeepEqual(ano.Success) {
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Events) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Total) {
 		return false
 	}
 	return true
 }
 
-func (p *UserServiceRegisterResult) Field0DeepEqual(src *RegisterResponse) bool {
+func (p *ListSecurityEventsResponse) Field1DeepEqual(src *model.BaseResp) bool {
 
-	if !p.Success.DeepEqual(src) {
+	if !p.Base.DeepEqual(src) {
 		return false
 	}
 	return true
 }
+func (p *ListSecurityEventsResponse) Field2DeepEqual(src []*model.SecurityEvent) bool {
 
-var fieldIDToName_UserServiceRegisterResult = map[int16]string{
-	0: "success",
+	if len(p.Events) != len(src) {
+		return false
+	}
+	for i, v := range p.Events {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
 }
+func (p *ListSecurityEventsResponse) Field3DeepEqual(src int64) bool {
 
-type UserServiceLoginArgs struct {
-	Req *LoginRequest `thrift:"req,1" frugal:"1,default,LoginRequest" json:"req"`
+	if p.Total != src {
+		return false
+	}
+	return true
 }
 
-func NewUserServiceLoginArgs() *UserServiceLoginArgs {
-	return &UserServiceLoginArgs{}
+var fieldIDToName_ListSecurityEventsResponse = map[int16]string{
+	1: "base",
+	2: "events",
+	3: "total",
 }
 
-func (p *UserServiceLoginArgs) InitDefault() {
+type MintInviteCodesRequest struct {
+	Count         int64 `thrift:"count,1,required" frugal:"1,required,i64" json:"count"`
+	MaxUses       int64 `thrift:"maxUses,2,required" frugal:"2,required,i64" json:"maxUses"`
+	ExpireSeconds int64 `thrift:"expireSeconds,3" frugal:"3,default,i64" json:"expireSeconds"`
 }
 
-var UserServiceLoginArgs_Req_DEFAULT *LoginRequest
+func NewMintInviteCodesRequest() *MintInviteCodesRequest {
+	return &MintInviteCodesRequest{}
+}
 
-func (p *UserServiceLoginArgs) GetReq() (v *LoginRequest) {
-	if !p.IsSetReq() {
-		return UserServiceLoginArgs_Req_DEFAULT
-	}
-	return p.Req
+func (p *MintInviteCodesRequest) InitDefault() {
 }
-func (p *UserServiceLoginArgs) SetReq(val *LoginRequest) {
-	p.Req = val
+
+func (p *MintInviteCodesRequest) GetCount() (v int64) {
+	return p.Count
 }
 
-func (p *UserServiceLoginArgs) IsSetReq() bool {
-	return p.Req != nil
+func (p *MintInviteCodesRequest) GetMaxUses() (v int64) {
+	return p.MaxUses
 }
 
-func (p *UserServiceLoginArgs) String() string {
+func (p *MintInviteCodesRequest) GetExpireSeconds() (v int64) {
+	return p.ExpireSeconds
+}
+func (p *MintInviteCodesRequest) SetCount(val int64) {
+	p.Count = val
+}
+func (p *MintInviteCodesRequest) SetMaxUses(val int64) {
+	p.MaxUses = val
+}
+func (p *MintInviteCodesRequest) SetExpireSeconds(val int64) {
+	p.ExpireSeconds = val
+}
+
+func (p *MintInviteCodesRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLoginArgs(%+v)", *p)
+	return fmt.Sprintf("MintInviteCodesRequest(%+v)", *p)
 }
 
-func (p *UserServiceLoginArgs) DeepEqual(ano *UserServiceLoginArgs) bool {
+func (p *MintInviteCodesRequest) DeepEqual(ano *MintInviteCodesRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Req) {
+	if !p.Field1DeepEqual(ano.Count) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.MaxUses) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ExpireSeconds) {
 		return false
 	}
 	return true
 }
 
-func (p *UserServiceLoginArgs) Field1DeepEqual(src *LoginRequest) bool {
+func (p *MintInviteCodesRequest) Field1DeepEqual(src int64) bool {
 
-	if !p.Req.DeepEqual(src) {
+	if p.Count != src {
 		return false
 	}
 	return true
 }
+func (p *MintInviteCodesRequest) Field2DeepEqual(src int64) bool {
 
-var fieldIDToName_UserServiceLoginArgs = map[int16]string{
-	1: "req",
+	if p.MaxUses != src {
+		return false
+	}
+	return true
 }
+func (p *MintInviteCodesRequest) Field3DeepEqual(src int64) bool {
 
-type UserServiceLoginResult struct {
-	Success *LoginResponse `thrift:"success,0,optional" frugal:"0,optional,LoginResponse" json:"success,omitempty"`
+	if p.ExpireSeconds != src {
+		return false
+	}
+	return true
 }
 
-func NewUserServiceLoginResult() *UserServiceLoginResult {
-	return &UserServiceLoginResult{}
+var fieldIDToName_MintInviteCodesRequest = map[int16]string{
+	1: "count",
+	2: "maxUses",
+	3: "expireSeconds",
 }
 
-func (p *UserServiceLoginResult) InitDefault() {
+type MintInviteCodesResponse struct {
+	Base  *model.BaseResp     `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	Codes []*model.InviteCode `thrift:"codes,2" frugal:"2,default,list<model.InviteCode>" json:"codes"`
 }
 
-var UserServiceLoginResult_Success_DEFAULT *LoginResponse
+func NewMintInviteCodesResponse() *MintInviteCodesResponse {
+	return &MintInviteCodesResponse{}
+}
 
-func (p *UserServiceLoginResult) GetSuccess() (v *LoginResponse) {
-	if !p.IsSetSuccess() {
-		return UserServiceLoginResult_Success_DEFAULT
+func (p *MintInviteCodesResponse) InitDefault() {
+}
+
+var MintInviteCodesResponse_Base_DEFAULT *model.BaseResp
+
+func (p *MintInviteCodesResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return MintInviteCodesResponse_Base_DEFAULT
 	}
-	return p.Success
+	return p.Base
 }
-func (p *UserServiceLoginResult) SetSuccess(x interface{}) {
-	p.Success = x.(*LoginResponse)
+
+func (p *MintInviteCodesResponse) GetCodes() (v []*model.InviteCode) {
+	return p.Codes
+}
+func (p *MintInviteCodesResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *MintInviteCodesResponse) SetCodes(val []*model.InviteCode) {
+	p.Codes = val
 }
 
-func (p *UserServiceLoginResult) IsSetSuccess() bool {
-	return p.Success != nil
+func (p *MintInviteCodesResponse) IsSetBase() bool {
+	return p.Base != nil
 }
 
-func (p *UserServiceLoginResult) String() string {
+func (p *MintInviteCodesResponse) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceLoginResult(%+v)", *p)
+	return fmt.Sprintf("MintInviteCodesResponse(%+v)", *p)
 }
 
-func (p *UserServiceLoginResult) DeepEqual(ano *UserServiceLoginResult) bool {
+func (p *MintInviteCodesResponse) DeepEqual(ano *MintInviteCodesResponse) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field0DeepEqual(ano.Success) {
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Codes) {
 		return false
 	}
 	return true
 }
 
-func (p *UserServiceLoginResult) Field0DeepEqual(src *LoginResponse) bool {
+func (p *MintInviteCodesResponse) Field1DeepEqual(src *model.BaseResp) bool {
 
-	if !p.Success.DeepEqual(src) {
+	if !p.Base.DeepEqual(src) {
 		return false
 	}
 	return true
 }
+func (p *MintInviteCodesResponse) Field2DeepEqual(src []*model.InviteCode) bool {
 
-var fieldIDToName_UserServiceLoginResult = map[int16]string{
-	0: "success",
+	if len(p.Codes) != len(src) {
+		return false
+	}
+	for i, v := range p.Codes {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
 }
 
-type UserServiceUpdateProfileArgs struct {
-	Req *UpdateUserProfileRequest `thrift:"req,1" frugal:"1,default,UpdateUserProfileRequest" json:"req"`
+var fieldIDToName_MintInviteCodesResponse = map[int16]string{
+	1: "base",
+	2: "codes",
 }
 
-func NewUserServiceUpdateProfileArgs() *UserServiceUpdateProfileArgs {
-	return &UserServiceUpdateProfileArgs{}
+type ListInviteCodesRequest struct {
+	PageNum  int64 `thrift:"pageNum,1,required" frugal:"1,required,i64" json:"pageNum"`
+	PageSize int64 `thrift:"pageSize,2,required" frugal:"2,required,i64" json:"pageSize"`
 }
 
-func (p *UserServiceUpdateProfileArgs) InitDefault() {
+func NewListInviteCodesRequest() *ListInviteCodesRequest {
+	return &ListInviteCodesRequest{}
 }
 
-var UserServiceUpdateProfileArgs_Req_DEFAULT *UpdateUserProfileRequest
-
-func (p *UserServiceUpdateProfileArgs) GetReq() (v *UpdateUserProfileRequest) {
-	if !p.IsSetReq() {
-		return UserServiceUpdateProfileArgs_Req_DEFAULT
-	}
-	return p.Req
+func (p *ListInviteCodesRequest) InitDefault() {
 }
-func (p *UserServiceUpdateProfileArgs) SetReq(val *UpdateUserProfileRequest) {
-	p.Req = val
+
+func (p *ListInviteCodesRequest) GetPageNum() (v int64) {
+	return p.PageNum
 }
 
-func (p *UserServiceUpdateProfileArgs) IsSetReq() bool {
-	return p.Req != nil
+func (p *ListInviteCodesRequest) GetPageSize() (v int64) {
+	return p.PageSize
+}
+func (p *ListInviteCodesRequest) SetPageNum(val int64) {
+	p.PageNum = val
+}
+func (p *ListInviteCodesRequest) SetPageSize(val int64) {
+	p.PageSize = val
 }
 
-func (p *UserServiceUpdateProfileArgs) String() string {
+func (p *ListInviteCodesRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateProfileArgs(%+v)", *p)
+	return fmt.Sprintf("ListInviteCodesRequest(%+v)", *p)
 }
 
-func (p *UserServiceUpdateProfileArgs) DeepEqual(ano *UserServiceUpdateProfileArgs) bool {
+func (p *ListInviteCodesRequest) DeepEqual(ano *ListInviteCodesRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Req) {
+	if !p.Field1DeepEqual(ano.PageNum) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.PageSize) {
 		return false
 	}
 	return true
 }
 
-func (p *UserServiceUpdateProfileArgs) Field1DeepEqual(src *UpdateUserProfileRequest) bool {
+func (p *ListInviteCodesRequest) Field1DeepEqual(src int64) bool {
 
-	if !p.Req.DeepEqual(src) {
+	if p.PageNum != src {
+		return false
+	}
+	return true
+}
+func (p *ListInviteCodesRequest) Field2DeepEqual(src int64) bool {
+
+	if p.PageSize != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ListInviteCodesRequest = map[int16]string{
+	1: "pageNum",
+	2: "pageSize",
+}
+
+type ListInviteCodesResponse struct {
+	Base  *model.BaseResp     `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	Codes []*model.InviteCode `thrift:"codes,2" frugal:"2,default,list<model.InviteCode>" json:"codes"`
+	Total int64               `thrift:"total,3" frugal:"3,default,i64" json:"total"`
+}
+
+func NewListInviteCodesResponse() *ListInviteCodesResponse {
+	return &ListInviteCodesResponse{}
+}
+
+func (p *ListInviteCodesResponse) InitDefault() {
+}
+
+var ListInviteCodesResponse_Base_DEFAULT *model.BaseResp
+
+func (p *ListInviteCodesResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ListInviteCodesResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ListInviteCodesResponse) GetCodes() (v []*model.InviteCode) {
+	return p.Codes
+}
+
+func (p *ListInviteCodesResponse) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *ListInviteCodesResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ListInviteCodesResponse) SetCodes(val []*model.InviteCode) {
+	p.Codes = val
+}
+func (p *ListInviteCodesResponse) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *ListInviteCodesResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListInviteCodesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListInviteCodesResponse(%+v)", *p)
+}
+
+func (p *ListInviteCodesResponse) DeepEqual(ano *ListInviteCodesResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Codes) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Total) {
+		return false
+	}
+	return true
+}
+
+func (p *ListInviteCodesResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ListInviteCodesResponse) Field2DeepEqual(src []*model.InviteCode) bool {
+
+	if len(p.Codes) != len(src) {
+		return false
+	}
+	for i, v := range p.Codes {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *ListInviteCodesResponse) Field3DeepEqual(src int64) bool {
+
+	if p.Total != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ListInviteCodesResponse = map[int16]string{
+	1: "base",
+	2: "codes",
+	3: "total",
+}
+
+type CreateInviteCodeRequest struct {
+}
+
+func NewCreateInviteCodeRequest() *CreateInviteCodeRequest {
+	return &CreateInviteCodeRequest{}
+}
+
+func (p *CreateInviteCodeRequest) InitDefault() {
+}
+
+func (p *CreateInviteCodeRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateInviteCodeRequest(%+v)", *p)
+}
+
+func (p *CreateInviteCodeRequest) DeepEqual(ano *CreateInviteCodeRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CreateInviteCodeRequest = map[int16]string{}
+
+type CreateInviteCodeResponse struct {
+	Base           *model.BaseResp   `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	Code           *model.InviteCode `thrift:"code,2" frugal:"2,default,model.InviteCode" json:"code"`
+	RemainingQuota int64             `thrift:"remainingQuota,3" frugal:"3,default,i64" json:"remainingQuota"`
+}
+
+func NewCreateInviteCodeResponse() *CreateInviteCodeResponse {
+	return &CreateInviteCodeResponse{}
+}
+
+func (p *CreateInviteCodeResponse) InitDefault() {
+}
+
+var CreateInviteCodeResponse_Base_DEFAULT *model.BaseResp
+
+func (p *CreateInviteCodeResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return CreateInviteCodeResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var CreateInviteCodeResponse_Code_DEFAULT *model.InviteCode
+
+func (p *CreateInviteCodeResponse) GetCode() (v *model.InviteCode) {
+	if !p.IsSetCode() {
+		return CreateInviteCodeResponse_Code_DEFAULT
+	}
+	return p.Code
+}
+
+func (p *CreateInviteCodeResponse) GetRemainingQuota() (v int64) {
+	return p.RemainingQuota
+}
+func (p *CreateInviteCodeResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *CreateInviteCodeResponse) SetCode(val *model.InviteCode) {
+	p.Code = val
+}
+func (p *CreateInviteCodeResponse) SetRemainingQuota(val int64) {
+	p.RemainingQuota = val
+}
+
+func (p *CreateInviteCodeResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CreateInviteCodeResponse) IsSetCode() bool {
+	return p.Code != nil
+}
+
+func (p *CreateInviteCodeResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CreateInviteCodeResponse(%+v)", *p)
+}
+
+func (p *CreateInviteCodeResponse) DeepEqual(ano *CreateInviteCodeResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Code) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.RemainingQuota) {
+		return false
+	}
+	return true
+}
+
+func (p *CreateInviteCodeResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *CreateInviteCodeResponse) Field2DeepEqual(src *model.InviteCode) bool {
+
+	if !p.Code.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *CreateInviteCodeResponse) Field3DeepEqual(src int64) bool {
+
+	if p.RemainingQuota != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CreateInviteCodeResponse = map[int16]string{
+	1: "base",
+	2: "code",
+	3: "remainingQuota",
+}
+
+type GetPrivacySettingsRequest struct {
+}
+
+func NewGetPrivacySettingsRequest() *GetPrivacySettingsRequest {
+	return &GetPrivacySettingsRequest{}
+}
+
+func (p *GetPrivacySettingsRequest) InitDefault() {
+}
+
+func (p *GetPrivacySettingsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPrivacySettingsRequest(%+v)", *p)
+}
+
+func (p *GetPrivacySettingsRequest) DeepEqual(ano *GetPrivacySettingsRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_GetPrivacySettingsRequest = map[int16]string{}
+
+type GetPrivacySettingsResponse struct {
+	Base     *model.BaseResp        `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	Settings *model.PrivacySettings `thrift:"settings,2" frugal:"2,default,model.PrivacySettings" json:"settings"`
+}
+
+func NewGetPrivacySettingsResponse() *GetPrivacySettingsResponse {
+	return &GetPrivacySettingsResponse{}
+}
+
+func (p *GetPrivacySettingsResponse) InitDefault() {
+}
+
+var GetPrivacySettingsResponse_Base_DEFAULT *model.BaseResp
+
+func (p *GetPrivacySettingsResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return GetPrivacySettingsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var GetPrivacySettingsResponse_Settings_DEFAULT *model.PrivacySettings
+
+func (p *GetPrivacySettingsResponse) GetSettings() (v *model.PrivacySettings) {
+	if !p.IsSetSettings() {
+		return GetPrivacySettingsResponse_Settings_DEFAULT
+	}
+	return p.Settings
+}
+func (p *GetPrivacySettingsResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *GetPrivacySettingsResponse) SetSettings(val *model.PrivacySettings) {
+	p.Settings = val
+}
+
+func (p *GetPrivacySettingsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *GetPrivacySettingsResponse) IsSetSettings() bool {
+	return p.Settings != nil
+}
+
+func (p *GetPrivacySettingsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetPrivacySettingsResponse(%+v)", *p)
+}
+
+func (p *GetPrivacySettingsResponse) DeepEqual(ano *GetPrivacySettingsResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Settings) {
+		return false
+	}
+	return true
+}
+
+func (p *GetPrivacySettingsResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *GetPrivacySettingsResponse) Field2DeepEqual(src *model.PrivacySettings) bool {
+
+	if !p.Settings.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_GetPrivacySettingsResponse = map[int16]string{
+	1: "base",
+	2: "settings",
+}
+
+type UpdatePrivacySettingsRequest struct {
+	Settings *model.PrivacySettings `thrift:"settings,1,required" frugal:"1,required,model.PrivacySettings" json:"settings"`
+}
+
+func NewUpdatePrivacySettingsRequest() *UpdatePrivacySettingsRequest {
+	return &UpdatePrivacySettingsRequest{}
+}
+
+func (p *UpdatePrivacySettingsRequest) InitDefault() {
+}
+
+var UpdatePrivacySettingsRequest_Settings_DEFAULT *model.PrivacySettings
+
+func (p *UpdatePrivacySettingsRequest) GetSettings() (v *model.PrivacySettings) {
+	if !p.IsSetSettings() {
+		return UpdatePrivacySettingsRequest_Settings_DEFAULT
+	}
+	return p.Settings
+}
+func (p *UpdatePrivacySettingsRequest) SetSettings(val *model.PrivacySettings) {
+	p.Settings = val
+}
+
+func (p *UpdatePrivacySettingsRequest) IsSetSettings() bool {
+	return p.Settings != nil
+}
+
+func (p *UpdatePrivacySettingsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdatePrivacySettingsRequest(%+v)", *p)
+}
+
+func (p *UpdatePrivacySettingsRequest) DeepEqual(ano *UpdatePrivacySettingsRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Settings) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdatePrivacySettingsRequest) Field1DeepEqual(src *model.PrivacySettings) bool {
+
+	if !p.Settings.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UpdatePrivacySettingsRequest = map[int16]string{
+	1: "settings",
+}
+
+type UpdatePrivacySettingsResponse struct {
+	Base     *model.BaseResp        `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	Settings *model.PrivacySettings `thrift:"settings,2" frugal:"2,default,model.PrivacySettings" json:"settings"`
+}
+
+func NewUpdatePrivacySettingsResponse() *UpdatePrivacySettingsResponse {
+	return &UpdatePrivacySettingsResponse{}
+}
+
+func (p *UpdatePrivacySettingsResponse) InitDefault() {
+}
+
+var UpdatePrivacySettingsResponse_Base_DEFAULT *model.BaseResp
+
+func (p *UpdatePrivacySettingsResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return UpdatePrivacySettingsResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+var UpdatePrivacySettingsResponse_Settings_DEFAULT *model.PrivacySettings
+
+func (p *UpdatePrivacySettingsResponse) GetSettings() (v *model.PrivacySettings) {
+	if !p.IsSetSettings() {
+		return UpdatePrivacySettingsResponse_Settings_DEFAULT
+	}
+	return p.Settings
+}
+func (p *UpdatePrivacySettingsResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *UpdatePrivacySettingsResponse) SetSettings(val *model.PrivacySettings) {
+	p.Settings = val
+}
+
+func (p *UpdatePrivacySettingsResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *UpdatePrivacySettingsResponse) IsSetSettings() bool {
+	return p.Settings != nil
+}
+
+func (p *UpdatePrivacySettingsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UpdatePrivacySettingsResponse(%+v)", *p)
+}
+
+func (p *UpdatePrivacySettingsResponse) DeepEqual(ano *UpdatePrivacySettingsResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Settings) {
+		return false
+	}
+	return true
+}
+
+func (p *UpdatePrivacySettingsResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *UpdatePrivacySettingsResponse) Field2DeepEqual(src *model.PrivacySettings) bool {
+
+	if !p.Settings.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UpdatePrivacySettingsResponse = map[int16]string{
+	1: "base",
+	2: "settings",
+}
+
+type CheckPrivacyRequest struct {
+	ViewerId int64  `thrift:"viewerId,1,required" frugal:"1,required,i64" json:"viewerId"`
+	OwnerId  int64  `thrift:"ownerId,2,required" frugal:"2,required,i64" json:"ownerId"`
+	Action   string `thrift:"action,3,required" frugal:"3,required,string" json:"action"`
+}
+
+func NewCheckPrivacyRequest() *CheckPrivacyRequest {
+	return &CheckPrivacyRequest{}
+}
+
+func (p *CheckPrivacyRequest) InitDefault() {
+}
+
+func (p *CheckPrivacyRequest) GetViewerId() (v int64) {
+	return p.ViewerId
+}
+
+func (p *CheckPrivacyRequest) GetOwnerId() (v int64) {
+	return p.OwnerId
+}
+
+func (p *CheckPrivacyRequest) GetAction() (v string) {
+	return p.Action
+}
+func (p *CheckPrivacyRequest) SetViewerId(val int64) {
+	p.ViewerId = val
+}
+func (p *CheckPrivacyRequest) SetOwnerId(val int64) {
+	p.OwnerId = val
+}
+func (p *CheckPrivacyRequest) SetAction(val string) {
+	p.Action = val
+}
+
+func (p *CheckPrivacyRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckPrivacyRequest(%+v)", *p)
+}
+
+func (p *CheckPrivacyRequest) DeepEqual(ano *CheckPrivacyRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.ViewerId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.OwnerId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Action) {
+		return false
+	}
+	return true
+}
+
+func (p *CheckPrivacyRequest) Field1DeepEqual(src int64) bool {
+
+	if p.ViewerId != src {
+		return false
+	}
+	return true
+}
+func (p *CheckPrivacyRequest) Field2DeepEqual(src int64) bool {
+
+	if p.OwnerId != src {
+		return false
+	}
+	return true
+}
+func (p *CheckPrivacyRequest) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Action, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CheckPrivacyRequest = map[int16]string{
+	1: "viewerId",
+	2: "ownerId",
+	3: "action",
+}
+
+type CheckPrivacyResponse struct {
+	Base    *model.BaseResp `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	Allowed bool            `thrift:"allowed,2" frugal:"2,default,bool" json:"allowed"`
+}
+
+func NewCheckPrivacyResponse() *CheckPrivacyResponse {
+	return &CheckPrivacyResponse{}
+}
+
+func (p *CheckPrivacyResponse) InitDefault() {
+}
+
+var CheckPrivacyResponse_Base_DEFAULT *model.BaseResp
+
+func (p *CheckPrivacyResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return CheckPrivacyResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *CheckPrivacyResponse) GetAllowed() (v bool) {
+	return p.Allowed
+}
+func (p *CheckPrivacyResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *CheckPrivacyResponse) SetAllowed(val bool) {
+	p.Allowed = val
+}
+
+func (p *CheckPrivacyResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *CheckPrivacyResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CheckPrivacyResponse(%+v)", *p)
+}
+
+func (p *CheckPrivacyResponse) DeepEqual(ano *CheckPrivacyResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Allowed) {
+		return false
+	}
+	return true
+}
+
+func (p *CheckPrivacyResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *CheckPrivacyResponse) Field2DeepEqual(src bool) bool {
+
+	if p.Allowed != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CheckPrivacyResponse = map[int16]string{
+	1: "base",
+	2: "allowed",
+}
+
+type ListFollowersRequest struct {
+	UserId int64  `thrift:"userId,1,required" frugal:"1,required,i64" json:"userId"`
+	Cursor *int64 `thrift:"cursor,2,optional" frugal:"2,optional,i64" json:"cursor,omitempty"`
+	Limit  int64  `thrift:"limit,3,required" frugal:"3,required,i64" json:"limit"`
+}
+
+func NewListFollowersRequest() *ListFollowersRequest {
+	return &ListFollowersRequest{}
+}
+
+func (p *ListFollowersRequest) InitDefault() {
+}
+
+func (p *ListFollowersRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+var ListFollowersRequest_Cursor_DEFAULT int64
+
+func (p *ListFollowersRequest) GetCursor() (v int64) {
+	if !p.IsSetCursor() {
+		return ListFollowersRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+func (p *ListFollowersRequest) GetLimit() (v int64) {
+	return p.Limit
+}
+func (p *ListFollowersRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *ListFollowersRequest) SetCursor(val *int64) {
+	p.Cursor = val
+}
+func (p *ListFollowersRequest) SetLimit(val int64) {
+	p.Limit = val
+}
+
+func (p *ListFollowersRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *ListFollowersRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListFollowersRequest(%+v)", *p)
+}
+
+func (p *ListFollowersRequest) DeepEqual(ano *ListFollowersRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Cursor) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Limit) {
+		return false
+	}
+	return true
+}
+
+func (p *ListFollowersRequest) Field1DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *ListFollowersRequest) Field2DeepEqual(src *int64) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if *p.Cursor != *src {
+		return false
+	}
+	return true
+}
+func (p *ListFollowersRequest) Field3DeepEqual(src int64) bool {
+
+	if p.Limit != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ListFollowersRequest = map[int16]string{
+	1: "userId",
+	2: "cursor",
+	3: "limit",
+}
+
+type ListFollowersResponse struct {
+	Base        *model.BaseResp `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	FollowerIds []int64         `thrift:"followerIds,2" frugal:"2,default,list<i64>" json:"followerIds"`
+	NextCursor  *int64          `thrift:"nextCursor,3,optional" frugal:"3,optional,i64" json:"nextCursor,omitempty"`
+	Total       int64           `thrift:"total,4" frugal:"4,default,i64" json:"total"`
+}
+
+func NewListFollowersResponse() *ListFollowersResponse {
+	return &ListFollowersResponse{}
+}
+
+func (p *ListFollowersResponse) InitDefault() {
+}
+
+var ListFollowersResponse_Base_DEFAULT *model.BaseResp
+
+func (p *ListFollowersResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ListFollowersResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ListFollowersResponse) GetFollowerIds() (v []int64) {
+	return p.FollowerIds
+}
+
+var ListFollowersResponse_NextCursor_DEFAULT int64
+
+func (p *ListFollowersResponse) GetNextCursor() (v int64) {
+	if !p.IsSetNextCursor() {
+		return ListFollowersResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+
+func (p *ListFollowersResponse) GetTotal() (v int64) {
+	return p.Total
+}
+func (p *ListFollowersResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ListFollowersResponse) SetFollowerIds(val []int64) {
+	p.FollowerIds = val
+}
+func (p *ListFollowersResponse) SetNextCursor(val *int64) {
+	p.NextCursor = val
+}
+func (p *ListFollowersResponse) SetTotal(val int64) {
+	p.Total = val
+}
+
+func (p *ListFollowersResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListFollowersResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *ListFollowersResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListFollowersResponse(%+v)", *p)
+}
+
+func (p *ListFollowersResponse) DeepEqual(ano *ListFollowersResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.FollowerIds) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.NextCursor) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Total) {
+		return false
+	}
+	return true
+}
+
+func (p *ListFollowersResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ListFollowersResponse) Field2DeepEqual(src []int64) bool {
+
+	if len(p.FollowerIds) != len(src) {
+		return false
+	}
+	for i, v := range p.FollowerIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+func (p *ListFollowersResponse) Field3DeepEqual(src *int64) bool {
+
+	if p.NextCursor == src {
+		return true
+	} else if p.NextCursor == nil || src == nil {
+		return false
+	}
+	if *p.NextCursor != *src {
+		return false
+	}
+	return true
+}
+func (p *ListFollowersResponse) Field4DeepEqual(src int64) bool {
+
+	if p.Total != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ListFollowersResponse = map[int16]string{
+	1: "base",
+	2: "followerIds",
+	3: "nextCursor",
+	4: "total",
+}
+
+type ListFollowingRequest struct {
+	UserId int64 `thrift:"userId,1,required" frugal:"1,required,i64" json:"userId"`
+}
+
+func NewListFollowingRequest() *ListFollowingRequest {
+	return &ListFollowingRequest{}
+}
+
+func (p *ListFollowingRequest) InitDefault() {
+}
+
+func (p *ListFollowingRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+func (p *ListFollowingRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+
+func (p *ListFollowingRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListFollowingRequest(%+v)", *p)
+}
+
+func (p *ListFollowingRequest) DeepEqual(ano *ListFollowingRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	return true
+}
+
+func (p *ListFollowingRequest) Field1DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_ListFollowingRequest = map[int16]string{
+	1: "userId",
+}
+
+type ListFollowingResponse struct {
+	Base         *model.BaseResp `thrift:"base,1" frugal:"1,default,model.BaseResp" json:"base"`
+	FollowingIds []int64         `thrift:"followingIds,2" frugal:"2,default,list<i64>" json:"followingIds"`
+}
+
+func NewListFollowingResponse() *ListFollowingResponse {
+	return &ListFollowingResponse{}
+}
+
+func (p *ListFollowingResponse) InitDefault() {
+}
+
+var ListFollowingResponse_Base_DEFAULT *model.BaseResp
+
+func (p *ListFollowingResponse) GetBase() (v *model.BaseResp) {
+	if !p.IsSetBase() {
+		return ListFollowingResponse_Base_DEFAULT
+	}
+	return p.Base
+}
+
+func (p *ListFollowingResponse) GetFollowingIds() (v []int64) {
+	return p.FollowingIds
+}
+func (p *ListFollowingResponse) SetBase(val *model.BaseResp) {
+	p.Base = val
+}
+func (p *ListFollowingResponse) SetFollowingIds(val []int64) {
+	p.FollowingIds = val
+}
+
+func (p *ListFollowingResponse) IsSetBase() bool {
+	return p.Base != nil
+}
+
+func (p *ListFollowingResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("ListFollowingResponse(%+v)", *p)
+}
+
+func (p *ListFollowingResponse) DeepEqual(ano *ListFollowingResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Base) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.FollowingIds) {
+		return false
+	}
+	return true
+}
+
+func (p *ListFollowingResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.Base.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *ListFollowingResponse) Field2DeepEqual(src []int64) bool {
+
+	if len(p.FollowingIds) != len(src) {
+		return false
+	}
+	for i, v := range p.FollowingIds {
+		_src := src[i]
+		if v != _src {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_ListFollowingResponse = map[int16]string{
+	1: "base",
+	2: "followingIds",
+}
+
+type UserService interface {
+	Register(ctx context.Context, req *RegisterRequest) (r *RegisterResponse, err error)
+
+	Login(ctx context.Context, req *LoginRequest) (r *LoginResponse, err error)
+
+	UpdateProfile(ctx context.Context, req *UpdateUserProfileRequest) (r *UpdateUserProfileResponse, err error)
+
+	GetProfile(ctx context.Context, req *GetUserProfileRequest) (r *GetUserProfileResponse, err error)
+
+	ListSecurityEvents(ctx context.Context, req *ListSecurityEventsRequest) (r *ListSecurityEventsResponse, err error)
+
+	MintInviteCodes(ctx context.Context, req *MintInviteCodesRequest) (r *MintInviteCodesResponse, err error)
+
+	ListInviteCodes(ctx context.Context, req *ListInviteCodesRequest) (r *ListInviteCodesResponse, err error)
+
+	CreateInviteCode(ctx context.Context, req *CreateInviteCodeRequest) (r *CreateInviteCodeResponse, err error)
+
+	GetPrivacySettings(ctx context.Context, req *GetPrivacySettingsRequest) (r *GetPrivacySettingsResponse, err error)
+
+	UpdatePrivacySettings(ctx context.Context, req *UpdatePrivacySettingsRequest) (r *UpdatePrivacySettingsResponse, err error)
+
+	CheckPrivacy(ctx context.Context, req *CheckPrivacyRequest) (r *CheckPrivacyResponse, err error)
+
+	ListFollowers(ctx context.Context, req *ListFollowersRequest) (r *ListFollowersResponse, err error)
+
+	ListFollowing(ctx context.Context, req *ListFollowingRequest) (r *ListFollowingResponse, err error)
+}
+
+type UserServiceRegisterArgs struct {
+	Req *RegisterRequest `thrift:"req,1" frugal:"1,default,RegisterRequest" json:"req"`
+}
+
+func NewUserServiceRegisterArgs() *UserServiceRegisterArgs {
+	return &UserServiceRegisterArgs{}
+}
+
+func (p *UserServiceRegisterArgs) InitDefault() {
+}
+
+var UserServiceRegisterArgs_Req_DEFAULT *RegisterRequest
+
+func (p *UserServiceRegisterArgs) GetReq() (v *RegisterRequest) {
+	if !p.IsSetReq() {
+		return UserServiceRegisterArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceRegisterArgs) SetReq(val *RegisterRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceRegisterArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceRegisterArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRegisterArgs(%+v)", *p)
+}
+
+func (p *UserServiceRegisterArgs) DeepEqual(ano *UserServiceRegisterArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceRegisterArgs) Field1DeepEqual(src *RegisterRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceRegisterArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceRegisterResult struct {
+	Success *RegisterResponse `thrift:"success,0,optional" frugal:"0,optional,RegisterResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceRegisterResult() *UserServiceRegisterResult {
+	return &UserServiceRegisterResult{}
+}
+
+func (p *UserServiceRegisterResult) InitDefault() {
+}
+
+var UserServiceRegisterResult_Success_DEFAULT *RegisterResponse
+
+func (p *UserServiceRegisterResult) GetSuccess() (v *RegisterResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceRegisterResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceRegisterResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RegisterResponse)
+}
+
+func (p *UserServiceRegisterResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceRegisterResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceRegisterResult(%+v)", *p)
+}
+
+func (p *UserServiceRegisterResult) DeepEqual(ano *UserServiceRegisterResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceRegisterResult) Field0DeepEqual(src *RegisterResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceRegisterResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceLoginArgs struct {
+	Req *LoginRequest `thrift:"req,1" frugal:"1,default,LoginRequest" json:"req"`
+}
+
+func NewUserServiceLoginArgs() *UserServiceLoginArgs {
+	return &UserServiceLoginArgs{}
+}
+
+func (p *UserServiceLoginArgs) InitDefault() {
+}
+
+var UserServiceLoginArgs_Req_DEFAULT *LoginRequest
+
+func (p *UserServiceLoginArgs) GetReq() (v *LoginRequest) {
+	if !p.IsSetReq() {
+		return UserServiceLoginArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceLoginArgs) SetReq(val *LoginRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceLoginArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceLoginArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLoginArgs(%+v)", *p)
+}
+
+func (p *UserServiceLoginArgs) DeepEqual(ano *UserServiceLoginArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceLoginArgs) Field1DeepEqual(src *LoginRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceLoginArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceLoginResult struct {
+	Success *LoginResponse `thrift:"success,0,optional" frugal:"0,optional,LoginResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceLoginResult() *UserServiceLoginResult {
+	return &UserServiceLoginResult{}
+}
+
+func (p *UserServiceLoginResult) InitDefault() {
+}
+
+var UserServiceLoginResult_Success_DEFAULT *LoginResponse
+
+func (p *UserServiceLoginResult) GetSuccess() (v *LoginResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceLoginResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceLoginResult) SetSuccess(x interface{}) {
+	p.Success = x.(*LoginResponse)
+}
+
+func (p *UserServiceLoginResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceLoginResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceLoginResult(%+v)", *p)
+}
+
+func (p *UserServiceLoginResult) DeepEqual(ano *UserServiceLoginResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceLoginResult) Field0DeepEqual(src *LoginResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceLoginResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceUpdateProfileArgs struct {
+	Req *UpdateUserProfileRequest `thrift:"req,1" frugal:"1,default,UpdateUserProfileRequest" json:"req"`
+}
+
+func NewUserServiceUpdateProfileArgs() *UserServiceUpdateProfileArgs {
+	return &UserServiceUpdateProfileArgs{}
+}
+
+func (p *UserServiceUpdateProfileArgs) InitDefault() {
+}
+
+var UserServiceUpdateProfileArgs_Req_DEFAULT *UpdateUserProfileRequest
+
+func (p *UserServiceUpdateProfileArgs) GetReq() (v *UpdateUserProfileRequest) {
+	if !p.IsSetReq() {
+		return UserServiceUpdateProfileArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceUpdateProfileArgs) SetReq(val *UpdateUserProfileRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceUpdateProfileArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUpdateProfileArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateProfileArgs(%+v)", *p)
+}
+
+func (p *UserServiceUpdateProfileArgs) DeepEqual(ano *UserServiceUpdateProfileArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceUpdateProfileArgs) Field1DeepEqual(src *UpdateUserProfileRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
 		return false
 	}
 	return true
@@ -984,41 +2553,853 @@ var fieldIDToName_UserServiceUpdateProfileArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceUpdateProfileResult struct {
-	Success *UpdateUserProfileResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateUserProfileResponse" json:"success,omitempty"`
+type UserServiceUpdateProfileResult struct {
+	Success *UpdateUserProfileResponse `thrift:"success,0,optional" frugal:"0,optional,UpdateUserProfileResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceUpdateProfileResult() *UserServiceUpdateProfileResult {
+	return &UserServiceUpdateProfileResult{}
+}
+
+func (p *UserServiceUpdateProfileResult) InitDefault() {
+}
+
+var UserServiceUpdateProfileResult_Success_DEFAULT *UpdateUserProfileResponse
+
+func (p *UserServiceUpdateProfileResult) GetSuccess() (v *UpdateUserProfileResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceUpdateProfileResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceUpdateProfileResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdateUserProfileResponse)
+}
+
+func (p *UserServiceUpdateProfileResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceUpdateProfileResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdateProfileResult(%+v)", *p)
+}
+
+func (p *UserServiceUpdateProfileResult) DeepEqual(ano *UserServiceUpdateProfileResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceUpdateProfileResult) Field0DeepEqual(src *UpdateUserProfileResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceUpdateProfileResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceGetProfileArgs struct {
+	Req *GetUserProfileRequest `thrift:"req,1" frugal:"1,default,GetUserProfileRequest" json:"req"`
+}
+
+func NewUserServiceGetProfileArgs() *UserServiceGetProfileArgs {
+	return &UserServiceGetProfileArgs{}
+}
+
+func (p *UserServiceGetProfileArgs) InitDefault() {
+}
+
+var UserServiceGetProfileArgs_Req_DEFAULT *GetUserProfileRequest
+
+func (p *UserServiceGetProfileArgs) GetReq() (v *GetUserProfileRequest) {
+	if !p.IsSetReq() {
+		return UserServiceGetProfileArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceGetProfileArgs) SetReq(val *GetUserProfileRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceGetProfileArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceGetProfileArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetProfileArgs(%+v)", *p)
+}
+
+func (p *UserServiceGetProfileArgs) DeepEqual(ano *UserServiceGetProfileArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceGetProfileArgs) Field1DeepEqual(src *GetUserProfileRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceGetProfileArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceGetProfileResult struct {
+	Success *GetUserProfileResponse `thrift:"success,0,optional" frugal:"0,optional,GetUserProfileResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceGetProfileResult() *UserServiceGetProfileResult {
+	return &UserServiceGetProfileResult{}
+}
+
+func (p *UserServiceGetProfileResult) InitDefault() {
+}
+
+var UserServiceGetProfileResult_Success_DEFAULT *GetUserProfileResponse
+
+func (p *UserServiceGetProfileResult) GetSuccess() (v *GetUserProfileResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetProfileResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceGetProfileResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetUserProfileResponse)
+}
+
+func (p *UserServiceGetProfileResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetProfileResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetProfileResult(%+v)", *p)
+}
+
+func (p *UserServiceGetProfileResult) DeepEqual(ano *UserServiceGetProfileResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceGetProfileResult) Field0DeepEqual(src *GetUserProfileResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceGetProfileResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceListSecurityEventsArgs struct {
+	Req *ListSecurityEventsRequest `thrift:"req,1" frugal:"1,default,ListSecurityEventsRequest" json:"req"`
+}
+
+func NewUserServiceListSecurityEventsArgs() *UserServiceListSecurityEventsArgs {
+	return &UserServiceListSecurityEventsArgs{}
+}
+
+func (p *UserServiceListSecurityEventsArgs) InitDefault() {
+}
+
+var UserServiceListSecurityEventsArgs_Req_DEFAULT *ListSecurityEventsRequest
+
+func (p *UserServiceListSecurityEventsArgs) GetReq() (v *ListSecurityEventsRequest) {
+	if !p.IsSetReq() {
+		return UserServiceListSecurityEventsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceListSecurityEventsArgs) SetReq(val *ListSecurityEventsRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceListSecurityEventsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceListSecurityEventsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListSecurityEventsArgs(%+v)", *p)
+}
+
+func (p *UserServiceListSecurityEventsArgs) DeepEqual(ano *UserServiceListSecurityEventsArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceListSecurityEventsArgs) Field1DeepEqual(src *ListSecurityEventsRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceListSecurityEventsArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceListSecurityEventsResult struct {
+	Success *ListSecurityEventsResponse `thrift:"success,0,optional" frugal:"0,optional,ListSecurityEventsResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceListSecurityEventsResult() *UserServiceListSecurityEventsResult {
+	return &UserServiceListSecurityEventsResult{}
+}
+
+func (p *UserServiceListSecurityEventsResult) InitDefault() {
+}
+
+var UserServiceListSecurityEventsResult_Success_DEFAULT *ListSecurityEventsResponse
+
+func (p *UserServiceListSecurityEventsResult) GetSuccess() (v *ListSecurityEventsResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceListSecurityEventsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceListSecurityEventsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListSecurityEventsResponse)
+}
+
+func (p *UserServiceListSecurityEventsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceListSecurityEventsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListSecurityEventsResult(%+v)", *p)
+}
+
+func (p *UserServiceListSecurityEventsResult) DeepEqual(ano *UserServiceListSecurityEventsResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceListSecurityEventsResult) Field0DeepEqual(src *ListSecurityEventsResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceListSecurityEventsResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceMintInviteCodesArgs struct {
+	Req *MintInviteCodesRequest `thrift:"req,1" frugal:"1,default,MintInviteCodesRequest" json:"req"`
+}
+
+func NewUserServiceMintInviteCodesArgs() *UserServiceMintInviteCodesArgs {
+	return &UserServiceMintInviteCodesArgs{}
+}
+
+func (p *UserServiceMintInviteCodesArgs) InitDefault() {
+}
+
+var UserServiceMintInviteCodesArgs_Req_DEFAULT *MintInviteCodesRequest
+
+func (p *UserServiceMintInviteCodesArgs) GetReq() (v *MintInviteCodesRequest) {
+	if !p.IsSetReq() {
+		return UserServiceMintInviteCodesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceMintInviteCodesArgs) SetReq(val *MintInviteCodesRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceMintInviteCodesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceMintInviteCodesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceMintInviteCodesArgs(%+v)", *p)
+}
+
+func (p *UserServiceMintInviteCodesArgs) DeepEqual(ano *UserServiceMintInviteCodesArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceMintInviteCodesArgs) Field1DeepEqual(src *MintInviteCodesRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceMintInviteCodesArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceMintInviteCodesResult struct {
+	Success *MintInviteCodesResponse `thrift:"success,0,optional" frugal:"0,optional,MintInviteCodesResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceMintInviteCodesResult() *UserServiceMintInviteCodesResult {
+	return &UserServiceMintInviteCodesResult{}
+}
+
+func (p *UserServiceMintInviteCodesResult) InitDefault() {
+}
+
+var UserServiceMintInviteCodesResult_Success_DEFAULT *MintInviteCodesResponse
+
+func (p *UserServiceMintInviteCodesResult) GetSuccess() (v *MintInviteCodesResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceMintInviteCodesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceMintInviteCodesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*MintInviteCodesResponse)
+}
+
+func (p *UserServiceMintInviteCodesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceMintInviteCodesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceMintInviteCodesResult(%+v)", *p)
+}
+
+func (p *UserServiceMintInviteCodesResult) DeepEqual(ano *UserServiceMintInviteCodesResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceMintInviteCodesResult) Field0DeepEqual(src *MintInviteCodesResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceMintInviteCodesResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceListInviteCodesArgs struct {
+	Req *ListInviteCodesRequest `thrift:"req,1" frugal:"1,default,ListInviteCodesRequest" json:"req"`
+}
+
+func NewUserServiceListInviteCodesArgs() *UserServiceListInviteCodesArgs {
+	return &UserServiceListInviteCodesArgs{}
+}
+
+func (p *UserServiceListInviteCodesArgs) InitDefault() {
+}
+
+var UserServiceListInviteCodesArgs_Req_DEFAULT *ListInviteCodesRequest
+
+func (p *UserServiceListInviteCodesArgs) GetReq() (v *ListInviteCodesRequest) {
+	if !p.IsSetReq() {
+		return UserServiceListInviteCodesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceListInviteCodesArgs) SetReq(val *ListInviteCodesRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceListInviteCodesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceListInviteCodesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListInviteCodesArgs(%+v)", *p)
+}
+
+func (p *UserServiceListInviteCodesArgs) DeepEqual(ano *UserServiceListInviteCodesArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceListInviteCodesArgs) Field1DeepEqual(src *ListInviteCodesRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceListInviteCodesArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceListInviteCodesResult struct {
+	Success *ListInviteCodesResponse `thrift:"success,0,optional" frugal:"0,optional,ListInviteCodesResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceListInviteCodesResult() *UserServiceListInviteCodesResult {
+	return &UserServiceListInviteCodesResult{}
+}
+
+func (p *UserServiceListInviteCodesResult) InitDefault() {
+}
+
+var UserServiceListInviteCodesResult_Success_DEFAULT *ListInviteCodesResponse
+
+func (p *UserServiceListInviteCodesResult) GetSuccess() (v *ListInviteCodesResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceListInviteCodesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceListInviteCodesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListInviteCodesResponse)
+}
+
+func (p *UserServiceListInviteCodesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceListInviteCodesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListInviteCodesResult(%+v)", *p)
+}
+
+func (p *UserServiceListInviteCodesResult) DeepEqual(ano *UserServiceListInviteCodesResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceListInviteCodesResult) Field0DeepEqual(src *ListInviteCodesResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceListInviteCodesResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceCreateInviteCodeArgs struct {
+	Req *CreateInviteCodeRequest `thrift:"req,1" frugal:"1,default,CreateInviteCodeRequest" json:"req"`
+}
+
+func NewUserServiceCreateInviteCodeArgs() *UserServiceCreateInviteCodeArgs {
+	return &UserServiceCreateInviteCodeArgs{}
+}
+
+func (p *UserServiceCreateInviteCodeArgs) InitDefault() {
+}
+
+var UserServiceCreateInviteCodeArgs_Req_DEFAULT *CreateInviteCodeRequest
+
+func (p *UserServiceCreateInviteCodeArgs) GetReq() (v *CreateInviteCodeRequest) {
+	if !p.IsSetReq() {
+		return UserServiceCreateInviteCodeArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceCreateInviteCodeArgs) SetReq(val *CreateInviteCodeRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceCreateInviteCodeArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceCreateInviteCodeArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceCreateInviteCodeArgs(%+v)", *p)
+}
+
+func (p *UserServiceCreateInviteCodeArgs) DeepEqual(ano *UserServiceCreateInviteCodeArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceCreateInviteCodeArgs) Field1DeepEqual(src *CreateInviteCodeRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceCreateInviteCodeArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceCreateInviteCodeResult struct {
+	Success *CreateInviteCodeResponse `thrift:"success,0,optional" frugal:"0,optional,CreateInviteCodeResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceCreateInviteCodeResult() *UserServiceCreateInviteCodeResult {
+	return &UserServiceCreateInviteCodeResult{}
+}
+
+func (p *UserServiceCreateInviteCodeResult) InitDefault() {
+}
+
+var UserServiceCreateInviteCodeResult_Success_DEFAULT *CreateInviteCodeResponse
+
+func (p *UserServiceCreateInviteCodeResult) GetSuccess() (v *CreateInviteCodeResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceCreateInviteCodeResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceCreateInviteCodeResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CreateInviteCodeResponse)
+}
+
+func (p *UserServiceCreateInviteCodeResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceCreateInviteCodeResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceCreateInviteCodeResult(%+v)", *p)
+}
+
+func (p *UserServiceCreateInviteCodeResult) DeepEqual(ano *UserServiceCreateInviteCodeResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceCreateInviteCodeResult) Field0DeepEqual(src *CreateInviteCodeResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceCreateInviteCodeResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceGetPrivacySettingsArgs struct {
+	Req *GetPrivacySettingsRequest `thrift:"req,1" frugal:"1,default,GetPrivacySettingsRequest" json:"req"`
+}
+
+func NewUserServiceGetPrivacySettingsArgs() *UserServiceGetPrivacySettingsArgs {
+	return &UserServiceGetPrivacySettingsArgs{}
+}
+
+func (p *UserServiceGetPrivacySettingsArgs) InitDefault() {
+}
+
+var UserServiceGetPrivacySettingsArgs_Req_DEFAULT *GetPrivacySettingsRequest
+
+func (p *UserServiceGetPrivacySettingsArgs) GetReq() (v *GetPrivacySettingsRequest) {
+	if !p.IsSetReq() {
+		return UserServiceGetPrivacySettingsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceGetPrivacySettingsArgs) SetReq(val *GetPrivacySettingsRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceGetPrivacySettingsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceGetPrivacySettingsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetPrivacySettingsArgs(%+v)", *p)
+}
+
+func (p *UserServiceGetPrivacySettingsArgs) DeepEqual(ano *UserServiceGetPrivacySettingsArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceGetPrivacySettingsArgs) Field1DeepEqual(src *GetPrivacySettingsRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceGetPrivacySettingsArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceGetPrivacySettingsResult struct {
+	Success *GetPrivacySettingsResponse `thrift:"success,0,optional" frugal:"0,optional,GetPrivacySettingsResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceGetPrivacySettingsResult() *UserServiceGetPrivacySettingsResult {
+	return &UserServiceGetPrivacySettingsResult{}
+}
+
+func (p *UserServiceGetPrivacySettingsResult) InitDefault() {
+}
+
+var UserServiceGetPrivacySettingsResult_Success_DEFAULT *GetPrivacySettingsResponse
+
+func (p *UserServiceGetPrivacySettingsResult) GetSuccess() (v *GetPrivacySettingsResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceGetPrivacySettingsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceGetPrivacySettingsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetPrivacySettingsResponse)
+}
+
+func (p *UserServiceGetPrivacySettingsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceGetPrivacySettingsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceGetPrivacySettingsResult(%+v)", *p)
+}
+
+func (p *UserServiceGetPrivacySettingsResult) DeepEqual(ano *UserServiceGetPrivacySettingsResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceGetPrivacySettingsResult) Field0DeepEqual(src *GetPrivacySettingsResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceGetPrivacySettingsResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceUpdatePrivacySettingsArgs struct {
+	Req *UpdatePrivacySettingsRequest `thrift:"req,1" frugal:"1,default,UpdatePrivacySettingsRequest" json:"req"`
+}
+
+func NewUserServiceUpdatePrivacySettingsArgs() *UserServiceUpdatePrivacySettingsArgs {
+	return &UserServiceUpdatePrivacySettingsArgs{}
+}
+
+func (p *UserServiceUpdatePrivacySettingsArgs) InitDefault() {
+}
+
+var UserServiceUpdatePrivacySettingsArgs_Req_DEFAULT *UpdatePrivacySettingsRequest
+
+func (p *UserServiceUpdatePrivacySettingsArgs) GetReq() (v *UpdatePrivacySettingsRequest) {
+	if !p.IsSetReq() {
+		return UserServiceUpdatePrivacySettingsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceUpdatePrivacySettingsArgs) SetReq(val *UpdatePrivacySettingsRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceUpdatePrivacySettingsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceUpdatePrivacySettingsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceUpdatePrivacySettingsArgs(%+v)", *p)
+}
+
+func (p *UserServiceUpdatePrivacySettingsArgs) DeepEqual(ano *UserServiceUpdatePrivacySettingsArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceUpdatePrivacySettingsArgs) Field1DeepEqual(src *UpdatePrivacySettingsRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceUpdatePrivacySettingsArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceUpdatePrivacySettingsResult struct {
+	Success *UpdatePrivacySettingsResponse `thrift:"success,0,optional" frugal:"0,optional,UpdatePrivacySettingsResponse" json:"success,omitempty"`
 }
 
-func NewUserServiceUpdateProfileResult() *UserServiceUpdateProfileResult {
-	return &UserServiceUpdateProfileResult{}
+func NewUserServiceUpdatePrivacySettingsResult() *UserServiceUpdatePrivacySettingsResult {
+	return &UserServiceUpdatePrivacySettingsResult{}
 }
 
-func (p *UserServiceUpdateProfileResult) InitDefault() {
+func (p *UserServiceUpdatePrivacySettingsResult) InitDefault() {
 }
 
-var UserServiceUpdateProfileResult_Success_DEFAULT *UpdateUserProfileResponse
+var UserServiceUpdatePrivacySettingsResult_Success_DEFAULT *UpdatePrivacySettingsResponse
 
-func (p *UserServiceUpdateProfileResult) GetSuccess() (v *UpdateUserProfileResponse) {
+func (p *UserServiceUpdatePrivacySettingsResult) GetSuccess() (v *UpdatePrivacySettingsResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceUpdateProfileResult_Success_DEFAULT
+		return UserServiceUpdatePrivacySettingsResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceUpdateProfileResult) SetSuccess(x interface{}) {
-	p.Success = x.(*UpdateUserProfileResponse)
+func (p *UserServiceUpdatePrivacySettingsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UpdatePrivacySettingsResponse)
 }
 
-func (p *UserServiceUpdateProfileResult) IsSetSuccess() bool {
+func (p *UserServiceUpdatePrivacySettingsResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceUpdateProfileResult) String() string {
+func (p *UserServiceUpdatePrivacySettingsResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceUpdateProfileResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceUpdatePrivacySettingsResult(%+v)", *p)
 }
 
-func (p *UserServiceUpdateProfileResult) DeepEqual(ano *UserServiceUpdateProfileResult) bool {
+func (p *UserServiceUpdatePrivacySettingsResult) DeepEqual(ano *UserServiceUpdatePrivacySettingsResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1030,7 +3411,7 @@ func (p *UserServiceUpdateProfileResult) DeepEqual(ano *UserServiceUpdateProfile
 	return true
 }
 
-func (p *UserServiceUpdateProfileResult) Field0DeepEqual(src *UpdateUserProfileResponse) bool {
+func (p *UserServiceUpdatePrivacySettingsResult) Field0DeepEqual(src *UpdatePrivacySettingsResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -1038,45 +3419,45 @@ func (p *UserServiceUpdateProfileResult) Field0DeepEqual(src *UpdateUserProfileR
 	return true
 }
 
-var fieldIDToName_UserServiceUpdateProfileResult = map[int16]string{
+var fieldIDToName_UserServiceUpdatePrivacySettingsResult = map[int16]string{
 	0: "success",
 }
 
-type UserServiceGetProfileArgs struct {
-	Req *GetUserProfileRequest `thrift:"req,1" frugal:"1,default,GetUserProfileRequest" json:"req"`
+type UserServiceCheckPrivacyArgs struct {
+	Req *CheckPrivacyRequest `thrift:"req,1" frugal:"1,default,CheckPrivacyRequest" json:"req"`
 }
 
-func NewUserServiceGetProfileArgs() *UserServiceGetProfileArgs {
-	return &UserServiceGetProfileArgs{}
+func NewUserServiceCheckPrivacyArgs() *UserServiceCheckPrivacyArgs {
+	return &UserServiceCheckPrivacyArgs{}
 }
 
-func (p *UserServiceGetProfileArgs) InitDefault() {
+func (p *UserServiceCheckPrivacyArgs) InitDefault() {
 }
 
-var UserServiceGetProfileArgs_Req_DEFAULT *GetUserProfileRequest
+var UserServiceCheckPrivacyArgs_Req_DEFAULT *CheckPrivacyRequest
 
-func (p *UserServiceGetProfileArgs) GetReq() (v *GetUserProfileRequest) {
+func (p *UserServiceCheckPrivacyArgs) GetReq() (v *CheckPrivacyRequest) {
 	if !p.IsSetReq() {
-		return UserServiceGetProfileArgs_Req_DEFAULT
+		return UserServiceCheckPrivacyArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *UserServiceGetProfileArgs) SetReq(val *GetUserProfileRequest) {
+func (p *UserServiceCheckPrivacyArgs) SetReq(val *CheckPrivacyRequest) {
 	p.Req = val
 }
 
-func (p *UserServiceGetProfileArgs) IsSetReq() bool {
+func (p *UserServiceCheckPrivacyArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *UserServiceGetProfileArgs) String() string {
+func (p *UserServiceCheckPrivacyArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetProfileArgs(%+v)", *p)
+	return fmt.Sprintf("UserServiceCheckPrivacyArgs(%+v)", *p)
 }
 
-func (p *UserServiceGetProfileArgs) DeepEqual(ano *UserServiceGetProfileArgs) bool {
+func (p *UserServiceCheckPrivacyArgs) DeepEqual(ano *UserServiceCheckPrivacyArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1088,7 +3469,7 @@ func (p *UserServiceGetProfileArgs) DeepEqual(ano *UserServiceGetProfileArgs) bo
 	return true
 }
 
-func (p *UserServiceGetProfileArgs) Field1DeepEqual(src *GetUserProfileRequest) bool {
+func (p *UserServiceCheckPrivacyArgs) Field1DeepEqual(src *CheckPrivacyRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -1096,45 +3477,45 @@ func (p *UserServiceGetProfileArgs) Field1DeepEqual(src *GetUserProfileRequest)
 	return true
 }
 
-var fieldIDToName_UserServiceGetProfileArgs = map[int16]string{
+var fieldIDToName_UserServiceCheckPrivacyArgs = map[int16]string{
 	1: "req",
 }
 
-type UserServiceGetProfileResult struct {
-	Success *GetUserProfileResponse `thrift:"success,0,optional" frugal:"0,optional,GetUserProfileResponse" json:"success,omitempty"`
+type UserServiceCheckPrivacyResult struct {
+	Success *CheckPrivacyResponse `thrift:"success,0,optional" frugal:"0,optional,CheckPrivacyResponse" json:"success,omitempty"`
 }
 
-func NewUserServiceGetProfileResult() *UserServiceGetProfileResult {
-	return &UserServiceGetProfileResult{}
+func NewUserServiceCheckPrivacyResult() *UserServiceCheckPrivacyResult {
+	return &UserServiceCheckPrivacyResult{}
 }
 
-func (p *UserServiceGetProfileResult) InitDefault() {
+func (p *UserServiceCheckPrivacyResult) InitDefault() {
 }
 
-var UserServiceGetProfileResult_Success_DEFAULT *GetUserProfileResponse
+var UserServiceCheckPrivacyResult_Success_DEFAULT *CheckPrivacyResponse
 
-func (p *UserServiceGetProfileResult) GetSuccess() (v *GetUserProfileResponse) {
+func (p *UserServiceCheckPrivacyResult) GetSuccess() (v *CheckPrivacyResponse) {
 	if !p.IsSetSuccess() {
-		return UserServiceGetProfileResult_Success_DEFAULT
+		return UserServiceCheckPrivacyResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *UserServiceGetProfileResult) SetSuccess(x interface{}) {
-	p.Success = x.(*GetUserProfileResponse)
+func (p *UserServiceCheckPrivacyResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CheckPrivacyResponse)
 }
 
-func (p *UserServiceGetProfileResult) IsSetSuccess() bool {
+func (p *UserServiceCheckPrivacyResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *UserServiceGetProfileResult) String() string {
+func (p *UserServiceCheckPrivacyResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("UserServiceGetProfileResult(%+v)", *p)
+	return fmt.Sprintf("UserServiceCheckPrivacyResult(%+v)", *p)
 }
 
-func (p *UserServiceGetProfileResult) DeepEqual(ano *UserServiceGetProfileResult) bool {
+func (p *UserServiceCheckPrivacyResult) DeepEqual(ano *UserServiceCheckPrivacyResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1146,7 +3527,7 @@ func (p *UserServiceGetProfileResult) DeepEqual(ano *UserServiceGetProfileResult
 	return true
 }
 
-func (p *UserServiceGetProfileResult) Field0DeepEqual(src *GetUserProfileResponse) bool {
+func (p *UserServiceCheckPrivacyResult) Field0DeepEqual(src *CheckPrivacyResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -1154,6 +3535,238 @@ func (p *UserServiceGetProfileResult) Field0DeepEqual(src *GetUserProfileRespons
 	return true
 }
 
-var fieldIDToName_UserServiceGetProfileResult = map[int16]string{
+var fieldIDToName_UserServiceCheckPrivacyResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceListFollowersArgs struct {
+	Req *ListFollowersRequest `thrift:"req,1" frugal:"1,default,ListFollowersRequest" json:"req"`
+}
+
+func NewUserServiceListFollowersArgs() *UserServiceListFollowersArgs {
+	return &UserServiceListFollowersArgs{}
+}
+
+func (p *UserServiceListFollowersArgs) InitDefault() {
+}
+
+var UserServiceListFollowersArgs_Req_DEFAULT *ListFollowersRequest
+
+func (p *UserServiceListFollowersArgs) GetReq() (v *ListFollowersRequest) {
+	if !p.IsSetReq() {
+		return UserServiceListFollowersArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceListFollowersArgs) SetReq(val *ListFollowersRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceListFollowersArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceListFollowersArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListFollowersArgs(%+v)", *p)
+}
+
+func (p *UserServiceListFollowersArgs) DeepEqual(ano *UserServiceListFollowersArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceListFollowersArgs) Field1DeepEqual(src *ListFollowersRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceListFollowersArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceListFollowersResult struct {
+	Success *ListFollowersResponse `thrift:"success,0,optional" frugal:"0,optional,ListFollowersResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceListFollowersResult() *UserServiceListFollowersResult {
+	return &UserServiceListFollowersResult{}
+}
+
+func (p *UserServiceListFollowersResult) InitDefault() {
+}
+
+var UserServiceListFollowersResult_Success_DEFAULT *ListFollowersResponse
+
+func (p *UserServiceListFollowersResult) GetSuccess() (v *ListFollowersResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceListFollowersResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceListFollowersResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListFollowersResponse)
+}
+
+func (p *UserServiceListFollowersResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceListFollowersResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListFollowersResult(%+v)", *p)
+}
+
+func (p *UserServiceListFollowersResult) DeepEqual(ano *UserServiceListFollowersResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceListFollowersResult) Field0DeepEqual(src *ListFollowersResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceListFollowersResult = map[int16]string{
+	0: "success",
+}
+
+type UserServiceListFollowingArgs struct {
+	Req *ListFollowingRequest `thrift:"req,1" frugal:"1,default,ListFollowingRequest" json:"req"`
+}
+
+func NewUserServiceListFollowingArgs() *UserServiceListFollowingArgs {
+	return &UserServiceListFollowingArgs{}
+}
+
+func (p *UserServiceListFollowingArgs) InitDefault() {
+}
+
+var UserServiceListFollowingArgs_Req_DEFAULT *ListFollowingRequest
+
+func (p *UserServiceListFollowingArgs) GetReq() (v *ListFollowingRequest) {
+	if !p.IsSetReq() {
+		return UserServiceListFollowingArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *UserServiceListFollowingArgs) SetReq(val *ListFollowingRequest) {
+	p.Req = val
+}
+
+func (p *UserServiceListFollowingArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *UserServiceListFollowingArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListFollowingArgs(%+v)", *p)
+}
+
+func (p *UserServiceListFollowingArgs) DeepEqual(ano *UserServiceListFollowingArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceListFollowingArgs) Field1DeepEqual(src *ListFollowingRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceListFollowingArgs = map[int16]string{
+	1: "req",
+}
+
+type UserServiceListFollowingResult struct {
+	Success *ListFollowingResponse `thrift:"success,0,optional" frugal:"0,optional,ListFollowingResponse" json:"success,omitempty"`
+}
+
+func NewUserServiceListFollowingResult() *UserServiceListFollowingResult {
+	return &UserServiceListFollowingResult{}
+}
+
+func (p *UserServiceListFollowingResult) InitDefault() {
+}
+
+var UserServiceListFollowingResult_Success_DEFAULT *ListFollowingResponse
+
+func (p *UserServiceListFollowingResult) GetSuccess() (v *ListFollowingResponse) {
+	if !p.IsSetSuccess() {
+		return UserServiceListFollowingResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *UserServiceListFollowingResult) SetSuccess(x interface{}) {
+	p.Success = x.(*ListFollowingResponse)
+}
+
+func (p *UserServiceListFollowingResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *UserServiceListFollowingResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserServiceListFollowingResult(%+v)", *p)
+}
+
+func (p *UserServiceListFollowingResult) DeepEqual(ano *UserServiceListFollowingResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *UserServiceListFollowingResult) Field0DeepEqual(src *ListFollowingResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserServiceListFollowingResult = map[int16]string{
 	0: "success",
 }
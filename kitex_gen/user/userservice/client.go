@@ -17,6 +17,15 @@ type Client interface {
 	Login(ctx context.Context, req *user.LoginRequest, callOptions ...callopt.Option) (r *user.LoginResponse, err error)
 	UpdateProfile(ctx context.Context, req *user.UpdateUserProfileRequest, callOptions ...callopt.Option) (r *user.UpdateUserProfileResponse, err error)
 	GetProfile(ctx context.Context, req *user.GetUserProfileRequest, callOptions ...callopt.Option) (r *user.GetUserProfileResponse, err error)
+	ListSecurityEvents(ctx context.Context, req *user.ListSecurityEventsRequest, callOptions ...callopt.Option) (r *user.ListSecurityEventsResponse, err error)
+	MintInviteCodes(ctx context.Context, req *user.MintInviteCodesRequest, callOptions ...callopt.Option) (r *user.MintInviteCodesResponse, err error)
+	ListInviteCodes(ctx context.Context, req *user.ListInviteCodesRequest, callOptions ...callopt.Option) (r *user.ListInviteCodesResponse, err error)
+	CreateInviteCode(ctx context.Context, req *user.CreateInviteCodeRequest, callOptions ...callopt.Option) (r *user.CreateInviteCodeResponse, err error)
+	GetPrivacySettings(ctx context.Context, req *user.GetPrivacySettingsRequest, callOptions ...callopt.Option) (r *user.GetPrivacySettingsResponse, err error)
+	UpdatePrivacySettings(ctx context.Context, req *user.UpdatePrivacySettingsRequest, callOptions ...callopt.Option) (r *user.UpdatePrivacySettingsResponse, err error)
+	CheckPrivacy(ctx context.Context, req *user.CheckPrivacyRequest, callOptions ...callopt.Option) (r *user.CheckPrivacyResponse, err error)
+	ListFollowers(ctx context.Context, req *user.ListFollowersRequest, callOptions ...callopt.Option) (r *user.ListFollowersResponse, err error)
+	ListFollowing(ctx context.Context, req *user.ListFollowingRequest, callOptions ...callopt.Option) (r *user.ListFollowingResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -67,3 +76,48 @@ func (p *kUserServiceClient) GetProfile(ctx context.Context, req *user.GetUserPr
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.GetProfile(ctx, req)
 }
+
+func (p *kUserServiceClient) ListSecurityEvents(ctx context.Context, req *user.ListSecurityEventsRequest, callOptions ...callopt.Option) (r *user.ListSecurityEventsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListSecurityEvents(ctx, req)
+}
+
+func (p *kUserServiceClient) MintInviteCodes(ctx context.Context, req *user.MintInviteCodesRequest, callOptions ...callopt.Option) (r *user.MintInviteCodesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.MintInviteCodes(ctx, req)
+}
+
+func (p *kUserServiceClient) ListInviteCodes(ctx context.Context, req *user.ListInviteCodesRequest, callOptions ...callopt.Option) (r *user.ListInviteCodesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListInviteCodes(ctx, req)
+}
+
+func (p *kUserServiceClient) CreateInviteCode(ctx context.Context, req *user.CreateInviteCodeRequest, callOptions ...callopt.Option) (r *user.CreateInviteCodeResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CreateInviteCode(ctx, req)
+}
+
+func (p *kUserServiceClient) GetPrivacySettings(ctx context.Context, req *user.GetPrivacySettingsRequest, callOptions ...callopt.Option) (r *user.GetPrivacySettingsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetPrivacySettings(ctx, req)
+}
+
+func (p *kUserServiceClient) UpdatePrivacySettings(ctx context.Context, req *user.UpdatePrivacySettingsRequest, callOptions ...callopt.Option) (r *user.UpdatePrivacySettingsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdatePrivacySettings(ctx, req)
+}
+
+func (p *kUserServiceClient) CheckPrivacy(ctx context.Context, req *user.CheckPrivacyRequest, callOptions ...callopt.Option) (r *user.CheckPrivacyResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CheckPrivacy(ctx, req)
+}
+
+func (p *kUserServiceClient) ListFollowers(ctx context.Context, req *user.ListFollowersRequest, callOptions ...callopt.Option) (r *user.ListFollowersResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListFollowers(ctx, req)
+}
+
+func (p *kUserServiceClient) ListFollowing(ctx context.Context, req *user.ListFollowingRequest, callOptions ...callopt.Option) (r *user.ListFollowingResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListFollowing(ctx, req)
+}
@@ -43,6 +43,69 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"ListSecurityEvents": kitex.NewMethodInfo(
+		listSecurityEventsHandler,
+		newUserServiceListSecurityEventsArgs,
+		newUserServiceListSecurityEventsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"MintInviteCodes": kitex.NewMethodInfo(
+		mintInviteCodesHandler,
+		newUserServiceMintInviteCodesArgs,
+		newUserServiceMintInviteCodesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListInviteCodes": kitex.NewMethodInfo(
+		listInviteCodesHandler,
+		newUserServiceListInviteCodesArgs,
+		newUserServiceListInviteCodesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CreateInviteCode": kitex.NewMethodInfo(
+		createInviteCodeHandler,
+		newUserServiceCreateInviteCodeArgs,
+		newUserServiceCreateInviteCodeResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetPrivacySettings": kitex.NewMethodInfo(
+		getPrivacySettingsHandler,
+		newUserServiceGetPrivacySettingsArgs,
+		newUserServiceGetPrivacySettingsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UpdatePrivacySettings": kitex.NewMethodInfo(
+		updatePrivacySettingsHandler,
+		newUserServiceUpdatePrivacySettingsArgs,
+		newUserServiceUpdatePrivacySettingsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CheckPrivacy": kitex.NewMethodInfo(
+		checkPrivacyHandler,
+		newUserServiceCheckPrivacyArgs,
+		newUserServiceCheckPrivacyResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListFollowers": kitex.NewMethodInfo(
+		listFollowersHandler,
+		newUserServiceListFollowersArgs,
+		newUserServiceListFollowersResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListFollowing": kitex.NewMethodInfo(
+		listFollowingHandler,
+		newUserServiceListFollowingArgs,
+		newUserServiceListFollowingResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -181,6 +244,168 @@ func newUserServiceGetProfileResult() interface{} {
 	return user.NewUserServiceGetProfileResult()
 }
 
+func listSecurityEventsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceListSecurityEventsArgs)
+	realResult := result.(*user.UserServiceListSecurityEventsResult)
+	success, err := handler.(user.UserService).ListSecurityEvents(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceListSecurityEventsArgs() interface{} {
+	return user.NewUserServiceListSecurityEventsArgs()
+}
+
+func newUserServiceListSecurityEventsResult() interface{} {
+	return user.NewUserServiceListSecurityEventsResult()
+}
+
+func mintInviteCodesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceMintInviteCodesArgs)
+	realResult := result.(*user.UserServiceMintInviteCodesResult)
+	success, err := handler.(user.UserService).MintInviteCodes(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceMintInviteCodesArgs() interface{} {
+	return user.NewUserServiceMintInviteCodesArgs()
+}
+
+func newUserServiceMintInviteCodesResult() interface{} {
+	return user.NewUserServiceMintInviteCodesResult()
+}
+
+func listInviteCodesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceListInviteCodesArgs)
+	realResult := result.(*user.UserServiceListInviteCodesResult)
+	success, err := handler.(user.UserService).ListInviteCodes(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceListInviteCodesArgs() interface{} {
+	return user.NewUserServiceListInviteCodesArgs()
+}
+
+func newUserServiceListInviteCodesResult() interface{} {
+	return user.NewUserServiceListInviteCodesResult()
+}
+
+func createInviteCodeHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceCreateInviteCodeArgs)
+	realResult := result.(*user.UserServiceCreateInviteCodeResult)
+	success, err := handler.(user.UserService).CreateInviteCode(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceCreateInviteCodeArgs() interface{} {
+	return user.NewUserServiceCreateInviteCodeArgs()
+}
+
+func newUserServiceCreateInviteCodeResult() interface{} {
+	return user.NewUserServiceCreateInviteCodeResult()
+}
+
+func getPrivacySettingsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceGetPrivacySettingsArgs)
+	realResult := result.(*user.UserServiceGetPrivacySettingsResult)
+	success, err := handler.(user.UserService).GetPrivacySettings(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceGetPrivacySettingsArgs() interface{} {
+	return user.NewUserServiceGetPrivacySettingsArgs()
+}
+
+func newUserServiceGetPrivacySettingsResult() interface{} {
+	return user.NewUserServiceGetPrivacySettingsResult()
+}
+
+func updatePrivacySettingsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceUpdatePrivacySettingsArgs)
+	realResult := result.(*user.UserServiceUpdatePrivacySettingsResult)
+	success, err := handler.(user.UserService).UpdatePrivacySettings(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceUpdatePrivacySettingsArgs() interface{} {
+	return user.NewUserServiceUpdatePrivacySettingsArgs()
+}
+
+func newUserServiceUpdatePrivacySettingsResult() interface{} {
+	return user.NewUserServiceUpdatePrivacySettingsResult()
+}
+
+func checkPrivacyHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceCheckPrivacyArgs)
+	realResult := result.(*user.UserServiceCheckPrivacyResult)
+	success, err := handler.(user.UserService).CheckPrivacy(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceCheckPrivacyArgs() interface{} {
+	return user.NewUserServiceCheckPrivacyArgs()
+}
+
+func newUserServiceCheckPrivacyResult() interface{} {
+	return user.NewUserServiceCheckPrivacyResult()
+}
+
+func listFollowersHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceListFollowersArgs)
+	realResult := result.(*user.UserServiceListFollowersResult)
+	success, err := handler.(user.UserService).ListFollowers(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceListFollowersArgs() interface{} {
+	return user.NewUserServiceListFollowersArgs()
+}
+
+func newUserServiceListFollowersResult() interface{} {
+	return user.NewUserServiceListFollowersResult()
+}
+
+func listFollowingHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*user.UserServiceListFollowingArgs)
+	realResult := result.(*user.UserServiceListFollowingResult)
+	success, err := handler.(user.UserService).ListFollowing(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newUserServiceListFollowingArgs() interface{} {
+	return user.NewUserServiceListFollowingArgs()
+}
+
+func newUserServiceListFollowingResult() interface{} {
+	return user.NewUserServiceListFollowingResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -230,3 +455,93 @@ func (p *kClient) GetProfile(ctx context.Context, req *user.GetUserProfileReques
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) ListSecurityEvents(ctx context.Context, req *user.ListSecurityEventsRequest) (r *user.ListSecurityEventsResponse, err error) {
+	var _args user.UserServiceListSecurityEventsArgs
+	_args.Req = req
+	var _result user.UserServiceListSecurityEventsResult
+	if err = p.c.Call(ctx, "ListSecurityEvents", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) MintInviteCodes(ctx context.Context, req *user.MintInviteCodesRequest) (r *user.MintInviteCodesResponse, err error) {
+	var _args user.UserServiceMintInviteCodesArgs
+	_args.Req = req
+	var _result user.UserServiceMintInviteCodesResult
+	if err = p.c.Call(ctx, "MintInviteCodes", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListInviteCodes(ctx context.Context, req *user.ListInviteCodesRequest) (r *user.ListInviteCodesResponse, err error) {
+	var _args user.UserServiceListInviteCodesArgs
+	_args.Req = req
+	var _result user.UserServiceListInviteCodesResult
+	if err = p.c.Call(ctx, "ListInviteCodes", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CreateInviteCode(ctx context.Context, req *user.CreateInviteCodeRequest) (r *user.CreateInviteCodeResponse, err error) {
+	var _args user.UserServiceCreateInviteCodeArgs
+	_args.Req = req
+	var _result user.UserServiceCreateInviteCodeResult
+	if err = p.c.Call(ctx, "CreateInviteCode", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetPrivacySettings(ctx context.Context, req *user.GetPrivacySettingsRequest) (r *user.GetPrivacySettingsResponse, err error) {
+	var _args user.UserServiceGetPrivacySettingsArgs
+	_args.Req = req
+	var _result user.UserServiceGetPrivacySettingsResult
+	if err = p.c.Call(ctx, "GetPrivacySettings", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UpdatePrivacySettings(ctx context.Context, req *user.UpdatePrivacySettingsRequest) (r *user.UpdatePrivacySettingsResponse, err error) {
+	var _args user.UserServiceUpdatePrivacySettingsArgs
+	_args.Req = req
+	var _result user.UserServiceUpdatePrivacySettingsResult
+	if err = p.c.Call(ctx, "UpdatePrivacySettings", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CheckPrivacy(ctx context.Context, req *user.CheckPrivacyRequest) (r *user.CheckPrivacyResponse, err error) {
+	var _args user.UserServiceCheckPrivacyArgs
+	_args.Req = req
+	var _result user.UserServiceCheckPrivacyResult
+	if err = p.c.Call(ctx, "CheckPrivacy", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListFollowers(ctx context.Context, req *user.ListFollowersRequest) (r *user.ListFollowersResponse, err error) {
+	var _args user.UserServiceListFollowersArgs
+	_args.Req = req
+	var _result user.UserServiceListFollowersResult
+	if err = p.c.Call(ctx, "ListFollowers", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListFollowing(ctx context.Context, req *user.ListFollowingRequest) (r *user.ListFollowingResponse, err error) {
+	var _args user.UserServiceListFollowingArgs
+	_args.Req = req
+	var _result user.UserServiceListFollowingResult
+	if err = p.c.Call(ctx, "ListFollowing", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
@@ -12,12 +12,15 @@ import (
 )
 
 type VideoSubmissionRequest struct {
-	UserId          int64   `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
-	Title           string  `thrift:"title,2,required" frugal:"2,required,string" json:"title"`
-	Description     string  `thrift:"description,3,required" frugal:"3,required,string" json:"description"`
-	CoverUrl        *string `thrift:"cover_url,4,optional" frugal:"4,optional,string" json:"cover_url,omitempty"`
-	DurationSeconds int64   `thrift:"duration_seconds,5,required" frugal:"5,required,i64" json:"duration_seconds"`
-	Video           []byte  `thrift:"video,6,required" frugal:"6,required,binary" json:"video"`
+	UserId          int64    `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
+	Title           string   `thrift:"title,2,required" frugal:"2,required,string" json:"title"`
+	Description     string   `thrift:"description,3,required" frugal:"3,required,string" json:"description"`
+	CoverUrl        *string  `thrift:"cover_url,4,optional" frugal:"4,optional,string" json:"cover_url,omitempty"`
+	DurationSeconds int64    `thrift:"duration_seconds,5,required" frugal:"5,required,i64" json:"duration_seconds"`
+	Video           []byte   `thrift:"video,6,required" frugal:"6,required,binary" json:"video"`
+	IsDraft         *bool    `thrift:"is_draft,7,optional" frugal:"7,optional,bool" json:"is_draft,omitempty"`
+	PublishAt       *int64   `thrift:"publish_at,8,optional" frugal:"8,optional,i64" json:"publish_at,omitempty"`
+	Tags            []string `thrift:"tags,9,optional" frugal:"9,optional,list<string>" json:"tags,omitempty"`
 }
 
 func NewVideoSubmissionRequest() *VideoSubmissionRequest {
@@ -55,6 +58,33 @@ func (p *VideoSubmissionRequest) GetDurationSeconds() (v int64) {
 func (p *VideoSubmissionRequest) GetVideo() (v []byte) {
 	return p.Video
 }
+
+var VideoSubmissionRequest_IsDraft_DEFAULT bool
+
+func (p *VideoSubmissionRequest) GetIsDraft() (v bool) {
+	if !p.IsSetIsDraft() {
+		return VideoSubmissionRequest_IsDraft_DEFAULT
+	}
+	return *p.IsDraft
+}
+
+var VideoSubmissionRequest_PublishAt_DEFAULT int64
+
+func (p *VideoSubmissionRequest) GetPublishAt() (v int64) {
+	if !p.IsSetPublishAt() {
+		return VideoSubmissionRequest_PublishAt_DEFAULT
+	}
+	return *p.PublishAt
+}
+
+var VideoSubmissionRequest_Tags_DEFAULT []string
+
+func (p *VideoSubmissionRequest) GetTags() (v []string) {
+	if !p.IsSetTags() {
+		return VideoSubmissionRequest_Tags_DEFAULT
+	}
+	return p.Tags
+}
 func (p *VideoSubmissionRequest) SetUserId(val int64) {
 	p.UserId = val
 }
@@ -73,11 +103,32 @@ func (p *VideoSubmissionRequest) SetDurationSeconds(val int64) {
 func (p *VideoSubmissionRequest) SetVideo(val []byte) {
 	p.Video = val
 }
+func (p *VideoSubmissionRequest) SetIsDraft(val *bool) {
+	p.IsDraft = val
+}
+func (p *VideoSubmissionRequest) SetPublishAt(val *int64) {
+	p.PublishAt = val
+}
+func (p *VideoSubmissionRequest) SetTags(val []string) {
+	p.Tags = val
+}
 
 func (p *VideoSubmissionRequest) IsSetCoverUrl() bool {
 	return p.CoverUrl != nil
 }
 
+func (p *VideoSubmissionRequest) IsSetIsDraft() bool {
+	return p.IsDraft != nil
+}
+
+func (p *VideoSubmissionRequest) IsSetPublishAt() bool {
+	return p.PublishAt != nil
+}
+
+func (p *VideoSubmissionRequest) IsSetTags() bool {
+	return p.Tags != nil
+}
+
 func (p *VideoSubmissionRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -109,6 +160,15 @@ func (p *VideoSubmissionRequest) DeepEqual(ano *VideoSubmissionRequest) bool {
 	if !p.Field6DeepEqual(ano.Video) {
 		return false
 	}
+	if !p.Field7DeepEqual(ano.IsDraft) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.PublishAt) {
+		return false
+	}
+	if !p.Field9DeepEqual(ano.Tags) {
+		return false
+	}
 	return true
 }
 
@@ -159,6 +219,43 @@ func (p *VideoSubmissionRequest) Field6DeepEqual(src []byte) bool {
 	}
 	return true
 }
+func (p *VideoSubmissionRequest) Field7DeepEqual(src *bool) bool {
+
+	if p.IsDraft == src {
+		return true
+	} else if p.IsDraft == nil || src == nil {
+		return false
+	}
+	if *p.IsDraft != *src {
+		return false
+	}
+	return true
+}
+func (p *VideoSubmissionRequest) Field8DeepEqual(src *int64) bool {
+
+	if p.PublishAt == src {
+		return true
+	} else if p.PublishAt == nil || src == nil {
+		return false
+	}
+	if *p.PublishAt != *src {
+		return false
+	}
+	return true
+}
+func (p *VideoSubmissionRequest) Field9DeepEqual(src []string) bool {
+
+	if len(p.Tags) != len(src) {
+		return false
+	}
+	for i, v := range p.Tags {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
 
 var fieldIDToName_VideoSubmissionRequest = map[int16]string{
 	1: "user_id",
@@ -167,6 +264,9 @@ var fieldIDToName_VideoSubmissionRequest = map[int16]string{
 	4: "cover_url",
 	5: "duration_seconds",
 	6: "video",
+	7: "is_draft",
+	8: "publish_at",
+	9: "tags",
 }
 
 type VideoSubmissionResponse struct {
@@ -405,6 +505,9 @@ type VideoSearchRequest struct {
 	Tags     []string `thrift:"tags,2,optional" frugal:"2,optional,list<string>" json:"tags,omitempty"`
 	PageNum  int64    `thrift:"page_num,3,required" frugal:"3,required,i64" json:"page_num"`
 	PageSize int64    `thrift:"page_size,4,required" frugal:"4,required,i64" json:"page_size"`
+	TagMode  *string  `thrift:"tag_mode,5,optional" frugal:"5,optional,string" json:"tag_mode,omitempty"`
+	Sort     *string  `thrift:"sort,6,optional" frugal:"6,optional,string" json:"sort,omitempty"`
+	Cursor   *string  `thrift:"cursor,7,optional" frugal:"7,optional,string" json:"cursor,omitempty"`
 }
 
 func NewVideoSearchRequest() *VideoSearchRequest {
@@ -434,6 +537,33 @@ func (p *VideoSearchRequest) GetPageNum() (v int64) {
 func (p *VideoSearchRequest) GetPageSize() (v int64) {
 	return p.PageSize
 }
+
+var VideoSearchRequest_TagMode_DEFAULT string
+
+func (p *VideoSearchRequest) GetTagMode() (v string) {
+	if !p.IsSetTagMode() {
+		return VideoSearchRequest_TagMode_DEFAULT
+	}
+	return *p.TagMode
+}
+
+var VideoSearchRequest_Sort_DEFAULT string
+
+func (p *VideoSearchRequest) GetSort() (v string) {
+	if !p.IsSetSort() {
+		return VideoSearchRequest_Sort_DEFAULT
+	}
+	return *p.Sort
+}
+
+var VideoSearchRequest_Cursor_DEFAULT string
+
+func (p *VideoSearchRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return VideoSearchRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
 func (p *VideoSearchRequest) SetKeyword(val string) {
 	p.Keyword = val
 }
@@ -446,11 +576,32 @@ func (p *VideoSearchRequest) SetPageNum(val int64) {
 func (p *VideoSearchRequest) SetPageSize(val int64) {
 	p.PageSize = val
 }
+func (p *VideoSearchRequest) SetTagMode(val *string) {
+	p.TagMode = val
+}
+func (p *VideoSearchRequest) SetSort(val *string) {
+	p.Sort = val
+}
+func (p *VideoSearchRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
 
 func (p *VideoSearchRequest) IsSetTags() bool {
 	return p.Tags != nil
 }
 
+func (p *VideoSearchRequest) IsSetTagMode() bool {
+	return p.TagMode != nil
+}
+
+func (p *VideoSearchRequest) IsSetSort() bool {
+	return p.Sort != nil
+}
+
+func (p *VideoSearchRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
 func (p *VideoSearchRequest) String() string {
 	if p == nil {
 		return "<nil>"
@@ -476,6 +627,15 @@ func (p *VideoSearchRequest) DeepEqual(ano *VideoSearchRequest) bool {
 	if !p.Field4DeepEqual(ano.PageSize) {
 		return false
 	}
+	if !p.Field5DeepEqual(ano.TagMode) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Sort) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Cursor) {
+		return false
+	}
 	return true
 }
 
@@ -513,17 +673,58 @@ func (p *VideoSearchRequest) Field4DeepEqual(src int64) bool {
 	}
 	return true
 }
+func (p *VideoSearchRequest) Field5DeepEqual(src *string) bool {
+
+	if p.TagMode == src {
+		return true
+	} else if p.TagMode == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.TagMode, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *VideoSearchRequest) Field6DeepEqual(src *string) bool {
+
+	if p.Sort == src {
+		return true
+	} else if p.Sort == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Sort, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *VideoSearchRequest) Field7DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 var fieldIDToName_VideoSearchRequest = map[int16]string{
 	1: "keyword",
 	2: "tags",
 	3: "page_num",
 	4: "page_size",
+	5: "tag_mode",
+	6: "sort",
+	7: "cursor",
 }
 
 type VideoSearchResponse struct {
-	BaseResp *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
-	Videos   []*model.Video  `thrift:"videos,2,required" frugal:"2,required,list<model.Video>" json:"videos"`
+	BaseResp   *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	Videos     []*model.Video  `thrift:"videos,2,required" frugal:"2,required,list<model.Video>" json:"videos"`
+	Total      int64           `thrift:"total,3,required" frugal:"3,required,i64" json:"total"`
+	NextCursor *string         `thrift:"next_cursor,4,optional" frugal:"4,optional,string" json:"next_cursor,omitempty"`
 }
 
 func NewVideoSearchResponse() *VideoSearchResponse {
@@ -545,17 +746,40 @@ func (p *VideoSearchResponse) GetBaseResp() (v *model.BaseResp) {
 func (p *VideoSearchResponse) GetVideos() (v []*model.Video) {
 	return p.Videos
 }
+
+func (p *VideoSearchResponse) GetTotal() (v int64) {
+	return p.Total
+}
+
+var VideoSearchResponse_NextCursor_DEFAULT string
+
+func (p *VideoSearchResponse) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return VideoSearchResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
 func (p *VideoSearchResponse) SetBaseResp(val *model.BaseResp) {
 	p.BaseResp = val
 }
 func (p *VideoSearchResponse) SetVideos(val []*model.Video) {
 	p.Videos = val
 }
+func (p *VideoSearchResponse) SetTotal(val int64) {
+	p.Total = val
+}
+func (p *VideoSearchResponse) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
 
 func (p *VideoSearchResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
+func (p *VideoSearchResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
 func (p *VideoSearchResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -575,6 +799,12 @@ func (p *VideoSearchResponse) DeepEqual(ano *VideoSearchResponse) bool {
 	if !p.Field2DeepEqual(ano.Videos) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Total) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.NextCursor) {
+		return false
+	}
 	return true
 }
 
@@ -598,15 +828,37 @@ func (p *VideoSearchResponse) Field2DeepEqual(src []*model.Video) bool {
 	}
 	return true
 }
+func (p *VideoSearchResponse) Field3DeepEqual(src int64) bool {
+
+	if p.Total != src {
+		return false
+	}
+	return true
+}
+func (p *VideoSearchResponse) Field4DeepEqual(src *string) bool {
+
+	if p.NextCursor == src {
+		return true
+	} else if p.NextCursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NextCursor, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 var fieldIDToName_VideoSearchResponse = map[int16]string{
 	1: "base_resp",
 	2: "videos",
+	3: "total",
+	4: "next_cursor",
 }
 
 type VideoTrendingRequest struct {
-	PageNum  int64 `thrift:"page_num,1,required" frugal:"1,required,i64" json:"page_num"`
-	PageSize int64 `thrift:"page_size,2,required" frugal:"2,required,i64" json:"page_size"`
+	PageNum  int64   `thrift:"page_num,1,required" frugal:"1,required,i64" json:"page_num"`
+	PageSize int64   `thrift:"page_size,2,required" frugal:"2,required,i64" json:"page_size"`
+	Cursor   *string `thrift:"cursor,3,optional" frugal:"3,optional,string" json:"cursor,omitempty"`
 }
 
 func NewVideoTrendingRequest() *VideoTrendingRequest {
@@ -623,12 +875,28 @@ func (p *VideoTrendingRequest) GetPageNum() (v int64) {
 func (p *VideoTrendingRequest) GetPageSize() (v int64) {
 	return p.PageSize
 }
+
+var VideoTrendingRequest_Cursor_DEFAULT string
+
+func (p *VideoTrendingRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return VideoTrendingRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
 func (p *VideoTrendingRequest) SetPageNum(val int64) {
 	p.PageNum = val
 }
 func (p *VideoTrendingRequest) SetPageSize(val int64) {
 	p.PageSize = val
 }
+func (p *VideoTrendingRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
+
+func (p *VideoTrendingRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
 
 func (p *VideoTrendingRequest) String() string {
 	if p == nil {
@@ -649,6 +917,9 @@ func (p *VideoTrendingRequest) DeepEqual(ano *VideoTrendingRequest) bool {
 	if !p.Field2DeepEqual(ano.PageSize) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.Cursor) {
+		return false
+	}
 	return true
 }
 
@@ -666,15 +937,29 @@ func (p *VideoTrendingRequest) Field2DeepEqual(src int64) bool {
 	}
 	return true
 }
+func (p *VideoTrendingRequest) Field3DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 var fieldIDToName_VideoTrendingRequest = map[int16]string{
 	1: "page_num",
 	2: "page_size",
+	3: "cursor",
 }
 
 type VideoTrendingResponse struct {
-	BaseResp *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
-	Videos   []*model.Video  `thrift:"videos,2,required" frugal:"2,required,list<model.Video>" json:"videos"`
+	BaseResp   *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	Videos     []*model.Video  `thrift:"videos,2,required" frugal:"2,required,list<model.Video>" json:"videos"`
+	NextCursor *string         `thrift:"next_cursor,3,optional" frugal:"3,optional,string" json:"next_cursor,omitempty"`
 }
 
 func NewVideoTrendingResponse() *VideoTrendingResponse {
@@ -696,17 +981,33 @@ func (p *VideoTrendingResponse) GetBaseResp() (v *model.BaseResp) {
 func (p *VideoTrendingResponse) GetVideos() (v []*model.Video) {
 	return p.Videos
 }
+
+var VideoTrendingResponse_NextCursor_DEFAULT string
+
+func (p *VideoTrendingResponse) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return VideoTrendingResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
 func (p *VideoTrendingResponse) SetBaseResp(val *model.BaseResp) {
 	p.BaseResp = val
 }
 func (p *VideoTrendingResponse) SetVideos(val []*model.Video) {
 	p.Videos = val
 }
+func (p *VideoTrendingResponse) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
 
 func (p *VideoTrendingResponse) IsSetBaseResp() bool {
 	return p.BaseResp != nil
 }
 
+func (p *VideoTrendingResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
 func (p *VideoTrendingResponse) String() string {
 	if p == nil {
 		return "<nil>"
@@ -726,6 +1027,9 @@ func (p *VideoTrendingResponse) DeepEqual(ano *VideoTrendingResponse) bool {
 	if !p.Field2DeepEqual(ano.Videos) {
 		return false
 	}
+	if !p.Field3DeepEqual(ano.NextCursor) {
+		return false
+	}
 	return true
 }
 
@@ -749,10 +1053,23 @@ func (p *VideoTrendingResponse) Field2DeepEqual(src []*model.Video) bool {
 	}
 	return true
 }
+func (p *VideoTrendingResponse) Field3DeepEqual(src *string) bool {
+
+	if p.NextCursor == src {
+		return true
+	} else if p.NextCursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NextCursor, *src) != 0 {
+		return false
+	}
+	return true
+}
 
 var fieldIDToName_VideoTrendingResponse = map[int16]string{
 	1: "base_resp",
 	2: "videos",
+	3: "next_cursor",
 }
 
 type VideoHotUpdateRequest struct {
@@ -862,111 +1179,6620 @@ var fieldIDToName_VideoHotUpdateResponse = map[int16]string{
 	1: "base_resp",
 }
 
-type VideoService interface {
-	SubmitVideo(ctx context.Context, req *VideoSubmissionRequest) (r *VideoSubmissionResponse, err error)
+type VideoUpdateRequest struct {
+	VideoId     int64    `thrift:"video_id,1,required" frugal:"1,required,i64" json:"video_id"`
+	Title       *string  `thrift:"title,2,optional" frugal:"2,optional,string" json:"title,omitempty"`
+	Description *string  `thrift:"description,3,optional" frugal:"3,optional,string" json:"description,omitempty"`
+	CoverUrl    *string  `thrift:"cover_url,4,optional" frugal:"4,optional,string" json:"cover_url,omitempty"`
+	Tags        []string `thrift:"tags,5,optional" frugal:"5,optional,list<string>" json:"tags,omitempty"`
+	Visibility  *string  `thrift:"visibility,6,optional" frugal:"6,optional,string" json:"visibility,omitempty"`
+}
 
-	GetVideo(ctx context.Context, req *VideoDetailRequest) (r *VideoDetailResponse, err error)
+func NewVideoUpdateRequest() *VideoUpdateRequest {
+	return &VideoUpdateRequest{}
+}
 
-	SearchVideo(ctx context.Context, req *VideoSearchRequest) (r *VideoSearchResponse, err error)
+func (p *VideoUpdateRequest) InitDefault() {
+}
 
-	TrendVideo(ctx context.Context, req *VideoTrendingRequest) (r *VideoTrendingResponse, err error)
+func (p *VideoUpdateRequest) GetVideoId() (v int64) {
+	return p.VideoId
+}
 
-	UpdateVideoHot(ctx context.Context, req *VideoHotUpdateRequest) (r *VideoHotUpdateResponse, err error)
+var VideoUpdateRequest_Title_DEFAULT string
+
+func (p *VideoUpdateRequest) GetTitle() (v string) {
+	if !p.IsSetTitle() {
+		return VideoUpdateRequest_Title_DEFAULT
+	}
+	return *p.Title
 }
 
-type VideoServiceSubmitVideoArgs struct {
-	Req *VideoSubmissionRequest `thrift:"req,1" frugal:"1,default,VideoSubmissionRequest" json:"req"`
+var VideoUpdateRequest_Description_DEFAULT string
+
+func (p *VideoUpdateRequest) GetDescription() (v string) {
+	if !p.IsSetDescription() {
+		return VideoUpdateRequest_Description_DEFAULT
+	}
+	return *p.Description
 }
 
-func NewVideoServiceSubmitVideoArgs() *VideoServiceSubmitVideoArgs {
-	return &VideoServiceSubmitVideoArgs{}
+var VideoUpdateRequest_CoverUrl_DEFAULT string
+
+func (p *VideoUpdateRequest) GetCoverUrl() (v string) {
+	if !p.IsSetCoverUrl() {
+		return VideoUpdateRequest_CoverUrl_DEFAULT
+	}
+	return *p.CoverUrl
 }
 
-func (p *VideoServiceSubmitVideoArgs) InitDefault() {
+var VideoUpdateRequest_Tags_DEFAULT []string
+
+func (p *VideoUpdateRequest) GetTags() (v []string) {
+	if !p.IsSetTags() {
+		return VideoUpdateRequest_Tags_DEFAULT
+	}
+	return p.Tags
 }
 
-var VideoServiceSubmitVideoArgs_Req_DEFAULT *VideoSubmissionRequest
+var VideoUpdateRequest_Visibility_DEFAULT string
 
-func (p *VideoServiceSubmitVideoArgs) GetReq() (v *VideoSubmissionRequest) {
-	if !p.IsSetReq() {
-		return VideoServiceSubmitVideoArgs_Req_DEFAULT
+func (p *VideoUpdateRequest) GetVisibility() (v string) {
+	if !p.IsSetVisibility() {
+		return VideoUpdateRequest_Visibility_DEFAULT
 	}
-	return p.Req
+	return *p.Visibility
 }
-func (p *VideoServiceSubmitVideoArgs) SetReq(val *VideoSubmissionRequest) {
-	p.Req = val
+func (p *VideoUpdateRequest) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *VideoUpdateRequest) SetTitle(val *string) {
+	p.Title = val
+}
+func (p *VideoUpdateRequest) SetDescription(val *string) {
+	p.Description = val
+}
+func (p *VideoUpdateRequest) SetCoverUrl(val *string) {
+	p.CoverUrl = val
+}
+func (p *VideoUpdateRequest) SetTags(val []string) {
+	p.Tags = val
+}
+func (p *VideoUpdateRequest) SetVisibility(val *string) {
+	p.Visibility = val
 }
 
-func (p *VideoServiceSubmitVideoArgs) IsSetReq() bool {
-	return p.Req != nil
+func (p *VideoUpdateRequest) IsSetTitle() bool {
+	return p.Title != nil
 }
 
-func (p *VideoServiceSubmitVideoArgs) String() string {
+func (p *VideoUpdateRequest) IsSetDescription() bool {
+	return p.Description != nil
+}
+
+func (p *VideoUpdateRequest) IsSetCoverUrl() bool {
+	return p.CoverUrl != nil
+}
+
+func (p *VideoUpdateRequest) IsSetTags() bool {
+	return p.Tags != nil
+}
+
+func (p *VideoUpdateRequest) IsSetVisibility() bool {
+	return p.Visibility != nil
+}
+
+func (p *VideoUpdateRequest) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceSubmitVideoArgs(%+v)", *p)
+	return fmt.Sprintf("VideoUpdateRequest(%+v)", *p)
 }
 
-func (p *VideoServiceSubmitVideoArgs) DeepEqual(ano *VideoServiceSubmitVideoArgs) bool {
+func (p *VideoUpdateRequest) DeepEqual(ano *VideoUpdateRequest) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
 		return false
 	}
-	if !p.Field1DeepEqual(ano.Req) {
+	if !p.Field1DeepEqual(ano.VideoId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Title) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Description) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.CoverUrl) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.Tags) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.Visibility) {
 		return false
 	}
 	return true
 }
 
-func (p *VideoServiceSubmitVideoArgs) Field1DeepEqual(src *VideoSubmissionRequest) bool {
+func (p *VideoUpdateRequest) Field1DeepEqual(src int64) bool {
 
-	if !p.Req.DeepEqual(src) {
+	if p.VideoId != src {
 		return false
 	}
 	return true
 }
+func (p *VideoUpdateRequest) Field2DeepEqual(src *string) bool {
 
-var fieldIDToName_VideoServiceSubmitVideoArgs = map[int16]string{
+	if p.Title == src {
+		return true
+	} else if p.Title == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Title, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *VideoUpdateRequest) Field3DeepEqual(src *string) bool {
+
+	if p.Description == src {
+		return true
+	} else if p.Description == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Description, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *VideoUpdateRequest) Field4DeepEqual(src *string) bool {
+
+	if p.CoverUrl == src {
+		return true
+	} else if p.CoverUrl == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.CoverUrl, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *VideoUpdateRequest) Field5DeepEqual(src []string) bool {
+
+	if len(p.Tags) != len(src) {
+		return false
+	}
+	for i, v := range p.Tags {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *VideoUpdateRequest) Field6DeepEqual(src *string) bool {
+
+	if p.Visibility == src {
+		return true
+	} else if p.Visibility == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Visibility, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoUpdateRequest = map[int16]string{
+	1: "video_id",
+	2: "title",
+	3: "description",
+	4: "cover_url",
+	5: "tags",
+	6: "visibility",
+}
+
+type VideoUpdateResponse struct {
+	BaseResp *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	Video    *model.Video    `thrift:"video,2,required" frugal:"2,required,model.Video" json:"video"`
+}
+
+func NewVideoUpdateResponse() *VideoUpdateResponse {
+	return &VideoUpdateResponse{}
+}
+
+func (p *VideoUpdateResponse) InitDefault() {
+}
+
+var VideoUpdateResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *VideoUpdateResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return VideoUpdateResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var VideoUpdateResponse_Video_DEFAULT *model.Video
+
+func (p *VideoUpdateResponse) GetVideo() (v *model.Video) {
+	if !p.IsSetVideo() {
+		return VideoUpdateResponse_Video_DEFAULT
+	}
+	return p.Video
+}
+func (p *VideoUpdateResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+func (p *VideoUpdateResponse) SetVideo(val *model.Video) {
+	p.Video = val
+}
+
+func (p *VideoUpdateResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *VideoUpdateResponse) IsSetVideo() bool {
+	return p.Video != nil
+}
+
+func (p *VideoUpdateResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoUpdateResponse(%+v)", *p)
+}
+
+func (p *VideoUpdateResponse) DeepEqual(ano *VideoUpdateResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Video) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoUpdateResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *VideoUpdateResponse) Field2DeepEqual(src *model.Video) bool {
+
+	if !p.Video.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoUpdateResponse = map[int16]string{
+	1: "base_resp",
+	2: "video",
+}
+
+type VideoDeleteRequest struct {
+	VideoId int64 `thrift:"video_id,1,required" frugal:"1,required,i64" json:"video_id"`
+}
+
+func NewVideoDeleteRequest() *VideoDeleteRequest {
+	return &VideoDeleteRequest{}
+}
+
+func (p *VideoDeleteRequest) InitDefault() {
+}
+
+func (p *VideoDeleteRequest) GetVideoId() (v int64) {
+	return p.VideoId
+}
+func (p *VideoDeleteRequest) SetVideoId(val int64) {
+	p.VideoId = val
+}
+
+func (p *VideoDeleteRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoDeleteRequest(%+v)", *p)
+}
+
+func (p *VideoDeleteRequest) DeepEqual(ano *VideoDeleteRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.VideoId) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoDeleteRequest) Field1DeepEqual(src int64) bool {
+
+	if p.VideoId != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoDeleteRequest = map[int16]string{
+	1: "video_id",
+}
+
+type VideoDeleteResponse struct {
+	BaseResp *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+}
+
+func NewVideoDeleteResponse() *VideoDeleteResponse {
+	return &VideoDeleteResponse{}
+}
+
+func (p *VideoDeleteResponse) InitDefault() {
+}
+
+var VideoDeleteResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *VideoDeleteResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return VideoDeleteResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *VideoDeleteResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *VideoDeleteResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *VideoDeleteResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoDeleteResponse(%+v)", *p)
+}
+
+func (p *VideoDeleteResponse) DeepEqual(ano *VideoDeleteResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoDeleteResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoDeleteResponse = map[int16]string{
+	1: "base_resp",
+}
+
+type VideoDraftListRequest struct {
+	PageNum  int64 `thrift:"page_num,1,required" frugal:"1,required,i64" json:"page_num"`
+	PageSize int64 `thrift:"page_size,2,required" frugal:"2,required,i64" json:"page_size"`
+}
+
+func NewVideoDraftListRequest() *VideoDraftListRequest {
+	return &VideoDraftListRequest{}
+}
+
+func (p *VideoDraftListRequest) InitDefault() {
+}
+
+func (p *VideoDraftListRequest) GetPageNum() (v int64) {
+	return p.PageNum
+}
+
+func (p *VideoDraftListRequest) GetPageSize() (v int64) {
+	return p.PageSize
+}
+func (p *VideoDraftListRequest) SetPageNum(val int64) {
+	p.PageNum = val
+}
+func (p *VideoDraftListRequest) SetPageSize(val int64) {
+	p.PageSize = val
+}
+
+func (p *VideoDraftListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoDraftListRequest(%+v)", *p)
+}
+
+func (p *VideoDraftListRequest) DeepEqual(ano *VideoDraftListRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.PageNum) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.PageSize) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoDraftListRequest) Field1DeepEqual(src int64) bool {
+
+	if p.PageNum != src {
+		return false
+	}
+	return true
+}
+func (p *VideoDraftListRequest) Field2DeepEqual(src int64) bool {
+
+	if p.PageSize != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoDraftListRequest = map[int16]string{
+	1: "page_num",
+	2: "page_size",
+}
+
+type VideoDraftListResponse struct {
+	BaseResp *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	Videos   []*model.Video  `thrift:"videos,2,required" frugal:"2,required,list<model.Video>" json:"videos"`
+}
+
+func NewVideoDraftListResponse() *VideoDraftListResponse {
+	return &VideoDraftListResponse{}
+}
+
+func (p *VideoDraftListResponse) InitDefault() {
+}
+
+var VideoDraftListResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *VideoDraftListResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return VideoDraftListResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *VideoDraftListResponse) GetVideos() (v []*model.Video) {
+	return p.Videos
+}
+func (p *VideoDraftListResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+func (p *VideoDraftListResponse) SetVideos(val []*model.Video) {
+	p.Videos = val
+}
+
+func (p *VideoDraftListResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *VideoDraftListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoDraftListResponse(%+v)", *p)
+}
+
+func (p *VideoDraftListResponse) DeepEqual(ano *VideoDraftListResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Videos) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoDraftListResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *VideoDraftListResponse) Field2DeepEqual(src []*model.Video) bool {
+
+	if len(p.Videos) != len(src) {
+		return false
+	}
+	for i, v := range p.Videos {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_VideoDraftListResponse = map[int16]string{
+	1: "base_resp",
+	2: "videos",
+}
+
+type VideoScheduleRequest struct {
+	VideoId   int64 `thrift:"video_id,1,required" frugal:"1,required,i64" json:"video_id"`
+	PublishAt int64 `thrift:"publish_at,2,required" frugal:"2,required,i64" json:"publish_at"`
+}
+
+func NewVideoScheduleRequest() *VideoScheduleRequest {
+	return &VideoScheduleRequest{}
+}
+
+func (p *VideoScheduleRequest) InitDefault() {
+}
+
+func (p *VideoScheduleRequest) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *VideoScheduleRequest) GetPublishAt() (v int64) {
+	return p.PublishAt
+}
+func (p *VideoScheduleRequest) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *VideoScheduleRequest) SetPublishAt(val int64) {
+	p.PublishAt = val
+}
+
+func (p *VideoScheduleRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoScheduleRequest(%+v)", *p)
+}
+
+func (p *VideoScheduleRequest) DeepEqual(ano *VideoScheduleRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.VideoId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.PublishAt) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoScheduleRequest) Field1DeepEqual(src int64) bool {
+
+	if p.VideoId != src {
+		return false
+	}
+	return true
+}
+func (p *VideoScheduleRequest) Field2DeepEqual(src int64) bool {
+
+	if p.PublishAt != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoScheduleRequest = map[int16]string{
+	1: "video_id",
+	2: "publish_at",
+}
+
+type VideoScheduleResponse struct {
+	BaseResp *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	Video    *model.Video    `thrift:"video,2,required" frugal:"2,required,model.Video" json:"video"`
+}
+
+func NewVideoScheduleResponse() *VideoScheduleResponse {
+	return &VideoScheduleResponse{}
+}
+
+func (p *VideoScheduleResponse) InitDefault() {
+}
+
+var VideoScheduleResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *VideoScheduleResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return VideoScheduleResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var VideoScheduleResponse_Video_DEFAULT *model.Video
+
+func (p *VideoScheduleResponse) GetVideo() (v *model.Video) {
+	if !p.IsSetVideo() {
+		return VideoScheduleResponse_Video_DEFAULT
+	}
+	return p.Video
+}
+func (p *VideoScheduleResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+func (p *VideoScheduleResponse) SetVideo(val *model.Video) {
+	p.Video = val
+}
+
+func (p *VideoScheduleResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *VideoScheduleResponse) IsSetVideo() bool {
+	return p.Video != nil
+}
+
+func (p *VideoScheduleResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoScheduleResponse(%+v)", *p)
+}
+
+func (p *VideoScheduleResponse) DeepEqual(ano *VideoScheduleResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Video) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoScheduleResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *VideoScheduleResponse) Field2DeepEqual(src *model.Video) bool {
+
+	if !p.Video.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoScheduleResponse = map[int16]string{
+	1: "base_resp",
+	2: "video",
+}
+
+type VideoTagListRequest struct {
+	Tag      string `thrift:"tag,1,required" frugal:"1,required,string" json:"tag"`
+	PageNum  int64  `thrift:"page_num,2,required" frugal:"2,required,i64" json:"page_num"`
+	PageSize int64  `thrift:"page_size,3,required" frugal:"3,required,i64" json:"page_size"`
+}
+
+func NewVideoTagListRequest() *VideoTagListRequest {
+	return &VideoTagListRequest{}
+}
+
+func (p *VideoTagListRequest) InitDefault() {
+}
+
+func (p *VideoTagListRequest) GetTag() (v string) {
+	return p.Tag
+}
+
+func (p *VideoTagListRequest) GetPageNum() (v int64) {
+	return p.PageNum
+}
+
+func (p *VideoTagListRequest) GetPageSize() (v int64) {
+	return p.PageSize
+}
+func (p *VideoTagListRequest) SetTag(val string) {
+	p.Tag = val
+}
+func (p *VideoTagListRequest) SetPageNum(val int64) {
+	p.PageNum = val
+}
+func (p *VideoTagListRequest) SetPageSize(val int64) {
+	p.PageSize = val
+}
+
+func (p *VideoTagListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoTagListRequest(%+v)", *p)
+}
+
+func (p *VideoTagListRequest) DeepEqual(ano *VideoTagListRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Tag) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.PageNum) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.PageSize) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoTagListRequest) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Tag, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *VideoTagListRequest) Field2DeepEqual(src int64) bool {
+
+	if p.PageNum != src {
+		return false
+	}
+	return true
+}
+func (p *VideoTagListRequest) Field3DeepEqual(src int64) bool {
+
+	if p.PageSize != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoTagListRequest = map[int16]string{
+	1: "tag",
+	2: "page_num",
+	3: "page_size",
+}
+
+type VideoTagListResponse struct {
+	BaseResp *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	Videos   []*model.Video  `thrift:"videos,2,required" frugal:"2,required,list<model.Video>" json:"videos"`
+}
+
+func NewVideoTagListResponse() *VideoTagListResponse {
+	return &VideoTagListResponse{}
+}
+
+func (p *VideoTagListResponse) InitDefault() {
+}
+
+var VideoTagListResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *VideoTagListResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return VideoTagListResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *VideoTagListResponse) GetVideos() (v []*model.Video) {
+	return p.Videos
+}
+func (p *VideoTagListResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+func (p *VideoTagListResponse) SetVideos(val []*model.Video) {
+	p.Videos = val
+}
+
+func (p *VideoTagListResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *VideoTagListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoTagListResponse(%+v)", *p)
+}
+
+func (p *VideoTagListResponse) DeepEqual(ano *VideoTagListResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Videos) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoTagListResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *VideoTagListResponse) Field2DeepEqual(src []*model.Video) bool {
+
+	if len(p.Videos) != len(src) {
+		return false
+	}
+	for i, v := range p.Videos {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_VideoTagListResponse = map[int16]string{
+	1: "base_resp",
+	2: "videos",
+}
+
+type PopularTagsRequest struct {
+	Limit *int64 `thrift:"limit,1,optional" frugal:"1,optional,i64" json:"limit,omitempty"`
+}
+
+func NewPopularTagsRequest() *PopularTagsRequest {
+	return &PopularTagsRequest{}
+}
+
+func (p *PopularTagsRequest) InitDefault() {
+}
+
+var PopularTagsRequest_Limit_DEFAULT int64
+
+func (p *PopularTagsRequest) GetLimit() (v int64) {
+	if !p.IsSetLimit() {
+		return PopularTagsRequest_Limit_DEFAULT
+	}
+	return *p.Limit
+}
+func (p *PopularTagsRequest) SetLimit(val *int64) {
+	p.Limit = val
+}
+
+func (p *PopularTagsRequest) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *PopularTagsRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PopularTagsRequest(%+v)", *p)
+}
+
+func (p *PopularTagsRequest) DeepEqual(ano *PopularTagsRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Limit) {
+		return false
+	}
+	return true
+}
+
+func (p *PopularTagsRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.Limit == src {
+		return true
+	} else if p.Limit == nil || src == nil {
+		return false
+	}
+	if *p.Limit != *src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_PopularTagsRequest = map[int16]string{
+	1: "limit",
+}
+
+type PopularTagsResponse struct {
+	BaseResp *model.BaseResp   `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	Tags     []*model.TagCount `thrift:"tags,2,required" frugal:"2,required,list<model.TagCount>" json:"tags"`
+}
+
+func NewPopularTagsResponse() *PopularTagsResponse {
+	return &PopularTagsResponse{}
+}
+
+func (p *PopularTagsResponse) InitDefault() {
+}
+
+var PopularTagsResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *PopularTagsResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return PopularTagsResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *PopularTagsResponse) GetTags() (v []*model.TagCount) {
+	return p.Tags
+}
+func (p *PopularTagsResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+func (p *PopularTagsResponse) SetTags(val []*model.TagCount) {
+	p.Tags = val
+}
+
+func (p *PopularTagsResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *PopularTagsResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("PopularTagsResponse(%+v)", *p)
+}
+
+func (p *PopularTagsResponse) DeepEqual(ano *PopularTagsResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Tags) {
+		return false
+	}
+	return true
+}
+
+func (p *PopularTagsResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *PopularTagsResponse) Field2DeepEqual(src []*model.TagCount) bool {
+
+	if len(p.Tags) != len(src) {
+		return false
+	}
+	for i, v := range p.Tags {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_PopularTagsResponse = map[int16]string{
+	1: "base_resp",
+	2: "tags",
+}
+
+type SearchSuggestRequest struct {
+	Prefix string `thrift:"prefix,1,required" frugal:"1,required,string" json:"prefix"`
+	Limit  *int64 `thrift:"limit,2,optional" frugal:"2,optional,i64" json:"limit,omitempty"`
+}
+
+func NewSearchSuggestRequest() *SearchSuggestRequest {
+	return &SearchSuggestRequest{}
+}
+
+func (p *SearchSuggestRequest) InitDefault() {
+}
+
+func (p *SearchSuggestRequest) GetPrefix() (v string) {
+	return p.Prefix
+}
+
+var SearchSuggestRequest_Limit_DEFAULT int64
+
+func (p *SearchSuggestRequest) GetLimit() (v int64) {
+	if !p.IsSetLimit() {
+		return SearchSuggestRequest_Limit_DEFAULT
+	}
+	return *p.Limit
+}
+func (p *SearchSuggestRequest) SetPrefix(val string) {
+	p.Prefix = val
+}
+func (p *SearchSuggestRequest) SetLimit(val *int64) {
+	p.Limit = val
+}
+
+func (p *SearchSuggestRequest) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *SearchSuggestRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchSuggestRequest(%+v)", *p)
+}
+
+func (p *SearchSuggestRequest) DeepEqual(ano *SearchSuggestRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Prefix) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Limit) {
+		return false
+	}
+	return true
+}
+
+func (p *SearchSuggestRequest) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Prefix, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *SearchSuggestRequest) Field2DeepEqual(src *int64) bool {
+
+	if p.Limit == src {
+		return true
+	} else if p.Limit == nil || src == nil {
+		return false
+	}
+	if *p.Limit != *src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_SearchSuggestRequest = map[int16]string{
+	1: "prefix",
+	2: "limit",
+}
+
+type SearchSuggestResponse struct {
+	BaseResp    *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	Suggestions []string        `thrift:"suggestions,2,required" frugal:"2,required,list<string>" json:"suggestions"`
+}
+
+func NewSearchSuggestResponse() *SearchSuggestResponse {
+	return &SearchSuggestResponse{}
+}
+
+func (p *SearchSuggestResponse) InitDefault() {
+}
+
+var SearchSuggestResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *SearchSuggestResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return SearchSuggestResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *SearchSuggestResponse) GetSuggestions() (v []string) {
+	return p.Suggestions
+}
+func (p *SearchSuggestResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+func (p *SearchSuggestResponse) SetSuggestions(val []string) {
+	p.Suggestions = val
+}
+
+func (p *SearchSuggestResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *SearchSuggestResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchSuggestResponse(%+v)", *p)
+}
+
+func (p *SearchSuggestResponse) DeepEqual(ano *SearchSuggestResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Suggestions) {
+		return false
+	}
+	return true
+}
+
+func (p *SearchSuggestResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *SearchSuggestResponse) Field2DeepEqual(src []string) bool {
+
+	if len(p.Suggestions) != len(src) {
+		return false
+	}
+	for i, v := range p.Suggestions {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_SearchSuggestResponse = map[int16]string{
+	1: "base_resp",
+	2: "suggestions",
+}
+
+type TrendingSearchesRequest struct {
+	Limit *int64 `thrift:"limit,1,optional" frugal:"1,optional,i64" json:"limit,omitempty"`
+}
+
+func NewTrendingSearchesRequest() *TrendingSearchesRequest {
+	return &TrendingSearchesRequest{}
+}
+
+func (p *TrendingSearchesRequest) InitDefault() {
+}
+
+var TrendingSearchesRequest_Limit_DEFAULT int64
+
+func (p *TrendingSearchesRequest) GetLimit() (v int64) {
+	if !p.IsSetLimit() {
+		return TrendingSearchesRequest_Limit_DEFAULT
+	}
+	return *p.Limit
+}
+func (p *TrendingSearchesRequest) SetLimit(val *int64) {
+	p.Limit = val
+}
+
+func (p *TrendingSearchesRequest) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *TrendingSearchesRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TrendingSearchesRequest(%+v)", *p)
+}
+
+func (p *TrendingSearchesRequest) DeepEqual(ano *TrendingSearchesRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Limit) {
+		return false
+	}
+	return true
+}
+
+func (p *TrendingSearchesRequest) Field1DeepEqual(src *int64) bool {
+
+	if p.Limit == src {
+		return true
+	} else if p.Limit == nil || src == nil {
+		return false
+	}
+	if *p.Limit != *src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_TrendingSearchesRequest = map[int16]string{
+	1: "limit",
+}
+
+type TrendingSearchesResponse struct {
+	BaseResp *model.BaseResp      `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	Searches []*model.SearchTrend `thrift:"searches,2,required" frugal:"2,required,list<model.SearchTrend>" json:"searches"`
+}
+
+func NewTrendingSearchesResponse() *TrendingSearchesResponse {
+	return &TrendingSearchesResponse{}
+}
+
+func (p *TrendingSearchesResponse) InitDefault() {
+}
+
+var TrendingSearchesResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *TrendingSearchesResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return TrendingSearchesResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *TrendingSearchesResponse) GetSearches() (v []*model.SearchTrend) {
+	return p.Searches
+}
+func (p *TrendingSearchesResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+func (p *TrendingSearchesResponse) SetSearches(val []*model.SearchTrend) {
+	p.Searches = val
+}
+
+func (p *TrendingSearchesResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *TrendingSearchesResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("TrendingSearchesResponse(%+v)", *p)
+}
+
+func (p *TrendingSearchesResponse) DeepEqual(ano *TrendingSearchesResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Searches) {
+		return false
+	}
+	return true
+}
+
+func (p *TrendingSearchesResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *TrendingSearchesResponse) Field2DeepEqual(src []*model.SearchTrend) bool {
+
+	if len(p.Searches) != len(src) {
+		return false
+	}
+	for i, v := range p.Searches {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_TrendingSearchesResponse = map[int16]string{
+	1: "base_resp",
+	2: "searches",
+}
+
+type SearchHistoryListRequest struct {
+}
+
+func NewSearchHistoryListRequest() *SearchHistoryListRequest {
+	return &SearchHistoryListRequest{}
+}
+
+func (p *SearchHistoryListRequest) InitDefault() {
+}
+
+func (p *SearchHistoryListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchHistoryListRequest(%+v)", *p)
+}
+
+func (p *SearchHistoryListRequest) DeepEqual(ano *SearchHistoryListRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_SearchHistoryListRequest = map[int16]string{}
+
+type SearchHistoryListResponse struct {
+	BaseResp *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	Keywords []string        `thrift:"keywords,2,required" frugal:"2,required,list<string>" json:"keywords"`
+}
+
+func NewSearchHistoryListResponse() *SearchHistoryListResponse {
+	return &SearchHistoryListResponse{}
+}
+
+func (p *SearchHistoryListResponse) InitDefault() {
+}
+
+var SearchHistoryListResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *SearchHistoryListResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return SearchHistoryListResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *SearchHistoryListResponse) GetKeywords() (v []string) {
+	return p.Keywords
+}
+func (p *SearchHistoryListResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+func (p *SearchHistoryListResponse) SetKeywords(val []string) {
+	p.Keywords = val
+}
+
+func (p *SearchHistoryListResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *SearchHistoryListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchHistoryListResponse(%+v)", *p)
+}
+
+func (p *SearchHistoryListResponse) DeepEqual(ano *SearchHistoryListResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Keywords) {
+		return false
+	}
+	return true
+}
+
+func (p *SearchHistoryListResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *SearchHistoryListResponse) Field2DeepEqual(src []string) bool {
+
+	if len(p.Keywords) != len(src) {
+		return false
+	}
+	for i, v := range p.Keywords {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_SearchHistoryListResponse = map[int16]string{
+	1: "base_resp",
+	2: "keywords",
+}
+
+type SearchHistoryDeleteRequest struct {
+	Keyword string `thrift:"keyword,1,required" frugal:"1,required,string" json:"keyword"`
+}
+
+func NewSearchHistoryDeleteRequest() *SearchHistoryDeleteRequest {
+	return &SearchHistoryDeleteRequest{}
+}
+
+func (p *SearchHistoryDeleteRequest) InitDefault() {
+}
+
+func (p *SearchHistoryDeleteRequest) GetKeyword() (v string) {
+	return p.Keyword
+}
+func (p *SearchHistoryDeleteRequest) SetKeyword(val string) {
+	p.Keyword = val
+}
+
+func (p *SearchHistoryDeleteRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchHistoryDeleteRequest(%+v)", *p)
+}
+
+func (p *SearchHistoryDeleteRequest) DeepEqual(ano *SearchHistoryDeleteRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Keyword) {
+		return false
+	}
+	return true
+}
+
+func (p *SearchHistoryDeleteRequest) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Keyword, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_SearchHistoryDeleteRequest = map[int16]string{
+	1: "keyword",
+}
+
+type SearchHistoryDeleteResponse struct {
+	BaseResp *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+}
+
+func NewSearchHistoryDeleteResponse() *SearchHistoryDeleteResponse {
+	return &SearchHistoryDeleteResponse{}
+}
+
+func (p *SearchHistoryDeleteResponse) InitDefault() {
+}
+
+var SearchHistoryDeleteResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *SearchHistoryDeleteResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return SearchHistoryDeleteResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *SearchHistoryDeleteResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *SearchHistoryDeleteResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *SearchHistoryDeleteResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchHistoryDeleteResponse(%+v)", *p)
+}
+
+func (p *SearchHistoryDeleteResponse) DeepEqual(ano *SearchHistoryDeleteResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *SearchHistoryDeleteResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_SearchHistoryDeleteResponse = map[int16]string{
+	1: "base_resp",
+}
+
+type SearchHistoryClearRequest struct {
+}
+
+func NewSearchHistoryClearRequest() *SearchHistoryClearRequest {
+	return &SearchHistoryClearRequest{}
+}
+
+func (p *SearchHistoryClearRequest) InitDefault() {
+}
+
+func (p *SearchHistoryClearRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchHistoryClearRequest(%+v)", *p)
+}
+
+func (p *SearchHistoryClearRequest) DeepEqual(ano *SearchHistoryClearRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_SearchHistoryClearRequest = map[int16]string{}
+
+type SearchHistoryClearResponse struct {
+	BaseResp *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+}
+
+func NewSearchHistoryClearResponse() *SearchHistoryClearResponse {
+	return &SearchHistoryClearResponse{}
+}
+
+func (p *SearchHistoryClearResponse) InitDefault() {
+}
+
+var SearchHistoryClearResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *SearchHistoryClearResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return SearchHistoryClearResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *SearchHistoryClearResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *SearchHistoryClearResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *SearchHistoryClearResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("SearchHistoryClearResponse(%+v)", *p)
+}
+
+func (p *SearchHistoryClearResponse) DeepEqual(ano *SearchHistoryClearResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *SearchHistoryClearResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_SearchHistoryClearResponse = map[int16]string{
+	1: "base_resp",
+}
+
+type VideoProcessingStatusRequest struct {
+	VideoId int64 `thrift:"video_id,1,required" frugal:"1,required,i64" json:"video_id"`
+}
+
+func NewVideoProcessingStatusRequest() *VideoProcessingStatusRequest {
+	return &VideoProcessingStatusRequest{}
+}
+
+func (p *VideoProcessingStatusRequest) InitDefault() {
+}
+
+func (p *VideoProcessingStatusRequest) GetVideoId() (v int64) {
+	return p.VideoId
+}
+func (p *VideoProcessingStatusRequest) SetVideoId(val int64) {
+	p.VideoId = val
+}
+
+func (p *VideoProcessingStatusRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoProcessingStatusRequest(%+v)", *p)
+}
+
+func (p *VideoProcessingStatusRequest) DeepEqual(ano *VideoProcessingStatusRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.VideoId) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoProcessingStatusRequest) Field1DeepEqual(src int64) bool {
+
+	if p.VideoId != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoProcessingStatusRequest = map[int16]string{
+	1: "video_id",
+}
+
+type VideoProcessingStatusResponse struct {
+	BaseResp *model.BaseResp              `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	Status   *model.VideoProcessingStatus `thrift:"status,2,required" frugal:"2,required,model.VideoProcessingStatus" json:"status"`
+}
+
+func NewVideoProcessingStatusResponse() *VideoProcessingStatusResponse {
+	return &VideoProcessingStatusResponse{}
+}
+
+func (p *VideoProcessingStatusResponse) InitDefault() {
+}
+
+var VideoProcessingStatusResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *VideoProcessingStatusResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return VideoProcessingStatusResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var VideoProcessingStatusResponse_Status_DEFAULT *model.VideoProcessingStatus
+
+func (p *VideoProcessingStatusResponse) GetStatus() (v *model.VideoProcessingStatus) {
+	if !p.IsSetStatus() {
+		return VideoProcessingStatusResponse_Status_DEFAULT
+	}
+	return p.Status
+}
+func (p *VideoProcessingStatusResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+func (p *VideoProcessingStatusResponse) SetStatus(val *model.VideoProcessingStatus) {
+	p.Status = val
+}
+
+func (p *VideoProcessingStatusResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *VideoProcessingStatusResponse) IsSetStatus() bool {
+	return p.Status != nil
+}
+
+func (p *VideoProcessingStatusResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoProcessingStatusResponse(%+v)", *p)
+}
+
+func (p *VideoProcessingStatusResponse) DeepEqual(ano *VideoProcessingStatusResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Status) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoProcessingStatusResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *VideoProcessingStatusResponse) Field2DeepEqual(src *model.VideoProcessingStatus) bool {
+
+	if !p.Status.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoProcessingStatusResponse = map[int16]string{
+	1: "base_resp",
+	2: "status",
+}
+
+type InitVideoUploadRequest struct {
+	Title           string   `thrift:"title,1,required" frugal:"1,required,string" json:"title"`
+	Description     string   `thrift:"description,2,required" frugal:"2,required,string" json:"description"`
+	CoverUrl        *string  `thrift:"cover_url,3,optional" frugal:"3,optional,string" json:"cover_url,omitempty"`
+	DurationSeconds int64    `thrift:"duration_seconds,4,required" frugal:"4,required,i64" json:"duration_seconds"`
+	IsDraft         *bool    `thrift:"is_draft,5,optional" frugal:"5,optional,bool" json:"is_draft,omitempty"`
+	PublishAt       *int64   `thrift:"publish_at,6,optional" frugal:"6,optional,i64" json:"publish_at,omitempty"`
+	Tags            []string `thrift:"tags,7,optional" frugal:"7,optional,list<string>" json:"tags,omitempty"`
+	FileSize        int64    `thrift:"file_size,8,required" frugal:"8,required,i64" json:"file_size"`
+}
+
+func NewInitVideoUploadRequest() *InitVideoUploadRequest {
+	return &InitVideoUploadRequest{}
+}
+
+func (p *InitVideoUploadRequest) InitDefault() {
+}
+
+func (p *InitVideoUploadRequest) GetTitle() (v string) {
+	return p.Title
+}
+
+func (p *InitVideoUploadRequest) GetDescription() (v string) {
+	return p.Description
+}
+
+var InitVideoUploadRequest_CoverUrl_DEFAULT string
+
+func (p *InitVideoUploadRequest) GetCoverUrl() (v string) {
+	if !p.IsSetCoverUrl() {
+		return InitVideoUploadRequest_CoverUrl_DEFAULT
+	}
+	return *p.CoverUrl
+}
+
+func (p *InitVideoUploadRequest) GetDurationSeconds() (v int64) {
+	return p.DurationSeconds
+}
+
+var InitVideoUploadRequest_IsDraft_DEFAULT bool
+
+func (p *InitVideoUploadRequest) GetIsDraft() (v bool) {
+	if !p.IsSetIsDraft() {
+		return InitVideoUploadRequest_IsDraft_DEFAULT
+	}
+	return *p.IsDraft
+}
+
+var InitVideoUploadRequest_PublishAt_DEFAULT int64
+
+func (p *InitVideoUploadRequest) GetPublishAt() (v int64) {
+	if !p.IsSetPublishAt() {
+		return InitVideoUploadRequest_PublishAt_DEFAULT
+	}
+	return *p.PublishAt
+}
+
+var InitVideoUploadRequest_Tags_DEFAULT []string
+
+func (p *InitVideoUploadRequest) GetTags() (v []string) {
+	if !p.IsSetTags() {
+		return InitVideoUploadRequest_Tags_DEFAULT
+	}
+	return p.Tags
+}
+
+func (p *InitVideoUploadRequest) GetFileSize() (v int64) {
+	return p.FileSize
+}
+func (p *InitVideoUploadRequest) SetTitle(val string) {
+	p.Title = val
+}
+func (p *InitVideoUploadRequest) SetDescription(val string) {
+	p.Description = val
+}
+func (p *InitVideoUploadRequest) SetCoverUrl(val *string) {
+	p.CoverUrl = val
+}
+func (p *InitVideoUploadRequest) SetDurationSeconds(val int64) {
+	p.DurationSeconds = val
+}
+func (p *InitVideoUploadRequest) SetIsDraft(val *bool) {
+	p.IsDraft = val
+}
+func (p *InitVideoUploadRequest) SetPublishAt(val *int64) {
+	p.PublishAt = val
+}
+func (p *InitVideoUploadRequest) SetTags(val []string) {
+	p.Tags = val
+}
+func (p *InitVideoUploadRequest) SetFileSize(val int64) {
+	p.FileSize = val
+}
+
+func (p *InitVideoUploadRequest) IsSetCoverUrl() bool {
+	return p.CoverUrl != nil
+}
+
+func (p *InitVideoUploadRequest) IsSetIsDraft() bool {
+	return p.IsDraft != nil
+}
+
+func (p *InitVideoUploadRequest) IsSetPublishAt() bool {
+	return p.PublishAt != nil
+}
+
+func (p *InitVideoUploadRequest) IsSetTags() bool {
+	return p.Tags != nil
+}
+
+func (p *InitVideoUploadRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InitVideoUploadRequest(%+v)", *p)
+}
+
+func (p *InitVideoUploadRequest) DeepEqual(ano *InitVideoUploadRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Title) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Description) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.CoverUrl) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.DurationSeconds) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.IsDraft) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.PublishAt) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Tags) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.FileSize) {
+		return false
+	}
+	return true
+}
+
+func (p *InitVideoUploadRequest) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Title, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *InitVideoUploadRequest) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Description, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *InitVideoUploadRequest) Field3DeepEqual(src *string) bool {
+
+	if p.CoverUrl == src {
+		return true
+	} else if p.CoverUrl == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.CoverUrl, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *InitVideoUploadRequest) Field4DeepEqual(src int64) bool {
+
+	if p.DurationSeconds != src {
+		return false
+	}
+	return true
+}
+func (p *InitVideoUploadRequest) Field5DeepEqual(src *bool) bool {
+
+	if p.IsDraft == src {
+		return true
+	} else if p.IsDraft == nil || src == nil {
+		return false
+	}
+	if *p.IsDraft != *src {
+		return false
+	}
+	return true
+}
+func (p *InitVideoUploadRequest) Field6DeepEqual(src *int64) bool {
+
+	if p.PublishAt == src {
+		return true
+	} else if p.PublishAt == nil || src == nil {
+		return false
+	}
+	if *p.PublishAt != *src {
+		return false
+	}
+	return true
+}
+func (p *InitVideoUploadRequest) Field7DeepEqual(src []string) bool {
+
+	if len(p.Tags) != len(src) {
+		return false
+	}
+	for i, v := range p.Tags {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *InitVideoUploadRequest) Field8DeepEqual(src int64) bool {
+
+	if p.FileSize != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_InitVideoUploadRequest = map[int16]string{
+	1: "title",
+	2: "description",
+	3: "cover_url",
+	4: "duration_seconds",
+	5: "is_draft",
+	6: "publish_at",
+	7: "tags",
+	8: "file_size",
+}
+
+type InitVideoUploadResponse struct {
+	BaseResp *model.BaseResp    `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	Upload   *model.VideoUpload `thrift:"upload,2,required" frugal:"2,required,model.VideoUpload" json:"upload"`
+}
+
+func NewInitVideoUploadResponse() *InitVideoUploadResponse {
+	return &InitVideoUploadResponse{}
+}
+
+func (p *InitVideoUploadResponse) InitDefault() {
+}
+
+var InitVideoUploadResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *InitVideoUploadResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return InitVideoUploadResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var InitVideoUploadResponse_Upload_DEFAULT *model.VideoUpload
+
+func (p *InitVideoUploadResponse) GetUpload() (v *model.VideoUpload) {
+	if !p.IsSetUpload() {
+		return InitVideoUploadResponse_Upload_DEFAULT
+	}
+	return p.Upload
+}
+func (p *InitVideoUploadResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+func (p *InitVideoUploadResponse) SetUpload(val *model.VideoUpload) {
+	p.Upload = val
+}
+
+func (p *InitVideoUploadResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *InitVideoUploadResponse) IsSetUpload() bool {
+	return p.Upload != nil
+}
+
+func (p *InitVideoUploadResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InitVideoUploadResponse(%+v)", *p)
+}
+
+func (p *InitVideoUploadResponse) DeepEqual(ano *InitVideoUploadResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Upload) {
+		return false
+	}
+	return true
+}
+
+func (p *InitVideoUploadResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *InitVideoUploadResponse) Field2DeepEqual(src *model.VideoUpload) bool {
+
+	if !p.Upload.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_InitVideoUploadResponse = map[int16]string{
+	1: "base_resp",
+	2: "upload",
+}
+
+type UploadVideoChunkRequest struct {
+	UploadId   int64  `thrift:"upload_id,1,required" frugal:"1,required,i64" json:"upload_id"`
+	PartNumber int64  `thrift:"part_number,2,required" frugal:"2,required,i64" json:"part_number"`
+	Checksum   string `thrift:"checksum,3,required" frugal:"3,required,string" json:"checksum"`
+	Data       []byte `thrift:"data,4,required" frugal:"4,required,binary" json:"data"`
+}
+
+func NewUploadVideoChunkRequest() *UploadVideoChunkRequest {
+	return &UploadVideoChunkRequest{}
+}
+
+func (p *UploadVideoChunkRequest) InitDefault() {
+}
+
+func (p *UploadVideoChunkRequest) GetUploadId() (v int64) {
+	return p.UploadId
+}
+
+func (p *UploadVideoChunkRequest) GetPartNumber() (v int64) {
+	return p.PartNumber
+}
+
+func (p *UploadVideoChunkRequest) GetChecksum() (v string) {
+	return p.Checksum
+}
+
+func (p *UploadVideoChunkRequest) GetData() (v []byte) {
+	return p.Data
+}
+func (p *UploadVideoChunkRequest) SetUploadId(val int64) {
+	p.UploadId = val
+}
+func (p *UploadVideoChunkRequest) SetPartNumber(val int64) {
+	p.PartNumber = val
+}
+func (p *UploadVideoChunkRequest) SetChecksum(val string) {
+	p.Checksum = val
+}
+func (p *UploadVideoChunkRequest) SetData(val []byte) {
+	p.Data = val
+}
+
+func (p *UploadVideoChunkRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadVideoChunkRequest(%+v)", *p)
+}
+
+func (p *UploadVideoChunkRequest) DeepEqual(ano *UploadVideoChunkRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UploadId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.PartNumber) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Checksum) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Data) {
+		return false
+	}
+	return true
+}
+
+func (p *UploadVideoChunkRequest) Field1DeepEqual(src int64) bool {
+
+	if p.UploadId != src {
+		return false
+	}
+	return true
+}
+func (p *UploadVideoChunkRequest) Field2DeepEqual(src int64) bool {
+
+	if p.PartNumber != src {
+		return false
+	}
+	return true
+}
+func (p *UploadVideoChunkRequest) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.Checksum, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *UploadVideoChunkRequest) Field4DeepEqual(src []byte) bool {
+
+	if bytes.Compare(p.Data, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UploadVideoChunkRequest = map[int16]string{
+	1: "upload_id",
+	2: "part_number",
+	3: "checksum",
+	4: "data",
+}
+
+type UploadVideoChunkResponse struct {
+	BaseResp *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+}
+
+func NewUploadVideoChunkResponse() *UploadVideoChunkResponse {
+	return &UploadVideoChunkResponse{}
+}
+
+func (p *UploadVideoChunkResponse) InitDefault() {
+}
+
+var UploadVideoChunkResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *UploadVideoChunkResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return UploadVideoChunkResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *UploadVideoChunkResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *UploadVideoChunkResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UploadVideoChunkResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UploadVideoChunkResponse(%+v)", *p)
+}
+
+func (p *UploadVideoChunkResponse) DeepEqual(ano *UploadVideoChunkResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *UploadVideoChunkResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UploadVideoChunkResponse = map[int16]string{
+	1: "base_resp",
+}
+
+type GetVideoUploadRequest struct {
+	UploadId int64 `thrift:"upload_id,1,required" frugal:"1,required,i64" json:"upload_id"`
+}
+
+func NewGetVideoUploadRequest() *GetVideoUploadRequest {
+	return &GetVideoUploadRequest{}
+}
+
+func (p *GetVideoUploadRequest) InitDefault() {
+}
+
+func (p *GetVideoUploadRequest) GetUploadId() (v int64) {
+	return p.UploadId
+}
+func (p *GetVideoUploadRequest) SetUploadId(val int64) {
+	p.UploadId = val
+}
+
+func (p *GetVideoUploadRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetVideoUploadRequest(%+v)", *p)
+}
+
+func (p *GetVideoUploadRequest) DeepEqual(ano *GetVideoUploadRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UploadId) {
+		return false
+	}
+	return true
+}
+
+func (p *GetVideoUploadRequest) Field1DeepEqual(src int64) bool {
+
+	if p.UploadId != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_GetVideoUploadRequest = map[int16]string{
+	1: "upload_id",
+}
+
+type GetVideoUploadResponse struct {
+	BaseResp *model.BaseResp    `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	Upload   *model.VideoUpload `thrift:"upload,2,required" frugal:"2,required,model.VideoUpload" json:"upload"`
+}
+
+func NewGetVideoUploadResponse() *GetVideoUploadResponse {
+	return &GetVideoUploadResponse{}
+}
+
+func (p *GetVideoUploadResponse) InitDefault() {
+}
+
+var GetVideoUploadResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *GetVideoUploadResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetVideoUploadResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var GetVideoUploadResponse_Upload_DEFAULT *model.VideoUpload
+
+func (p *GetVideoUploadResponse) GetUpload() (v *model.VideoUpload) {
+	if !p.IsSetUpload() {
+		return GetVideoUploadResponse_Upload_DEFAULT
+	}
+	return p.Upload
+}
+func (p *GetVideoUploadResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+func (p *GetVideoUploadResponse) SetUpload(val *model.VideoUpload) {
+	p.Upload = val
+}
+
+func (p *GetVideoUploadResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetVideoUploadResponse) IsSetUpload() bool {
+	return p.Upload != nil
+}
+
+func (p *GetVideoUploadResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetVideoUploadResponse(%+v)", *p)
+}
+
+func (p *GetVideoUploadResponse) DeepEqual(ano *GetVideoUploadResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Upload) {
+		return false
+	}
+	return true
+}
+
+func (p *GetVideoUploadResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *GetVideoUploadResponse) Field2DeepEqual(src *model.VideoUpload) bool {
+
+	if !p.Upload.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_GetVideoUploadResponse = map[int16]string{
+	1: "base_resp",
+	2: "upload",
+}
+
+type CompleteVideoUploadRequest struct {
+	UploadId int64 `thrift:"upload_id,1,required" frugal:"1,required,i64" json:"upload_id"`
+}
+
+func NewCompleteVideoUploadRequest() *CompleteVideoUploadRequest {
+	return &CompleteVideoUploadRequest{}
+}
+
+func (p *CompleteVideoUploadRequest) InitDefault() {
+}
+
+func (p *CompleteVideoUploadRequest) GetUploadId() (v int64) {
+	return p.UploadId
+}
+func (p *CompleteVideoUploadRequest) SetUploadId(val int64) {
+	p.UploadId = val
+}
+
+func (p *CompleteVideoUploadRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CompleteVideoUploadRequest(%+v)", *p)
+}
+
+func (p *CompleteVideoUploadRequest) DeepEqual(ano *CompleteVideoUploadRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UploadId) {
+		return false
+	}
+	return true
+}
+
+func (p *CompleteVideoUploadRequest) Field1DeepEqual(src int64) bool {
+
+	if p.UploadId != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CompleteVideoUploadRequest = map[int16]string{
+	1: "upload_id",
+}
+
+type CompleteVideoUploadResponse struct {
+	BaseResp *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	VideoId  int64           `thrift:"video_id,2,required" frugal:"2,required,i64" json:"video_id"`
+	VideoUrl string          `thrift:"video_url,3,required" frugal:"3,required,string" json:"video_url"`
+}
+
+func NewCompleteVideoUploadResponse() *CompleteVideoUploadResponse {
+	return &CompleteVideoUploadResponse{}
+}
+
+func (p *CompleteVideoUploadResponse) InitDefault() {
+}
+
+var CompleteVideoUploadResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *CompleteVideoUploadResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CompleteVideoUploadResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *CompleteVideoUploadResponse) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *CompleteVideoUploadResponse) GetVideoUrl() (v string) {
+	return p.VideoUrl
+}
+func (p *CompleteVideoUploadResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+func (p *CompleteVideoUploadResponse) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *CompleteVideoUploadResponse) SetVideoUrl(val string) {
+	p.VideoUrl = val
+}
+
+func (p *CompleteVideoUploadResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CompleteVideoUploadResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CompleteVideoUploadResponse(%+v)", *p)
+}
+
+func (p *CompleteVideoUploadResponse) DeepEqual(ano *CompleteVideoUploadResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.VideoId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.VideoUrl) {
+		return false
+	}
+	return true
+}
+
+func (p *CompleteVideoUploadResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *CompleteVideoUploadResponse) Field2DeepEqual(src int64) bool {
+
+	if p.VideoId != src {
+		return false
+	}
+	return true
+}
+func (p *CompleteVideoUploadResponse) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.VideoUrl, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CompleteVideoUploadResponse = map[int16]string{
+	1: "base_resp",
+	2: "video_id",
+	3: "video_url",
+}
+
+type AbortVideoUploadRequest struct {
+	UploadId int64 `thrift:"upload_id,1,required" frugal:"1,required,i64" json:"upload_id"`
+}
+
+func NewAbortVideoUploadRequest() *AbortVideoUploadRequest {
+	return &AbortVideoUploadRequest{}
+}
+
+func (p *AbortVideoUploadRequest) InitDefault() {
+}
+
+func (p *AbortVideoUploadRequest) GetUploadId() (v int64) {
+	return p.UploadId
+}
+func (p *AbortVideoUploadRequest) SetUploadId(val int64) {
+	p.UploadId = val
+}
+
+func (p *AbortVideoUploadRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AbortVideoUploadRequest(%+v)", *p)
+}
+
+func (p *AbortVideoUploadRequest) DeepEqual(ano *AbortVideoUploadRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UploadId) {
+		return false
+	}
+	return true
+}
+
+func (p *AbortVideoUploadRequest) Field1DeepEqual(src int64) bool {
+
+	if p.UploadId != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_AbortVideoUploadRequest = map[int16]string{
+	1: "upload_id",
+}
+
+type AbortVideoUploadResponse struct {
+	BaseResp *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+}
+
+func NewAbortVideoUploadResponse() *AbortVideoUploadResponse {
+	return &AbortVideoUploadResponse{}
+}
+
+func (p *AbortVideoUploadResponse) InitDefault() {
+}
+
+var AbortVideoUploadResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *AbortVideoUploadResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return AbortVideoUploadResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+func (p *AbortVideoUploadResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+
+func (p *AbortVideoUploadResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *AbortVideoUploadResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("AbortVideoUploadResponse(%+v)", *p)
+}
+
+func (p *AbortVideoUploadResponse) DeepEqual(ano *AbortVideoUploadResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	return true
+}
+
+func (p *AbortVideoUploadResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_AbortVideoUploadResponse = map[int16]string{
+	1: "base_resp",
+}
+
+type InitDirectUploadRequest struct {
+	Title           string   `thrift:"title,1,required" frugal:"1,required,string" json:"title"`
+	Description     string   `thrift:"description,2,required" frugal:"2,required,string" json:"description"`
+	CoverUrl        *string  `thrift:"cover_url,3,optional" frugal:"3,optional,string" json:"cover_url,omitempty"`
+	DurationSeconds int64    `thrift:"duration_seconds,4,required" frugal:"4,required,i64" json:"duration_seconds"`
+	IsDraft         *bool    `thrift:"is_draft,5,optional" frugal:"5,optional,bool" json:"is_draft,omitempty"`
+	PublishAt       *int64   `thrift:"publish_at,6,optional" frugal:"6,optional,i64" json:"publish_at,omitempty"`
+	Tags            []string `thrift:"tags,7,optional" frugal:"7,optional,list<string>" json:"tags,omitempty"`
+	FileSize        int64    `thrift:"file_size,8,required" frugal:"8,required,i64" json:"file_size"`
+}
+
+func NewInitDirectUploadRequest() *InitDirectUploadRequest {
+	return &InitDirectUploadRequest{}
+}
+
+func (p *InitDirectUploadRequest) InitDefault() {
+}
+
+func (p *InitDirectUploadRequest) GetTitle() (v string) {
+	return p.Title
+}
+
+func (p *InitDirectUploadRequest) GetDescription() (v string) {
+	return p.Description
+}
+
+var InitDirectUploadRequest_CoverUrl_DEFAULT string
+
+func (p *InitDirectUploadRequest) GetCoverUrl() (v string) {
+	if !p.IsSetCoverUrl() {
+		return InitDirectUploadRequest_CoverUrl_DEFAULT
+	}
+	return *p.CoverUrl
+}
+
+func (p *InitDirectUploadRequest) GetDurationSeconds() (v int64) {
+	return p.DurationSeconds
+}
+
+var InitDirectUploadRequest_IsDraft_DEFAULT bool
+
+func (p *InitDirectUploadRequest) GetIsDraft() (v bool) {
+	if !p.IsSetIsDraft() {
+		return InitDirectUploadRequest_IsDraft_DEFAULT
+	}
+	return *p.IsDraft
+}
+
+var InitDirectUploadRequest_PublishAt_DEFAULT int64
+
+func (p *InitDirectUploadRequest) GetPublishAt() (v int64) {
+	if !p.IsSetPublishAt() {
+		return InitDirectUploadRequest_PublishAt_DEFAULT
+	}
+	return *p.PublishAt
+}
+
+var InitDirectUploadRequest_Tags_DEFAULT []string
+
+func (p *InitDirectUploadRequest) GetTags() (v []string) {
+	if !p.IsSetTags() {
+		return InitDirectUploadRequest_Tags_DEFAULT
+	}
+	return p.Tags
+}
+
+func (p *InitDirectUploadRequest) GetFileSize() (v int64) {
+	return p.FileSize
+}
+func (p *InitDirectUploadRequest) SetTitle(val string) {
+	p.Title = val
+}
+func (p *InitDirectUploadRequest) SetDescription(val string) {
+	p.Description = val
+}
+func (p *InitDirectUploadRequest) SetCoverUrl(val *string) {
+	p.CoverUrl = val
+}
+func (p *InitDirectUploadRequest) SetDurationSeconds(val int64) {
+	p.DurationSeconds = val
+}
+func (p *InitDirectUploadRequest) SetIsDraft(val *bool) {
+	p.IsDraft = val
+}
+func (p *InitDirectUploadRequest) SetPublishAt(val *int64) {
+	p.PublishAt = val
+}
+func (p *InitDirectUploadRequest) SetTags(val []string) {
+	p.Tags = val
+}
+func (p *InitDirectUploadRequest) SetFileSize(val int64) {
+	p.FileSize = val
+}
+
+func (p *InitDirectUploadRequest) IsSetCoverUrl() bool {
+	return p.CoverUrl != nil
+}
+
+func (p *InitDirectUploadRequest) IsSetIsDraft() bool {
+	return p.IsDraft != nil
+}
+
+func (p *InitDirectUploadRequest) IsSetPublishAt() bool {
+	return p.PublishAt != nil
+}
+
+func (p *InitDirectUploadRequest) IsSetTags() bool {
+	return p.Tags != nil
+}
+
+func (p *InitDirectUploadRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InitDirectUploadRequest(%+v)", *p)
+}
+
+func (p *InitDirectUploadRequest) DeepEqual(ano *InitDirectUploadRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Title) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Description) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.CoverUrl) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.DurationSeconds) {
+		return false
+	}
+	if !p.Field5DeepEqual(ano.IsDraft) {
+		return false
+	}
+	if !p.Field6DeepEqual(ano.PublishAt) {
+		return false
+	}
+	if !p.Field7DeepEqual(ano.Tags) {
+		return false
+	}
+	if !p.Field8DeepEqual(ano.FileSize) {
+		return false
+	}
+	return true
+}
+
+func (p *InitDirectUploadRequest) Field1DeepEqual(src string) bool {
+
+	if strings.Compare(p.Title, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *InitDirectUploadRequest) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Description, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *InitDirectUploadRequest) Field3DeepEqual(src *string) bool {
+
+	if p.CoverUrl == src {
+		return true
+	} else if p.CoverUrl == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.CoverUrl, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *InitDirectUploadRequest) Field4DeepEqual(src int64) bool {
+
+	if p.DurationSeconds != src {
+		return false
+	}
+	return true
+}
+func (p *InitDirectUploadRequest) Field5DeepEqual(src *bool) bool {
+
+	if p.IsDraft == src {
+		return true
+	} else if p.IsDraft == nil || src == nil {
+		return false
+	}
+	if *p.IsDraft != *src {
+		return false
+	}
+	return true
+}
+func (p *InitDirectUploadRequest) Field6DeepEqual(src *int64) bool {
+
+	if p.PublishAt == src {
+		return true
+	} else if p.PublishAt == nil || src == nil {
+		return false
+	}
+	if *p.PublishAt != *src {
+		return false
+	}
+	return true
+}
+func (p *InitDirectUploadRequest) Field7DeepEqual(src []string) bool {
+
+	if len(p.Tags) != len(src) {
+		return false
+	}
+	for i, v := range p.Tags {
+		_src := src[i]
+		if strings.Compare(v, _src) != 0 {
+			return false
+		}
+	}
+	return true
+}
+func (p *InitDirectUploadRequest) Field8DeepEqual(src int64) bool {
+
+	if p.FileSize != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_InitDirectUploadRequest = map[int16]string{
+	1: "title",
+	2: "description",
+	3: "cover_url",
+	4: "duration_seconds",
+	5: "is_draft",
+	6: "publish_at",
+	7: "tags",
+	8: "file_size",
+}
+
+type InitDirectUploadResponse struct {
+	BaseResp *model.BaseResp     `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	Upload   *model.DirectUpload `thrift:"upload,2,required" frugal:"2,required,model.DirectUpload" json:"upload"`
+}
+
+func NewInitDirectUploadResponse() *InitDirectUploadResponse {
+	return &InitDirectUploadResponse{}
+}
+
+func (p *InitDirectUploadResponse) InitDefault() {
+}
+
+var InitDirectUploadResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *InitDirectUploadResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return InitDirectUploadResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+var InitDirectUploadResponse_Upload_DEFAULT *model.DirectUpload
+
+func (p *InitDirectUploadResponse) GetUpload() (v *model.DirectUpload) {
+	if !p.IsSetUpload() {
+		return InitDirectUploadResponse_Upload_DEFAULT
+	}
+	return p.Upload
+}
+func (p *InitDirectUploadResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+func (p *InitDirectUploadResponse) SetUpload(val *model.DirectUpload) {
+	p.Upload = val
+}
+
+func (p *InitDirectUploadResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *InitDirectUploadResponse) IsSetUpload() bool {
+	return p.Upload != nil
+}
+
+func (p *InitDirectUploadResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("InitDirectUploadResponse(%+v)", *p)
+}
+
+func (p *InitDirectUploadResponse) DeepEqual(ano *InitDirectUploadResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Upload) {
+		return false
+	}
+	return true
+}
+
+func (p *InitDirectUploadResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *InitDirectUploadResponse) Field2DeepEqual(src *model.DirectUpload) bool {
+
+	if !p.Upload.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_InitDirectUploadResponse = map[int16]string{
+	1: "base_resp",
+	2: "upload",
+}
+
+type CompleteDirectUploadRequest struct {
+	UploadId int64 `thrift:"upload_id,1,required" frugal:"1,required,i64" json:"upload_id"`
+}
+
+func NewCompleteDirectUploadRequest() *CompleteDirectUploadRequest {
+	return &CompleteDirectUploadRequest{}
+}
+
+func (p *CompleteDirectUploadRequest) InitDefault() {
+}
+
+func (p *CompleteDirectUploadRequest) GetUploadId() (v int64) {
+	return p.UploadId
+}
+func (p *CompleteDirectUploadRequest) SetUploadId(val int64) {
+	p.UploadId = val
+}
+
+func (p *CompleteDirectUploadRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CompleteDirectUploadRequest(%+v)", *p)
+}
+
+func (p *CompleteDirectUploadRequest) DeepEqual(ano *CompleteDirectUploadRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UploadId) {
+		return false
+	}
+	return true
+}
+
+func (p *CompleteDirectUploadRequest) Field1DeepEqual(src int64) bool {
+
+	if p.UploadId != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CompleteDirectUploadRequest = map[int16]string{
+	1: "upload_id",
+}
+
+type CompleteDirectUploadResponse struct {
+	BaseResp *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	VideoId  int64           `thrift:"video_id,2,required" frugal:"2,required,i64" json:"video_id"`
+	VideoUrl string          `thrift:"video_url,3,required" frugal:"3,required,string" json:"video_url"`
+}
+
+func NewCompleteDirectUploadResponse() *CompleteDirectUploadResponse {
+	return &CompleteDirectUploadResponse{}
+}
+
+func (p *CompleteDirectUploadResponse) InitDefault() {
+}
+
+var CompleteDirectUploadResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *CompleteDirectUploadResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return CompleteDirectUploadResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *CompleteDirectUploadResponse) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+func (p *CompleteDirectUploadResponse) GetVideoUrl() (v string) {
+	return p.VideoUrl
+}
+func (p *CompleteDirectUploadResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+func (p *CompleteDirectUploadResponse) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *CompleteDirectUploadResponse) SetVideoUrl(val string) {
+	p.VideoUrl = val
+}
+
+func (p *CompleteDirectUploadResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *CompleteDirectUploadResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("CompleteDirectUploadResponse(%+v)", *p)
+}
+
+func (p *CompleteDirectUploadResponse) DeepEqual(ano *CompleteDirectUploadResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.VideoId) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.VideoUrl) {
+		return false
+	}
+	return true
+}
+
+func (p *CompleteDirectUploadResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *CompleteDirectUploadResponse) Field2DeepEqual(src int64) bool {
+
+	if p.VideoId != src {
+		return false
+	}
+	return true
+}
+func (p *CompleteDirectUploadResponse) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.VideoUrl, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_CompleteDirectUploadResponse = map[int16]string{
+	1: "base_resp",
+	2: "video_id",
+	3: "video_url",
+}
+
+type GetVideoObjectRequest struct {
+	VideoId int64 `thrift:"video_id,1,required" frugal:"1,required,i64" json:"video_id"`
+}
+
+func NewGetVideoObjectRequest() *GetVideoObjectRequest {
+	return &GetVideoObjectRequest{}
+}
+
+func (p *GetVideoObjectRequest) InitDefault() {
+}
+
+func (p *GetVideoObjectRequest) GetVideoId() (v int64) {
+	return p.VideoId
+}
+func (p *GetVideoObjectRequest) SetVideoId(val int64) {
+	p.VideoId = val
+}
+
+func (p *GetVideoObjectRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetVideoObjectRequest(%+v)", *p)
+}
+
+func (p *GetVideoObjectRequest) DeepEqual(ano *GetVideoObjectRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.VideoId) {
+		return false
+	}
+	return true
+}
+
+func (p *GetVideoObjectRequest) Field1DeepEqual(src int64) bool {
+
+	if p.VideoId != src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_GetVideoObjectRequest = map[int16]string{
+	1: "video_id",
+}
+
+type GetVideoObjectResponse struct {
+	BaseResp  *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	Bucket    string          `thrift:"bucket,2,required" frugal:"2,required,string" json:"bucket"`
+	ObjectKey string          `thrift:"object_key,3,required" frugal:"3,required,string" json:"object_key"`
+}
+
+func NewGetVideoObjectResponse() *GetVideoObjectResponse {
+	return &GetVideoObjectResponse{}
+}
+
+func (p *GetVideoObjectResponse) InitDefault() {
+}
+
+var GetVideoObjectResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *GetVideoObjectResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return GetVideoObjectResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *GetVideoObjectResponse) GetBucket() (v string) {
+	return p.Bucket
+}
+
+func (p *GetVideoObjectResponse) GetObjectKey() (v string) {
+	return p.ObjectKey
+}
+func (p *GetVideoObjectResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+func (p *GetVideoObjectResponse) SetBucket(val string) {
+	p.Bucket = val
+}
+func (p *GetVideoObjectResponse) SetObjectKey(val string) {
+	p.ObjectKey = val
+}
+
+func (p *GetVideoObjectResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *GetVideoObjectResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("GetVideoObjectResponse(%+v)", *p)
+}
+
+func (p *GetVideoObjectResponse) DeepEqual(ano *GetVideoObjectResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Bucket) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.ObjectKey) {
+		return false
+	}
+	return true
+}
+
+func (p *GetVideoObjectResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *GetVideoObjectResponse) Field2DeepEqual(src string) bool {
+
+	if strings.Compare(p.Bucket, src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *GetVideoObjectResponse) Field3DeepEqual(src string) bool {
+
+	if strings.Compare(p.ObjectKey, src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_GetVideoObjectResponse = map[int16]string{
+	1: "base_resp",
+	2: "bucket",
+	3: "object_key",
+}
+
+type UserVideoListRequest struct {
+	UserId int64   `thrift:"user_id,1,required" frugal:"1,required,i64" json:"user_id"`
+	Cursor *string `thrift:"cursor,2,optional" frugal:"2,optional,string" json:"cursor,omitempty"`
+	Limit  *int64  `thrift:"limit,3,optional" frugal:"3,optional,i64" json:"limit,omitempty"`
+	Sort   *string `thrift:"sort,4,optional" frugal:"4,optional,string" json:"sort,omitempty"`
+}
+
+func NewUserVideoListRequest() *UserVideoListRequest {
+	return &UserVideoListRequest{}
+}
+
+func (p *UserVideoListRequest) InitDefault() {
+}
+
+func (p *UserVideoListRequest) GetUserId() (v int64) {
+	return p.UserId
+}
+
+var UserVideoListRequest_Cursor_DEFAULT string
+
+func (p *UserVideoListRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return UserVideoListRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var UserVideoListRequest_Limit_DEFAULT int64
+
+func (p *UserVideoListRequest) GetLimit() (v int64) {
+	if !p.IsSetLimit() {
+		return UserVideoListRequest_Limit_DEFAULT
+	}
+	return *p.Limit
+}
+
+var UserVideoListRequest_Sort_DEFAULT string
+
+func (p *UserVideoListRequest) GetSort() (v string) {
+	if !p.IsSetSort() {
+		return UserVideoListRequest_Sort_DEFAULT
+	}
+	return *p.Sort
+}
+func (p *UserVideoListRequest) SetUserId(val int64) {
+	p.UserId = val
+}
+func (p *UserVideoListRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *UserVideoListRequest) SetLimit(val *int64) {
+	p.Limit = val
+}
+func (p *UserVideoListRequest) SetSort(val *string) {
+	p.Sort = val
+}
+
+func (p *UserVideoListRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *UserVideoListRequest) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *UserVideoListRequest) IsSetSort() bool {
+	return p.Sort != nil
+}
+
+func (p *UserVideoListRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserVideoListRequest(%+v)", *p)
+}
+
+func (p *UserVideoListRequest) DeepEqual(ano *UserVideoListRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.UserId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Cursor) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.Limit) {
+		return false
+	}
+	if !p.Field4DeepEqual(ano.Sort) {
+		return false
+	}
+	return true
+}
+
+func (p *UserVideoListRequest) Field1DeepEqual(src int64) bool {
+
+	if p.UserId != src {
+		return false
+	}
+	return true
+}
+func (p *UserVideoListRequest) Field2DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *UserVideoListRequest) Field3DeepEqual(src *int64) bool {
+
+	if p.Limit == src {
+		return true
+	} else if p.Limit == nil || src == nil {
+		return false
+	}
+	if *p.Limit != *src {
+		return false
+	}
+	return true
+}
+func (p *UserVideoListRequest) Field4DeepEqual(src *string) bool {
+
+	if p.Sort == src {
+		return true
+	} else if p.Sort == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Sort, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserVideoListRequest = map[int16]string{
+	1: "user_id",
+	2: "cursor",
+	3: "limit",
+	4: "sort",
+}
+
+type UserVideoListResponse struct {
+	BaseResp   *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	Videos     []*model.Video  `thrift:"videos,2,required" frugal:"2,required,list<model.Video>" json:"videos"`
+	NextCursor *string         `thrift:"next_cursor,3,optional" frugal:"3,optional,string" json:"next_cursor,omitempty"`
+}
+
+func NewUserVideoListResponse() *UserVideoListResponse {
+	return &UserVideoListResponse{}
+}
+
+func (p *UserVideoListResponse) InitDefault() {
+}
+
+var UserVideoListResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *UserVideoListResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return UserVideoListResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *UserVideoListResponse) GetVideos() (v []*model.Video) {
+	return p.Videos
+}
+
+var UserVideoListResponse_NextCursor_DEFAULT string
+
+func (p *UserVideoListResponse) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return UserVideoListResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+func (p *UserVideoListResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+func (p *UserVideoListResponse) SetVideos(val []*model.Video) {
+	p.Videos = val
+}
+func (p *UserVideoListResponse) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+
+func (p *UserVideoListResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *UserVideoListResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *UserVideoListResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("UserVideoListResponse(%+v)", *p)
+}
+
+func (p *UserVideoListResponse) DeepEqual(ano *UserVideoListResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Videos) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.NextCursor) {
+		return false
+	}
+	return true
+}
+
+func (p *UserVideoListResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *UserVideoListResponse) Field2DeepEqual(src []*model.Video) bool {
+
+	if len(p.Videos) != len(src) {
+		return false
+	}
+	for i, v := range p.Videos {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *UserVideoListResponse) Field3DeepEqual(src *string) bool {
+
+	if p.NextCursor == src {
+		return true
+	} else if p.NextCursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NextCursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_UserVideoListResponse = map[int16]string{
+	1: "base_resp",
+	2: "videos",
+	3: "next_cursor",
+}
+
+type FeedRequest struct {
+	Cursor *string `thrift:"cursor,1,optional" frugal:"1,optional,string" json:"cursor,omitempty"`
+	Limit  *int64  `thrift:"limit,2,optional" frugal:"2,optional,i64" json:"limit,omitempty"`
+}
+
+func NewFeedRequest() *FeedRequest {
+	return &FeedRequest{}
+}
+
+func (p *FeedRequest) InitDefault() {
+}
+
+var FeedRequest_Cursor_DEFAULT string
+
+func (p *FeedRequest) GetCursor() (v string) {
+	if !p.IsSetCursor() {
+		return FeedRequest_Cursor_DEFAULT
+	}
+	return *p.Cursor
+}
+
+var FeedRequest_Limit_DEFAULT int64
+
+func (p *FeedRequest) GetLimit() (v int64) {
+	if !p.IsSetLimit() {
+		return FeedRequest_Limit_DEFAULT
+	}
+	return *p.Limit
+}
+func (p *FeedRequest) SetCursor(val *string) {
+	p.Cursor = val
+}
+func (p *FeedRequest) SetLimit(val *int64) {
+	p.Limit = val
+}
+
+func (p *FeedRequest) IsSetCursor() bool {
+	return p.Cursor != nil
+}
+
+func (p *FeedRequest) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *FeedRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FeedRequest(%+v)", *p)
+}
+
+func (p *FeedRequest) DeepEqual(ano *FeedRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Cursor) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Limit) {
+		return false
+	}
+	return true
+}
+
+func (p *FeedRequest) Field1DeepEqual(src *string) bool {
+
+	if p.Cursor == src {
+		return true
+	} else if p.Cursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.Cursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+func (p *FeedRequest) Field2DeepEqual(src *int64) bool {
+
+	if p.Limit == src {
+		return true
+	} else if p.Limit == nil || src == nil {
+		return false
+	}
+	if *p.Limit != *src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_FeedRequest = map[int16]string{
+	1: "cursor",
+	2: "limit",
+}
+
+type FeedResponse struct {
+	BaseResp   *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	Videos     []*model.Video  `thrift:"videos,2,required" frugal:"2,required,list<model.Video>" json:"videos"`
+	NextCursor *string         `thrift:"next_cursor,3,optional" frugal:"3,optional,string" json:"next_cursor,omitempty"`
+}
+
+func NewFeedResponse() *FeedResponse {
+	return &FeedResponse{}
+}
+
+func (p *FeedResponse) InitDefault() {
+}
+
+var FeedResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *FeedResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return FeedResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *FeedResponse) GetVideos() (v []*model.Video) {
+	return p.Videos
+}
+
+var FeedResponse_NextCursor_DEFAULT string
+
+func (p *FeedResponse) GetNextCursor() (v string) {
+	if !p.IsSetNextCursor() {
+		return FeedResponse_NextCursor_DEFAULT
+	}
+	return *p.NextCursor
+}
+func (p *FeedResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+func (p *FeedResponse) SetVideos(val []*model.Video) {
+	p.Videos = val
+}
+func (p *FeedResponse) SetNextCursor(val *string) {
+	p.NextCursor = val
+}
+
+func (p *FeedResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *FeedResponse) IsSetNextCursor() bool {
+	return p.NextCursor != nil
+}
+
+func (p *FeedResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("FeedResponse(%+v)", *p)
+}
+
+func (p *FeedResponse) DeepEqual(ano *FeedResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Videos) {
+		return false
+	}
+	if !p.Field3DeepEqual(ano.NextCursor) {
+		return false
+	}
+	return true
+}
+
+func (p *FeedResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *FeedResponse) Field2DeepEqual(src []*model.Video) bool {
+
+	if len(p.Videos) != len(src) {
+		return false
+	}
+	for i, v := range p.Videos {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+func (p *FeedResponse) Field3DeepEqual(src *string) bool {
+
+	if p.NextCursor == src {
+		return true
+	} else if p.NextCursor == nil || src == nil {
+		return false
+	}
+	if strings.Compare(*p.NextCursor, *src) != 0 {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_FeedResponse = map[int16]string{
+	1: "base_resp",
+	2: "videos",
+	3: "next_cursor",
+}
+
+type RelatedVideosRequest struct {
+	VideoId int64  `thrift:"video_id,1,required" frugal:"1,required,i64" json:"video_id"`
+	Limit   *int64 `thrift:"limit,2,optional" frugal:"2,optional,i64" json:"limit,omitempty"`
+}
+
+func NewRelatedVideosRequest() *RelatedVideosRequest {
+	return &RelatedVideosRequest{}
+}
+
+func (p *RelatedVideosRequest) InitDefault() {
+}
+
+func (p *RelatedVideosRequest) GetVideoId() (v int64) {
+	return p.VideoId
+}
+
+var RelatedVideosRequest_Limit_DEFAULT int64
+
+func (p *RelatedVideosRequest) GetLimit() (v int64) {
+	if !p.IsSetLimit() {
+		return RelatedVideosRequest_Limit_DEFAULT
+	}
+	return *p.Limit
+}
+func (p *RelatedVideosRequest) SetVideoId(val int64) {
+	p.VideoId = val
+}
+func (p *RelatedVideosRequest) SetLimit(val *int64) {
+	p.Limit = val
+}
+
+func (p *RelatedVideosRequest) IsSetLimit() bool {
+	return p.Limit != nil
+}
+
+func (p *RelatedVideosRequest) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelatedVideosRequest(%+v)", *p)
+}
+
+func (p *RelatedVideosRequest) DeepEqual(ano *RelatedVideosRequest) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.VideoId) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Limit) {
+		return false
+	}
+	return true
+}
+
+func (p *RelatedVideosRequest) Field1DeepEqual(src int64) bool {
+
+	if p.VideoId != src {
+		return false
+	}
+	return true
+}
+func (p *RelatedVideosRequest) Field2DeepEqual(src *int64) bool {
+
+	if p.Limit == src {
+		return true
+	} else if p.Limit == nil || src == nil {
+		return false
+	}
+	if *p.Limit != *src {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_RelatedVideosRequest = map[int16]string{
+	1: "video_id",
+	2: "limit",
+}
+
+type RelatedVideosResponse struct {
+	BaseResp *model.BaseResp `thrift:"base_resp,1,required" frugal:"1,required,model.BaseResp" json:"base_resp"`
+	Videos   []*model.Video  `thrift:"videos,2,required" frugal:"2,required,list<model.Video>" json:"videos"`
+}
+
+func NewRelatedVideosResponse() *RelatedVideosResponse {
+	return &RelatedVideosResponse{}
+}
+
+func (p *RelatedVideosResponse) InitDefault() {
+}
+
+var RelatedVideosResponse_BaseResp_DEFAULT *model.BaseResp
+
+func (p *RelatedVideosResponse) GetBaseResp() (v *model.BaseResp) {
+	if !p.IsSetBaseResp() {
+		return RelatedVideosResponse_BaseResp_DEFAULT
+	}
+	return p.BaseResp
+}
+
+func (p *RelatedVideosResponse) GetVideos() (v []*model.Video) {
+	return p.Videos
+}
+func (p *RelatedVideosResponse) SetBaseResp(val *model.BaseResp) {
+	p.BaseResp = val
+}
+func (p *RelatedVideosResponse) SetVideos(val []*model.Video) {
+	p.Videos = val
+}
+
+func (p *RelatedVideosResponse) IsSetBaseResp() bool {
+	return p.BaseResp != nil
+}
+
+func (p *RelatedVideosResponse) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("RelatedVideosResponse(%+v)", *p)
+}
+
+func (p *RelatedVideosResponse) DeepEqual(ano *RelatedVideosResponse) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.BaseResp) {
+		return false
+	}
+	if !p.Field2DeepEqual(ano.Videos) {
+		return false
+	}
+	return true
+}
+
+func (p *RelatedVideosResponse) Field1DeepEqual(src *model.BaseResp) bool {
+
+	if !p.BaseResp.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+func (p *RelatedVideosResponse) Field2DeepEqual(src []*model.Video) bool {
+
+	if len(p.Videos) != len(src) {
+		return false
+	}
+	for i, v := range p.Videos {
+		_src := src[i]
+		if !v.DeepEqual(_src) {
+			return false
+		}
+	}
+	return true
+}
+
+var fieldIDToName_RelatedVideosResponse = map[int16]string{
+	1: "base_resp",
+	2: "videos",
+}
+
+type VideoService interface {
+	SubmitVideo(ctx context.Context, req *VideoSubmissionRequest) (r *VideoSubmissionResponse, err error)
+
+	GetVideo(ctx context.Context, req *VideoDetailRequest) (r *VideoDetailResponse, err error)
+
+	SearchVideo(ctx context.Context, req *VideoSearchRequest) (r *VideoSearchResponse, err error)
+
+	TrendVideo(ctx context.Context, req *VideoTrendingRequest) (r *VideoTrendingResponse, err error)
+
+	UpdateVideoHot(ctx context.Context, req *VideoHotUpdateRequest) (r *VideoHotUpdateResponse, err error)
+
+	UpdateVideo(ctx context.Context, req *VideoUpdateRequest) (r *VideoUpdateResponse, err error)
+
+	DeleteVideo(ctx context.Context, req *VideoDeleteRequest) (r *VideoDeleteResponse, err error)
+
+	ListDrafts(ctx context.Context, req *VideoDraftListRequest) (r *VideoDraftListResponse, err error)
+
+	ScheduleVideo(ctx context.Context, req *VideoScheduleRequest) (r *VideoScheduleResponse, err error)
+
+	ListVideosByTag(ctx context.Context, req *VideoTagListRequest) (r *VideoTagListResponse, err error)
+
+	PopularTags(ctx context.Context, req *PopularTagsRequest) (r *PopularTagsResponse, err error)
+
+	SuggestSearch(ctx context.Context, req *SearchSuggestRequest) (r *SearchSuggestResponse, err error)
+
+	TrendingSearches(ctx context.Context, req *TrendingSearchesRequest) (r *TrendingSearchesResponse, err error)
+
+	ListSearchHistory(ctx context.Context, req *SearchHistoryListRequest) (r *SearchHistoryListResponse, err error)
+
+	DeleteSearchHistoryItem(ctx context.Context, req *SearchHistoryDeleteRequest) (r *SearchHistoryDeleteResponse, err error)
+
+	ClearSearchHistory(ctx context.Context, req *SearchHistoryClearRequest) (r *SearchHistoryClearResponse, err error)
+
+	GetVideoProcessingStatus(ctx context.Context, req *VideoProcessingStatusRequest) (r *VideoProcessingStatusResponse, err error)
+
+	InitVideoUpload(ctx context.Context, req *InitVideoUploadRequest) (r *InitVideoUploadResponse, err error)
+
+	UploadVideoChunk(ctx context.Context, req *UploadVideoChunkRequest) (r *UploadVideoChunkResponse, err error)
+
+	GetVideoUpload(ctx context.Context, req *GetVideoUploadRequest) (r *GetVideoUploadResponse, err error)
+
+	CompleteVideoUpload(ctx context.Context, req *CompleteVideoUploadRequest) (r *CompleteVideoUploadResponse, err error)
+
+	AbortVideoUpload(ctx context.Context, req *AbortVideoUploadRequest) (r *AbortVideoUploadResponse, err error)
+
+	InitDirectUpload(ctx context.Context, req *InitDirectUploadRequest) (r *InitDirectUploadResponse, err error)
+
+	CompleteDirectUpload(ctx context.Context, req *CompleteDirectUploadRequest) (r *CompleteDirectUploadResponse, err error)
+
+	GetVideoObject(ctx context.Context, req *GetVideoObjectRequest) (r *GetVideoObjectResponse, err error)
+
+	ListUserVideos(ctx context.Context, req *UserVideoListRequest) (r *UserVideoListResponse, err error)
+
+	GetFeed(ctx context.Context, req *FeedRequest) (r *FeedResponse, err error)
+
+	RelatedVideos(ctx context.Context, req *RelatedVideosRequest) (r *RelatedVideosResponse, err error)
+}
+
+type VideoServiceSubmitVideoArgs struct {
+	Req *VideoSubmissionRequest `thrift:"req,1" frugal:"1,default,VideoSubmissionRequest" json:"req"`
+}
+
+func NewVideoServiceSubmitVideoArgs() *VideoServiceSubmitVideoArgs {
+	return &VideoServiceSubmitVideoArgs{}
+}
+
+func (p *VideoServiceSubmitVideoArgs) InitDefault() {
+}
+
+var VideoServiceSubmitVideoArgs_Req_DEFAULT *VideoSubmissionRequest
+
+func (p *VideoServiceSubmitVideoArgs) GetReq() (v *VideoSubmissionRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceSubmitVideoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceSubmitVideoArgs) SetReq(val *VideoSubmissionRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceSubmitVideoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceSubmitVideoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceSubmitVideoArgs(%+v)", *p)
+}
+
+func (p *VideoServiceSubmitVideoArgs) DeepEqual(ano *VideoServiceSubmitVideoArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceSubmitVideoArgs) Field1DeepEqual(src *VideoSubmissionRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceSubmitVideoArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceSubmitVideoResult struct {
+	Success *VideoSubmissionResponse `thrift:"success,0,optional" frugal:"0,optional,VideoSubmissionResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceSubmitVideoResult() *VideoServiceSubmitVideoResult {
+	return &VideoServiceSubmitVideoResult{}
+}
+
+func (p *VideoServiceSubmitVideoResult) InitDefault() {
+}
+
+var VideoServiceSubmitVideoResult_Success_DEFAULT *VideoSubmissionResponse
+
+func (p *VideoServiceSubmitVideoResult) GetSuccess() (v *VideoSubmissionResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceSubmitVideoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceSubmitVideoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*VideoSubmissionResponse)
+}
+
+func (p *VideoServiceSubmitVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceSubmitVideoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceSubmitVideoResult(%+v)", *p)
+}
+
+func (p *VideoServiceSubmitVideoResult) DeepEqual(ano *VideoServiceSubmitVideoResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceSubmitVideoResult) Field0DeepEqual(src *VideoSubmissionResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceSubmitVideoResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceGetVideoArgs struct {
+	Req *VideoDetailRequest `thrift:"req,1" frugal:"1,default,VideoDetailRequest" json:"req"`
+}
+
+func NewVideoServiceGetVideoArgs() *VideoServiceGetVideoArgs {
+	return &VideoServiceGetVideoArgs{}
+}
+
+func (p *VideoServiceGetVideoArgs) InitDefault() {
+}
+
+var VideoServiceGetVideoArgs_Req_DEFAULT *VideoDetailRequest
+
+func (p *VideoServiceGetVideoArgs) GetReq() (v *VideoDetailRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceGetVideoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceGetVideoArgs) SetReq(val *VideoDetailRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceGetVideoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceGetVideoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceGetVideoArgs(%+v)", *p)
+}
+
+func (p *VideoServiceGetVideoArgs) DeepEqual(ano *VideoServiceGetVideoArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceGetVideoArgs) Field1DeepEqual(src *VideoDetailRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceGetVideoArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceGetVideoResult struct {
+	Success *VideoDetailResponse `thrift:"success,0,optional" frugal:"0,optional,VideoDetailResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceGetVideoResult() *VideoServiceGetVideoResult {
+	return &VideoServiceGetVideoResult{}
+}
+
+func (p *VideoServiceGetVideoResult) InitDefault() {
+}
+
+var VideoServiceGetVideoResult_Success_DEFAULT *VideoDetailResponse
+
+func (p *VideoServiceGetVideoResult) GetSuccess() (v *VideoDetailResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceGetVideoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceGetVideoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*VideoDetailResponse)
+}
+
+func (p *VideoServiceGetVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceGetVideoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceGetVideoResult(%+v)", *p)
+}
+
+func (p *VideoServiceGetVideoResult) DeepEqual(ano *VideoServiceGetVideoResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceGetVideoResult) Field0DeepEqual(src *VideoDetailResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceGetVideoResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceSearchVideoArgs struct {
+	Req *VideoSearchRequest `thrift:"req,1" frugal:"1,default,VideoSearchRequest" json:"req"`
+}
+
+func NewVideoServiceSearchVideoArgs() *VideoServiceSearchVideoArgs {
+	return &VideoServiceSearchVideoArgs{}
+}
+
+func (p *VideoServiceSearchVideoArgs) InitDefault() {
+}
+
+var VideoServiceSearchVideoArgs_Req_DEFAULT *VideoSearchRequest
+
+func (p *VideoServiceSearchVideoArgs) GetReq() (v *VideoSearchRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceSearchVideoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceSearchVideoArgs) SetReq(val *VideoSearchRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceSearchVideoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceSearchVideoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceSearchVideoArgs(%+v)", *p)
+}
+
+func (p *VideoServiceSearchVideoArgs) DeepEqual(ano *VideoServiceSearchVideoArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceSearchVideoArgs) Field1DeepEqual(src *VideoSearchRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceSearchVideoArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceSearchVideoResult struct {
+	Success *VideoSearchResponse `thrift:"success,0,optional" frugal:"0,optional,VideoSearchResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceSearchVideoResult() *VideoServiceSearchVideoResult {
+	return &VideoServiceSearchVideoResult{}
+}
+
+func (p *VideoServiceSearchVideoResult) InitDefault() {
+}
+
+var VideoServiceSearchVideoResult_Success_DEFAULT *VideoSearchResponse
+
+func (p *VideoServiceSearchVideoResult) GetSuccess() (v *VideoSearchResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceSearchVideoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceSearchVideoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*VideoSearchResponse)
+}
+
+func (p *VideoServiceSearchVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceSearchVideoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceSearchVideoResult(%+v)", *p)
+}
+
+func (p *VideoServiceSearchVideoResult) DeepEqual(ano *VideoServiceSearchVideoResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceSearchVideoResult) Field0DeepEqual(src *VideoSearchResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceSearchVideoResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceTrendVideoArgs struct {
+	Req *VideoTrendingRequest `thrift:"req,1" frugal:"1,default,VideoTrendingRequest" json:"req"`
+}
+
+func NewVideoServiceTrendVideoArgs() *VideoServiceTrendVideoArgs {
+	return &VideoServiceTrendVideoArgs{}
+}
+
+func (p *VideoServiceTrendVideoArgs) InitDefault() {
+}
+
+var VideoServiceTrendVideoArgs_Req_DEFAULT *VideoTrendingRequest
+
+func (p *VideoServiceTrendVideoArgs) GetReq() (v *VideoTrendingRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceTrendVideoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceTrendVideoArgs) SetReq(val *VideoTrendingRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceTrendVideoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceTrendVideoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceTrendVideoArgs(%+v)", *p)
+}
+
+func (p *VideoServiceTrendVideoArgs) DeepEqual(ano *VideoServiceTrendVideoArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceTrendVideoArgs) Field1DeepEqual(src *VideoTrendingRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceTrendVideoArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceTrendVideoResult struct {
+	Success *VideoTrendingResponse `thrift:"success,0,optional" frugal:"0,optional,VideoTrendingResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceTrendVideoResult() *VideoServiceTrendVideoResult {
+	return &VideoServiceTrendVideoResult{}
+}
+
+func (p *VideoServiceTrendVideoResult) InitDefault() {
+}
+
+var VideoServiceTrendVideoResult_Success_DEFAULT *VideoTrendingResponse
+
+func (p *VideoServiceTrendVideoResult) GetSuccess() (v *VideoTrendingResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceTrendVideoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceTrendVideoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*VideoTrendingResponse)
+}
+
+func (p *VideoServiceTrendVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceTrendVideoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceTrendVideoResult(%+v)", *p)
+}
+
+func (p *VideoServiceTrendVideoResult) DeepEqual(ano *VideoServiceTrendVideoResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceTrendVideoResult) Field0DeepEqual(src *VideoTrendingResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceTrendVideoResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceUpdateVideoHotArgs struct {
+	Req *VideoHotUpdateRequest `thrift:"req,1" frugal:"1,default,VideoHotUpdateRequest" json:"req"`
+}
+
+func NewVideoServiceUpdateVideoHotArgs() *VideoServiceUpdateVideoHotArgs {
+	return &VideoServiceUpdateVideoHotArgs{}
+}
+
+func (p *VideoServiceUpdateVideoHotArgs) InitDefault() {
+}
+
+var VideoServiceUpdateVideoHotArgs_Req_DEFAULT *VideoHotUpdateRequest
+
+func (p *VideoServiceUpdateVideoHotArgs) GetReq() (v *VideoHotUpdateRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceUpdateVideoHotArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceUpdateVideoHotArgs) SetReq(val *VideoHotUpdateRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceUpdateVideoHotArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceUpdateVideoHotArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceUpdateVideoHotArgs(%+v)", *p)
+}
+
+func (p *VideoServiceUpdateVideoHotArgs) DeepEqual(ano *VideoServiceUpdateVideoHotArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceUpdateVideoHotArgs) Field1DeepEqual(src *VideoHotUpdateRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceUpdateVideoHotArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceUpdateVideoHotResult struct {
+	Success *VideoHotUpdateResponse `thrift:"success,0,optional" frugal:"0,optional,VideoHotUpdateResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceUpdateVideoHotResult() *VideoServiceUpdateVideoHotResult {
+	return &VideoServiceUpdateVideoHotResult{}
+}
+
+func (p *VideoServiceUpdateVideoHotResult) InitDefault() {
+}
+
+var VideoServiceUpdateVideoHotResult_Success_DEFAULT *VideoHotUpdateResponse
+
+func (p *VideoServiceUpdateVideoHotResult) GetSuccess() (v *VideoHotUpdateResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceUpdateVideoHotResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceUpdateVideoHotResult) SetSuccess(x interface{}) {
+	p.Success = x.(*VideoHotUpdateResponse)
+}
+
+func (p *VideoServiceUpdateVideoHotResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceUpdateVideoHotResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceUpdateVideoHotResult(%+v)", *p)
+}
+
+func (p *VideoServiceUpdateVideoHotResult) DeepEqual(ano *VideoServiceUpdateVideoHotResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceUpdateVideoHotResult) Field0DeepEqual(src *VideoHotUpdateResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceUpdateVideoHotResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceUpdateVideoArgs struct {
+	Req *VideoUpdateRequest `thrift:"req,1" frugal:"1,default,VideoUpdateRequest" json:"req"`
+}
+
+func NewVideoServiceUpdateVideoArgs() *VideoServiceUpdateVideoArgs {
+	return &VideoServiceUpdateVideoArgs{}
+}
+
+func (p *VideoServiceUpdateVideoArgs) InitDefault() {
+}
+
+var VideoServiceUpdateVideoArgs_Req_DEFAULT *VideoUpdateRequest
+
+func (p *VideoServiceUpdateVideoArgs) GetReq() (v *VideoUpdateRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceUpdateVideoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceUpdateVideoArgs) SetReq(val *VideoUpdateRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceUpdateVideoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceUpdateVideoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceUpdateVideoArgs(%+v)", *p)
+}
+
+func (p *VideoServiceUpdateVideoArgs) DeepEqual(ano *VideoServiceUpdateVideoArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceUpdateVideoArgs) Field1DeepEqual(src *VideoUpdateRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceUpdateVideoArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceUpdateVideoResult struct {
+	Success *VideoUpdateResponse `thrift:"success,0,optional" frugal:"0,optional,VideoUpdateResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceUpdateVideoResult() *VideoServiceUpdateVideoResult {
+	return &VideoServiceUpdateVideoResult{}
+}
+
+func (p *VideoServiceUpdateVideoResult) InitDefault() {
+}
+
+var VideoServiceUpdateVideoResult_Success_DEFAULT *VideoUpdateResponse
+
+func (p *VideoServiceUpdateVideoResult) GetSuccess() (v *VideoUpdateResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceUpdateVideoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceUpdateVideoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*VideoUpdateResponse)
+}
+
+func (p *VideoServiceUpdateVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceUpdateVideoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceUpdateVideoResult(%+v)", *p)
+}
+
+func (p *VideoServiceUpdateVideoResult) DeepEqual(ano *VideoServiceUpdateVideoResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceUpdateVideoResult) Field0DeepEqual(src *VideoUpdateResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceUpdateVideoResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceDeleteVideoArgs struct {
+	Req *VideoDeleteRequest `thrift:"req,1" frugal:"1,default,VideoDeleteRequest" json:"req"`
+}
+
+func NewVideoServiceDeleteVideoArgs() *VideoServiceDeleteVideoArgs {
+	return &VideoServiceDeleteVideoArgs{}
+}
+
+func (p *VideoServiceDeleteVideoArgs) InitDefault() {
+}
+
+var VideoServiceDeleteVideoArgs_Req_DEFAULT *VideoDeleteRequest
+
+func (p *VideoServiceDeleteVideoArgs) GetReq() (v *VideoDeleteRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceDeleteVideoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceDeleteVideoArgs) SetReq(val *VideoDeleteRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceDeleteVideoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceDeleteVideoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceDeleteVideoArgs(%+v)", *p)
+}
+
+func (p *VideoServiceDeleteVideoArgs) DeepEqual(ano *VideoServiceDeleteVideoArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceDeleteVideoArgs) Field1DeepEqual(src *VideoDeleteRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceDeleteVideoArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceDeleteVideoResult struct {
+	Success *VideoDeleteResponse `thrift:"success,0,optional" frugal:"0,optional,VideoDeleteResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceDeleteVideoResult() *VideoServiceDeleteVideoResult {
+	return &VideoServiceDeleteVideoResult{}
+}
+
+func (p *VideoServiceDeleteVideoResult) InitDefault() {
+}
+
+var VideoServiceDeleteVideoResult_Success_DEFAULT *VideoDeleteResponse
+
+func (p *VideoServiceDeleteVideoResult) GetSuccess() (v *VideoDeleteResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceDeleteVideoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceDeleteVideoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*VideoDeleteResponse)
+}
+
+func (p *VideoServiceDeleteVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceDeleteVideoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceDeleteVideoResult(%+v)", *p)
+}
+
+func (p *VideoServiceDeleteVideoResult) DeepEqual(ano *VideoServiceDeleteVideoResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceDeleteVideoResult) Field0DeepEqual(src *VideoDeleteResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceDeleteVideoResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceListDraftsArgs struct {
+	Req *VideoDraftListRequest `thrift:"req,1" frugal:"1,default,VideoDraftListRequest" json:"req"`
+}
+
+func NewVideoServiceListDraftsArgs() *VideoServiceListDraftsArgs {
+	return &VideoServiceListDraftsArgs{}
+}
+
+func (p *VideoServiceListDraftsArgs) InitDefault() {
+}
+
+var VideoServiceListDraftsArgs_Req_DEFAULT *VideoDraftListRequest
+
+func (p *VideoServiceListDraftsArgs) GetReq() (v *VideoDraftListRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceListDraftsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceListDraftsArgs) SetReq(val *VideoDraftListRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceListDraftsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceListDraftsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceListDraftsArgs(%+v)", *p)
+}
+
+func (p *VideoServiceListDraftsArgs) DeepEqual(ano *VideoServiceListDraftsArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceListDraftsArgs) Field1DeepEqual(src *VideoDraftListRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceListDraftsArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceListDraftsResult struct {
+	Success *VideoDraftListResponse `thrift:"success,0,optional" frugal:"0,optional,VideoDraftListResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceListDraftsResult() *VideoServiceListDraftsResult {
+	return &VideoServiceListDraftsResult{}
+}
+
+func (p *VideoServiceListDraftsResult) InitDefault() {
+}
+
+var VideoServiceListDraftsResult_Success_DEFAULT *VideoDraftListResponse
+
+func (p *VideoServiceListDraftsResult) GetSuccess() (v *VideoDraftListResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceListDraftsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceListDraftsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*VideoDraftListResponse)
+}
+
+func (p *VideoServiceListDraftsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceListDraftsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceListDraftsResult(%+v)", *p)
+}
+
+func (p *VideoServiceListDraftsResult) DeepEqual(ano *VideoServiceListDraftsResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceListDraftsResult) Field0DeepEqual(src *VideoDraftListResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceListDraftsResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceScheduleVideoArgs struct {
+	Req *VideoScheduleRequest `thrift:"req,1" frugal:"1,default,VideoScheduleRequest" json:"req"`
+}
+
+func NewVideoServiceScheduleVideoArgs() *VideoServiceScheduleVideoArgs {
+	return &VideoServiceScheduleVideoArgs{}
+}
+
+func (p *VideoServiceScheduleVideoArgs) InitDefault() {
+}
+
+var VideoServiceScheduleVideoArgs_Req_DEFAULT *VideoScheduleRequest
+
+func (p *VideoServiceScheduleVideoArgs) GetReq() (v *VideoScheduleRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceScheduleVideoArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceScheduleVideoArgs) SetReq(val *VideoScheduleRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceScheduleVideoArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceScheduleVideoArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceScheduleVideoArgs(%+v)", *p)
+}
+
+func (p *VideoServiceScheduleVideoArgs) DeepEqual(ano *VideoServiceScheduleVideoArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceScheduleVideoArgs) Field1DeepEqual(src *VideoScheduleRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceScheduleVideoArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceScheduleVideoResult struct {
+	Success *VideoScheduleResponse `thrift:"success,0,optional" frugal:"0,optional,VideoScheduleResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceScheduleVideoResult() *VideoServiceScheduleVideoResult {
+	return &VideoServiceScheduleVideoResult{}
+}
+
+func (p *VideoServiceScheduleVideoResult) InitDefault() {
+}
+
+var VideoServiceScheduleVideoResult_Success_DEFAULT *VideoScheduleResponse
+
+func (p *VideoServiceScheduleVideoResult) GetSuccess() (v *VideoScheduleResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceScheduleVideoResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceScheduleVideoResult) SetSuccess(x interface{}) {
+	p.Success = x.(*VideoScheduleResponse)
+}
+
+func (p *VideoServiceScheduleVideoResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceScheduleVideoResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceScheduleVideoResult(%+v)", *p)
+}
+
+func (p *VideoServiceScheduleVideoResult) DeepEqual(ano *VideoServiceScheduleVideoResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceScheduleVideoResult) Field0DeepEqual(src *VideoScheduleResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceScheduleVideoResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceListVideosByTagArgs struct {
+	Req *VideoTagListRequest `thrift:"req,1" frugal:"1,default,VideoTagListRequest" json:"req"`
+}
+
+func NewVideoServiceListVideosByTagArgs() *VideoServiceListVideosByTagArgs {
+	return &VideoServiceListVideosByTagArgs{}
+}
+
+func (p *VideoServiceListVideosByTagArgs) InitDefault() {
+}
+
+var VideoServiceListVideosByTagArgs_Req_DEFAULT *VideoTagListRequest
+
+func (p *VideoServiceListVideosByTagArgs) GetReq() (v *VideoTagListRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceListVideosByTagArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceListVideosByTagArgs) SetReq(val *VideoTagListRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceListVideosByTagArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceListVideosByTagArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceListVideosByTagArgs(%+v)", *p)
+}
+
+func (p *VideoServiceListVideosByTagArgs) DeepEqual(ano *VideoServiceListVideosByTagArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceListVideosByTagArgs) Field1DeepEqual(src *VideoTagListRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceListVideosByTagArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceListVideosByTagResult struct {
+	Success *VideoTagListResponse `thrift:"success,0,optional" frugal:"0,optional,VideoTagListResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceListVideosByTagResult() *VideoServiceListVideosByTagResult {
+	return &VideoServiceListVideosByTagResult{}
+}
+
+func (p *VideoServiceListVideosByTagResult) InitDefault() {
+}
+
+var VideoServiceListVideosByTagResult_Success_DEFAULT *VideoTagListResponse
+
+func (p *VideoServiceListVideosByTagResult) GetSuccess() (v *VideoTagListResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceListVideosByTagResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceListVideosByTagResult) SetSuccess(x interface{}) {
+	p.Success = x.(*VideoTagListResponse)
+}
+
+func (p *VideoServiceListVideosByTagResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceListVideosByTagResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceListVideosByTagResult(%+v)", *p)
+}
+
+func (p *VideoServiceListVideosByTagResult) DeepEqual(ano *VideoServiceListVideosByTagResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceListVideosByTagResult) Field0DeepEqual(src *VideoTagListResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceListVideosByTagResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServicePopularTagsArgs struct {
+	Req *PopularTagsRequest `thrift:"req,1" frugal:"1,default,PopularTagsRequest" json:"req"`
+}
+
+func NewVideoServicePopularTagsArgs() *VideoServicePopularTagsArgs {
+	return &VideoServicePopularTagsArgs{}
+}
+
+func (p *VideoServicePopularTagsArgs) InitDefault() {
+}
+
+var VideoServicePopularTagsArgs_Req_DEFAULT *PopularTagsRequest
+
+func (p *VideoServicePopularTagsArgs) GetReq() (v *PopularTagsRequest) {
+	if !p.IsSetReq() {
+		return VideoServicePopularTagsArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServicePopularTagsArgs) SetReq(val *PopularTagsRequest) {
+	p.Req = val
+}
+
+func (p *VideoServicePopularTagsArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServicePopularTagsArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServicePopularTagsArgs(%+v)", *p)
+}
+
+func (p *VideoServicePopularTagsArgs) DeepEqual(ano *VideoServicePopularTagsArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServicePopularTagsArgs) Field1DeepEqual(src *PopularTagsRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServicePopularTagsArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServicePopularTagsResult struct {
+	Success *PopularTagsResponse `thrift:"success,0,optional" frugal:"0,optional,PopularTagsResponse" json:"success,omitempty"`
+}
+
+func NewVideoServicePopularTagsResult() *VideoServicePopularTagsResult {
+	return &VideoServicePopularTagsResult{}
+}
+
+func (p *VideoServicePopularTagsResult) InitDefault() {
+}
+
+var VideoServicePopularTagsResult_Success_DEFAULT *PopularTagsResponse
+
+func (p *VideoServicePopularTagsResult) GetSuccess() (v *PopularTagsResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServicePopularTagsResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServicePopularTagsResult) SetSuccess(x interface{}) {
+	p.Success = x.(*PopularTagsResponse)
+}
+
+func (p *VideoServicePopularTagsResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServicePopularTagsResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServicePopularTagsResult(%+v)", *p)
+}
+
+func (p *VideoServicePopularTagsResult) DeepEqual(ano *VideoServicePopularTagsResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServicePopularTagsResult) Field0DeepEqual(src *PopularTagsResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServicePopularTagsResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceSuggestSearchArgs struct {
+	Req *SearchSuggestRequest `thrift:"req,1" frugal:"1,default,SearchSuggestRequest" json:"req"`
+}
+
+func NewVideoServiceSuggestSearchArgs() *VideoServiceSuggestSearchArgs {
+	return &VideoServiceSuggestSearchArgs{}
+}
+
+func (p *VideoServiceSuggestSearchArgs) InitDefault() {
+}
+
+var VideoServiceSuggestSearchArgs_Req_DEFAULT *SearchSuggestRequest
+
+func (p *VideoServiceSuggestSearchArgs) GetReq() (v *SearchSuggestRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceSuggestSearchArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceSuggestSearchArgs) SetReq(val *SearchSuggestRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceSuggestSearchArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceSuggestSearchArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceSuggestSearchArgs(%+v)", *p)
+}
+
+func (p *VideoServiceSuggestSearchArgs) DeepEqual(ano *VideoServiceSuggestSearchArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceSuggestSearchArgs) Field1DeepEqual(src *SearchSuggestRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceSuggestSearchArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceSuggestSearchResult struct {
+	Success *SearchSuggestResponse `thrift:"success,0,optional" frugal:"0,optional,SearchSuggestResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceSuggestSearchResult() *VideoServiceSuggestSearchResult {
+	return &VideoServiceSuggestSearchResult{}
+}
+
+func (p *VideoServiceSuggestSearchResult) InitDefault() {
+}
+
+var VideoServiceSuggestSearchResult_Success_DEFAULT *SearchSuggestResponse
+
+func (p *VideoServiceSuggestSearchResult) GetSuccess() (v *SearchSuggestResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceSuggestSearchResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceSuggestSearchResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SearchSuggestResponse)
+}
+
+func (p *VideoServiceSuggestSearchResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceSuggestSearchResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceSuggestSearchResult(%+v)", *p)
+}
+
+func (p *VideoServiceSuggestSearchResult) DeepEqual(ano *VideoServiceSuggestSearchResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceSuggestSearchResult) Field0DeepEqual(src *SearchSuggestResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceSuggestSearchResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceTrendingSearchesArgs struct {
+	Req *TrendingSearchesRequest `thrift:"req,1" frugal:"1,default,TrendingSearchesRequest" json:"req"`
+}
+
+func NewVideoServiceTrendingSearchesArgs() *VideoServiceTrendingSearchesArgs {
+	return &VideoServiceTrendingSearchesArgs{}
+}
+
+func (p *VideoServiceTrendingSearchesArgs) InitDefault() {
+}
+
+var VideoServiceTrendingSearchesArgs_Req_DEFAULT *TrendingSearchesRequest
+
+func (p *VideoServiceTrendingSearchesArgs) GetReq() (v *TrendingSearchesRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceTrendingSearchesArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceTrendingSearchesArgs) SetReq(val *TrendingSearchesRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceTrendingSearchesArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceTrendingSearchesArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceTrendingSearchesArgs(%+v)", *p)
+}
+
+func (p *VideoServiceTrendingSearchesArgs) DeepEqual(ano *VideoServiceTrendingSearchesArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceTrendingSearchesArgs) Field1DeepEqual(src *TrendingSearchesRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceTrendingSearchesArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceTrendingSearchesResult struct {
+	Success *TrendingSearchesResponse `thrift:"success,0,optional" frugal:"0,optional,TrendingSearchesResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceTrendingSearchesResult() *VideoServiceTrendingSearchesResult {
+	return &VideoServiceTrendingSearchesResult{}
+}
+
+func (p *VideoServiceTrendingSearchesResult) InitDefault() {
+}
+
+var VideoServiceTrendingSearchesResult_Success_DEFAULT *TrendingSearchesResponse
+
+func (p *VideoServiceTrendingSearchesResult) GetSuccess() (v *TrendingSearchesResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceTrendingSearchesResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceTrendingSearchesResult) SetSuccess(x interface{}) {
+	p.Success = x.(*TrendingSearchesResponse)
+}
+
+func (p *VideoServiceTrendingSearchesResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceTrendingSearchesResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceTrendingSearchesResult(%+v)", *p)
+}
+
+func (p *VideoServiceTrendingSearchesResult) DeepEqual(ano *VideoServiceTrendingSearchesResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceTrendingSearchesResult) Field0DeepEqual(src *TrendingSearchesResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceTrendingSearchesResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceListSearchHistoryArgs struct {
+	Req *SearchHistoryListRequest `thrift:"req,1" frugal:"1,default,SearchHistoryListRequest" json:"req"`
+}
+
+func NewVideoServiceListSearchHistoryArgs() *VideoServiceListSearchHistoryArgs {
+	return &VideoServiceListSearchHistoryArgs{}
+}
+
+func (p *VideoServiceListSearchHistoryArgs) InitDefault() {
+}
+
+var VideoServiceListSearchHistoryArgs_Req_DEFAULT *SearchHistoryListRequest
+
+func (p *VideoServiceListSearchHistoryArgs) GetReq() (v *SearchHistoryListRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceListSearchHistoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceListSearchHistoryArgs) SetReq(val *SearchHistoryListRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceListSearchHistoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceListSearchHistoryArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceListSearchHistoryArgs(%+v)", *p)
+}
+
+func (p *VideoServiceListSearchHistoryArgs) DeepEqual(ano *VideoServiceListSearchHistoryArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceListSearchHistoryArgs) Field1DeepEqual(src *SearchHistoryListRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceListSearchHistoryArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceListSearchHistoryResult struct {
+	Success *SearchHistoryListResponse `thrift:"success,0,optional" frugal:"0,optional,SearchHistoryListResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceListSearchHistoryResult() *VideoServiceListSearchHistoryResult {
+	return &VideoServiceListSearchHistoryResult{}
+}
+
+func (p *VideoServiceListSearchHistoryResult) InitDefault() {
+}
+
+var VideoServiceListSearchHistoryResult_Success_DEFAULT *SearchHistoryListResponse
+
+func (p *VideoServiceListSearchHistoryResult) GetSuccess() (v *SearchHistoryListResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceListSearchHistoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceListSearchHistoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SearchHistoryListResponse)
+}
+
+func (p *VideoServiceListSearchHistoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceListSearchHistoryResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceListSearchHistoryResult(%+v)", *p)
+}
+
+func (p *VideoServiceListSearchHistoryResult) DeepEqual(ano *VideoServiceListSearchHistoryResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceListSearchHistoryResult) Field0DeepEqual(src *SearchHistoryListResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceListSearchHistoryResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceDeleteSearchHistoryItemArgs struct {
+	Req *SearchHistoryDeleteRequest `thrift:"req,1" frugal:"1,default,SearchHistoryDeleteRequest" json:"req"`
+}
+
+func NewVideoServiceDeleteSearchHistoryItemArgs() *VideoServiceDeleteSearchHistoryItemArgs {
+	return &VideoServiceDeleteSearchHistoryItemArgs{}
+}
+
+func (p *VideoServiceDeleteSearchHistoryItemArgs) InitDefault() {
+}
+
+var VideoServiceDeleteSearchHistoryItemArgs_Req_DEFAULT *SearchHistoryDeleteRequest
+
+func (p *VideoServiceDeleteSearchHistoryItemArgs) GetReq() (v *SearchHistoryDeleteRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceDeleteSearchHistoryItemArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceDeleteSearchHistoryItemArgs) SetReq(val *SearchHistoryDeleteRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceDeleteSearchHistoryItemArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceDeleteSearchHistoryItemArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceDeleteSearchHistoryItemArgs(%+v)", *p)
+}
+
+func (p *VideoServiceDeleteSearchHistoryItemArgs) DeepEqual(ano *VideoServiceDeleteSearchHistoryItemArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceDeleteSearchHistoryItemArgs) Field1DeepEqual(src *SearchHistoryDeleteRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceDeleteSearchHistoryItemArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceDeleteSearchHistoryItemResult struct {
+	Success *SearchHistoryDeleteResponse `thrift:"success,0,optional" frugal:"0,optional,SearchHistoryDeleteResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceDeleteSearchHistoryItemResult() *VideoServiceDeleteSearchHistoryItemResult {
+	return &VideoServiceDeleteSearchHistoryItemResult{}
+}
+
+func (p *VideoServiceDeleteSearchHistoryItemResult) InitDefault() {
+}
+
+var VideoServiceDeleteSearchHistoryItemResult_Success_DEFAULT *SearchHistoryDeleteResponse
+
+func (p *VideoServiceDeleteSearchHistoryItemResult) GetSuccess() (v *SearchHistoryDeleteResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceDeleteSearchHistoryItemResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceDeleteSearchHistoryItemResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SearchHistoryDeleteResponse)
+}
+
+func (p *VideoServiceDeleteSearchHistoryItemResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceDeleteSearchHistoryItemResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceDeleteSearchHistoryItemResult(%+v)", *p)
+}
+
+func (p *VideoServiceDeleteSearchHistoryItemResult) DeepEqual(ano *VideoServiceDeleteSearchHistoryItemResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceDeleteSearchHistoryItemResult) Field0DeepEqual(src *SearchHistoryDeleteResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceDeleteSearchHistoryItemResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceClearSearchHistoryArgs struct {
+	Req *SearchHistoryClearRequest `thrift:"req,1" frugal:"1,default,SearchHistoryClearRequest" json:"req"`
+}
+
+func NewVideoServiceClearSearchHistoryArgs() *VideoServiceClearSearchHistoryArgs {
+	return &VideoServiceClearSearchHistoryArgs{}
+}
+
+func (p *VideoServiceClearSearchHistoryArgs) InitDefault() {
+}
+
+var VideoServiceClearSearchHistoryArgs_Req_DEFAULT *SearchHistoryClearRequest
+
+func (p *VideoServiceClearSearchHistoryArgs) GetReq() (v *SearchHistoryClearRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceClearSearchHistoryArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceClearSearchHistoryArgs) SetReq(val *SearchHistoryClearRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceClearSearchHistoryArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceClearSearchHistoryArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceClearSearchHistoryArgs(%+v)", *p)
+}
+
+func (p *VideoServiceClearSearchHistoryArgs) DeepEqual(ano *VideoServiceClearSearchHistoryArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceClearSearchHistoryArgs) Field1DeepEqual(src *SearchHistoryClearRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceClearSearchHistoryArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceClearSearchHistoryResult struct {
+	Success *SearchHistoryClearResponse `thrift:"success,0,optional" frugal:"0,optional,SearchHistoryClearResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceClearSearchHistoryResult() *VideoServiceClearSearchHistoryResult {
+	return &VideoServiceClearSearchHistoryResult{}
+}
+
+func (p *VideoServiceClearSearchHistoryResult) InitDefault() {
+}
+
+var VideoServiceClearSearchHistoryResult_Success_DEFAULT *SearchHistoryClearResponse
+
+func (p *VideoServiceClearSearchHistoryResult) GetSuccess() (v *SearchHistoryClearResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceClearSearchHistoryResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceClearSearchHistoryResult) SetSuccess(x interface{}) {
+	p.Success = x.(*SearchHistoryClearResponse)
+}
+
+func (p *VideoServiceClearSearchHistoryResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceClearSearchHistoryResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceClearSearchHistoryResult(%+v)", *p)
+}
+
+func (p *VideoServiceClearSearchHistoryResult) DeepEqual(ano *VideoServiceClearSearchHistoryResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceClearSearchHistoryResult) Field0DeepEqual(src *SearchHistoryClearResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceClearSearchHistoryResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceGetVideoProcessingStatusArgs struct {
+	Req *VideoProcessingStatusRequest `thrift:"req,1" frugal:"1,default,VideoProcessingStatusRequest" json:"req"`
+}
+
+func NewVideoServiceGetVideoProcessingStatusArgs() *VideoServiceGetVideoProcessingStatusArgs {
+	return &VideoServiceGetVideoProcessingStatusArgs{}
+}
+
+func (p *VideoServiceGetVideoProcessingStatusArgs) InitDefault() {
+}
+
+var VideoServiceGetVideoProcessingStatusArgs_Req_DEFAULT *VideoProcessingStatusRequest
+
+func (p *VideoServiceGetVideoProcessingStatusArgs) GetReq() (v *VideoProcessingStatusRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceGetVideoProcessingStatusArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceGetVideoProcessingStatusArgs) SetReq(val *VideoProcessingStatusRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceGetVideoProcessingStatusArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceGetVideoProcessingStatusArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceGetVideoProcessingStatusArgs(%+v)", *p)
+}
+
+func (p *VideoServiceGetVideoProcessingStatusArgs) DeepEqual(ano *VideoServiceGetVideoProcessingStatusArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceGetVideoProcessingStatusArgs) Field1DeepEqual(src *VideoProcessingStatusRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceGetVideoProcessingStatusArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceGetVideoProcessingStatusResult struct {
+	Success *VideoProcessingStatusResponse `thrift:"success,0,optional" frugal:"0,optional,VideoProcessingStatusResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceGetVideoProcessingStatusResult() *VideoServiceGetVideoProcessingStatusResult {
+	return &VideoServiceGetVideoProcessingStatusResult{}
+}
+
+func (p *VideoServiceGetVideoProcessingStatusResult) InitDefault() {
+}
+
+var VideoServiceGetVideoProcessingStatusResult_Success_DEFAULT *VideoProcessingStatusResponse
+
+func (p *VideoServiceGetVideoProcessingStatusResult) GetSuccess() (v *VideoProcessingStatusResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceGetVideoProcessingStatusResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceGetVideoProcessingStatusResult) SetSuccess(x interface{}) {
+	p.Success = x.(*VideoProcessingStatusResponse)
+}
+
+func (p *VideoServiceGetVideoProcessingStatusResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceGetVideoProcessingStatusResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceGetVideoProcessingStatusResult(%+v)", *p)
+}
+
+func (p *VideoServiceGetVideoProcessingStatusResult) DeepEqual(ano *VideoServiceGetVideoProcessingStatusResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceGetVideoProcessingStatusResult) Field0DeepEqual(src *VideoProcessingStatusResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceGetVideoProcessingStatusResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceInitVideoUploadArgs struct {
+	Req *InitVideoUploadRequest `thrift:"req,1" frugal:"1,default,InitVideoUploadRequest" json:"req"`
+}
+
+func NewVideoServiceInitVideoUploadArgs() *VideoServiceInitVideoUploadArgs {
+	return &VideoServiceInitVideoUploadArgs{}
+}
+
+func (p *VideoServiceInitVideoUploadArgs) InitDefault() {
+}
+
+var VideoServiceInitVideoUploadArgs_Req_DEFAULT *InitVideoUploadRequest
+
+func (p *VideoServiceInitVideoUploadArgs) GetReq() (v *InitVideoUploadRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceInitVideoUploadArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceInitVideoUploadArgs) SetReq(val *InitVideoUploadRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceInitVideoUploadArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceInitVideoUploadArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceInitVideoUploadArgs(%+v)", *p)
+}
+
+func (p *VideoServiceInitVideoUploadArgs) DeepEqual(ano *VideoServiceInitVideoUploadArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceInitVideoUploadArgs) Field1DeepEqual(src *InitVideoUploadRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceInitVideoUploadArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceInitVideoUploadResult struct {
+	Success *InitVideoUploadResponse `thrift:"success,0,optional" frugal:"0,optional,InitVideoUploadResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceInitVideoUploadResult() *VideoServiceInitVideoUploadResult {
+	return &VideoServiceInitVideoUploadResult{}
+}
+
+func (p *VideoServiceInitVideoUploadResult) InitDefault() {
+}
+
+var VideoServiceInitVideoUploadResult_Success_DEFAULT *InitVideoUploadResponse
+
+func (p *VideoServiceInitVideoUploadResult) GetSuccess() (v *InitVideoUploadResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceInitVideoUploadResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceInitVideoUploadResult) SetSuccess(x interface{}) {
+	p.Success = x.(*InitVideoUploadResponse)
+}
+
+func (p *VideoServiceInitVideoUploadResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceInitVideoUploadResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceInitVideoUploadResult(%+v)", *p)
+}
+
+func (p *VideoServiceInitVideoUploadResult) DeepEqual(ano *VideoServiceInitVideoUploadResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceInitVideoUploadResult) Field0DeepEqual(src *InitVideoUploadResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceInitVideoUploadResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceUploadVideoChunkArgs struct {
+	Req *UploadVideoChunkRequest `thrift:"req,1" frugal:"1,default,UploadVideoChunkRequest" json:"req"`
+}
+
+func NewVideoServiceUploadVideoChunkArgs() *VideoServiceUploadVideoChunkArgs {
+	return &VideoServiceUploadVideoChunkArgs{}
+}
+
+func (p *VideoServiceUploadVideoChunkArgs) InitDefault() {
+}
+
+var VideoServiceUploadVideoChunkArgs_Req_DEFAULT *UploadVideoChunkRequest
+
+func (p *VideoServiceUploadVideoChunkArgs) GetReq() (v *UploadVideoChunkRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceUploadVideoChunkArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceUploadVideoChunkArgs) SetReq(val *UploadVideoChunkRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceUploadVideoChunkArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceUploadVideoChunkArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceUploadVideoChunkArgs(%+v)", *p)
+}
+
+func (p *VideoServiceUploadVideoChunkArgs) DeepEqual(ano *VideoServiceUploadVideoChunkArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceUploadVideoChunkArgs) Field1DeepEqual(src *UploadVideoChunkRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceUploadVideoChunkArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceUploadVideoChunkResult struct {
+	Success *UploadVideoChunkResponse `thrift:"success,0,optional" frugal:"0,optional,UploadVideoChunkResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceUploadVideoChunkResult() *VideoServiceUploadVideoChunkResult {
+	return &VideoServiceUploadVideoChunkResult{}
+}
+
+func (p *VideoServiceUploadVideoChunkResult) InitDefault() {
+}
+
+var VideoServiceUploadVideoChunkResult_Success_DEFAULT *UploadVideoChunkResponse
+
+func (p *VideoServiceUploadVideoChunkResult) GetSuccess() (v *UploadVideoChunkResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceUploadVideoChunkResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceUploadVideoChunkResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UploadVideoChunkResponse)
+}
+
+func (p *VideoServiceUploadVideoChunkResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceUploadVideoChunkResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceUploadVideoChunkResult(%+v)", *p)
+}
+
+func (p *VideoServiceUploadVideoChunkResult) DeepEqual(ano *VideoServiceUploadVideoChunkResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceUploadVideoChunkResult) Field0DeepEqual(src *UploadVideoChunkResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceUploadVideoChunkResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceGetVideoUploadArgs struct {
+	Req *GetVideoUploadRequest `thrift:"req,1" frugal:"1,default,GetVideoUploadRequest" json:"req"`
+}
+
+func NewVideoServiceGetVideoUploadArgs() *VideoServiceGetVideoUploadArgs {
+	return &VideoServiceGetVideoUploadArgs{}
+}
+
+func (p *VideoServiceGetVideoUploadArgs) InitDefault() {
+}
+
+var VideoServiceGetVideoUploadArgs_Req_DEFAULT *GetVideoUploadRequest
+
+func (p *VideoServiceGetVideoUploadArgs) GetReq() (v *GetVideoUploadRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceGetVideoUploadArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceGetVideoUploadArgs) SetReq(val *GetVideoUploadRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceGetVideoUploadArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceGetVideoUploadArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceGetVideoUploadArgs(%+v)", *p)
+}
+
+func (p *VideoServiceGetVideoUploadArgs) DeepEqual(ano *VideoServiceGetVideoUploadArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceGetVideoUploadArgs) Field1DeepEqual(src *GetVideoUploadRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceGetVideoUploadArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceGetVideoUploadResult struct {
+	Success *GetVideoUploadResponse `thrift:"success,0,optional" frugal:"0,optional,GetVideoUploadResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceGetVideoUploadResult() *VideoServiceGetVideoUploadResult {
+	return &VideoServiceGetVideoUploadResult{}
+}
+
+func (p *VideoServiceGetVideoUploadResult) InitDefault() {
+}
+
+var VideoServiceGetVideoUploadResult_Success_DEFAULT *GetVideoUploadResponse
+
+func (p *VideoServiceGetVideoUploadResult) GetSuccess() (v *GetVideoUploadResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceGetVideoUploadResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceGetVideoUploadResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetVideoUploadResponse)
+}
+
+func (p *VideoServiceGetVideoUploadResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceGetVideoUploadResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceGetVideoUploadResult(%+v)", *p)
+}
+
+func (p *VideoServiceGetVideoUploadResult) DeepEqual(ano *VideoServiceGetVideoUploadResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceGetVideoUploadResult) Field0DeepEqual(src *GetVideoUploadResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceGetVideoUploadResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceCompleteVideoUploadArgs struct {
+	Req *CompleteVideoUploadRequest `thrift:"req,1" frugal:"1,default,CompleteVideoUploadRequest" json:"req"`
+}
+
+func NewVideoServiceCompleteVideoUploadArgs() *VideoServiceCompleteVideoUploadArgs {
+	return &VideoServiceCompleteVideoUploadArgs{}
+}
+
+func (p *VideoServiceCompleteVideoUploadArgs) InitDefault() {
+}
+
+var VideoServiceCompleteVideoUploadArgs_Req_DEFAULT *CompleteVideoUploadRequest
+
+func (p *VideoServiceCompleteVideoUploadArgs) GetReq() (v *CompleteVideoUploadRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceCompleteVideoUploadArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceCompleteVideoUploadArgs) SetReq(val *CompleteVideoUploadRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceCompleteVideoUploadArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceCompleteVideoUploadArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceCompleteVideoUploadArgs(%+v)", *p)
+}
+
+func (p *VideoServiceCompleteVideoUploadArgs) DeepEqual(ano *VideoServiceCompleteVideoUploadArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceCompleteVideoUploadArgs) Field1DeepEqual(src *CompleteVideoUploadRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceCompleteVideoUploadArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceCompleteVideoUploadResult struct {
+	Success *CompleteVideoUploadResponse `thrift:"success,0,optional" frugal:"0,optional,CompleteVideoUploadResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceCompleteVideoUploadResult() *VideoServiceCompleteVideoUploadResult {
+	return &VideoServiceCompleteVideoUploadResult{}
+}
+
+func (p *VideoServiceCompleteVideoUploadResult) InitDefault() {
+}
+
+var VideoServiceCompleteVideoUploadResult_Success_DEFAULT *CompleteVideoUploadResponse
+
+func (p *VideoServiceCompleteVideoUploadResult) GetSuccess() (v *CompleteVideoUploadResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceCompleteVideoUploadResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceCompleteVideoUploadResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CompleteVideoUploadResponse)
+}
+
+func (p *VideoServiceCompleteVideoUploadResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceCompleteVideoUploadResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceCompleteVideoUploadResult(%+v)", *p)
+}
+
+func (p *VideoServiceCompleteVideoUploadResult) DeepEqual(ano *VideoServiceCompleteVideoUploadResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceCompleteVideoUploadResult) Field0DeepEqual(src *CompleteVideoUploadResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceCompleteVideoUploadResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceAbortVideoUploadArgs struct {
+	Req *AbortVideoUploadRequest `thrift:"req,1" frugal:"1,default,AbortVideoUploadRequest" json:"req"`
+}
+
+func NewVideoServiceAbortVideoUploadArgs() *VideoServiceAbortVideoUploadArgs {
+	return &VideoServiceAbortVideoUploadArgs{}
+}
+
+func (p *VideoServiceAbortVideoUploadArgs) InitDefault() {
+}
+
+var VideoServiceAbortVideoUploadArgs_Req_DEFAULT *AbortVideoUploadRequest
+
+func (p *VideoServiceAbortVideoUploadArgs) GetReq() (v *AbortVideoUploadRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceAbortVideoUploadArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceAbortVideoUploadArgs) SetReq(val *AbortVideoUploadRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceAbortVideoUploadArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceAbortVideoUploadArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceAbortVideoUploadArgs(%+v)", *p)
+}
+
+func (p *VideoServiceAbortVideoUploadArgs) DeepEqual(ano *VideoServiceAbortVideoUploadArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceAbortVideoUploadArgs) Field1DeepEqual(src *AbortVideoUploadRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceAbortVideoUploadArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceAbortVideoUploadResult struct {
+	Success *AbortVideoUploadResponse `thrift:"success,0,optional" frugal:"0,optional,AbortVideoUploadResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceAbortVideoUploadResult() *VideoServiceAbortVideoUploadResult {
+	return &VideoServiceAbortVideoUploadResult{}
+}
+
+func (p *VideoServiceAbortVideoUploadResult) InitDefault() {
+}
+
+var VideoServiceAbortVideoUploadResult_Success_DEFAULT *AbortVideoUploadResponse
+
+func (p *VideoServiceAbortVideoUploadResult) GetSuccess() (v *AbortVideoUploadResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceAbortVideoUploadResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceAbortVideoUploadResult) SetSuccess(x interface{}) {
+	p.Success = x.(*AbortVideoUploadResponse)
+}
+
+func (p *VideoServiceAbortVideoUploadResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceAbortVideoUploadResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceAbortVideoUploadResult(%+v)", *p)
+}
+
+func (p *VideoServiceAbortVideoUploadResult) DeepEqual(ano *VideoServiceAbortVideoUploadResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceAbortVideoUploadResult) Field0DeepEqual(src *AbortVideoUploadResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceAbortVideoUploadResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceInitDirectUploadArgs struct {
+	Req *InitDirectUploadRequest `thrift:"req,1" frugal:"1,default,InitDirectUploadRequest" json:"req"`
+}
+
+func NewVideoServiceInitDirectUploadArgs() *VideoServiceInitDirectUploadArgs {
+	return &VideoServiceInitDirectUploadArgs{}
+}
+
+func (p *VideoServiceInitDirectUploadArgs) InitDefault() {
+}
+
+var VideoServiceInitDirectUploadArgs_Req_DEFAULT *InitDirectUploadRequest
+
+func (p *VideoServiceInitDirectUploadArgs) GetReq() (v *InitDirectUploadRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceInitDirectUploadArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceInitDirectUploadArgs) SetReq(val *InitDirectUploadRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceInitDirectUploadArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceInitDirectUploadArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceInitDirectUploadArgs(%+v)", *p)
+}
+
+func (p *VideoServiceInitDirectUploadArgs) DeepEqual(ano *VideoServiceInitDirectUploadArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceInitDirectUploadArgs) Field1DeepEqual(src *InitDirectUploadRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceInitDirectUploadArgs = map[int16]string{
 	1: "req",
 }
 
-type VideoServiceSubmitVideoResult struct {
-	Success *VideoSubmissionResponse `thrift:"success,0,optional" frugal:"0,optional,VideoSubmissionResponse" json:"success,omitempty"`
+type VideoServiceInitDirectUploadResult struct {
+	Success *InitDirectUploadResponse `thrift:"success,0,optional" frugal:"0,optional,InitDirectUploadResponse" json:"success,omitempty"`
 }
 
-func NewVideoServiceSubmitVideoResult() *VideoServiceSubmitVideoResult {
-	return &VideoServiceSubmitVideoResult{}
+func NewVideoServiceInitDirectUploadResult() *VideoServiceInitDirectUploadResult {
+	return &VideoServiceInitDirectUploadResult{}
 }
 
-func (p *VideoServiceSubmitVideoResult) InitDefault() {
+func (p *VideoServiceInitDirectUploadResult) InitDefault() {
 }
 
-var VideoServiceSubmitVideoResult_Success_DEFAULT *VideoSubmissionResponse
+var VideoServiceInitDirectUploadResult_Success_DEFAULT *InitDirectUploadResponse
 
-func (p *VideoServiceSubmitVideoResult) GetSuccess() (v *VideoSubmissionResponse) {
+func (p *VideoServiceInitDirectUploadResult) GetSuccess() (v *InitDirectUploadResponse) {
 	if !p.IsSetSuccess() {
-		return VideoServiceSubmitVideoResult_Success_DEFAULT
+		return VideoServiceInitDirectUploadResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServiceSubmitVideoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*VideoSubmissionResponse)
+func (p *VideoServiceInitDirectUploadResult) SetSuccess(x interface{}) {
+	p.Success = x.(*InitDirectUploadResponse)
 }
 
-func (p *VideoServiceSubmitVideoResult) IsSetSuccess() bool {
+func (p *VideoServiceInitDirectUploadResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceSubmitVideoResult) String() string {
+func (p *VideoServiceInitDirectUploadResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceSubmitVideoResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceInitDirectUploadResult(%+v)", *p)
 }
 
-func (p *VideoServiceSubmitVideoResult) DeepEqual(ano *VideoServiceSubmitVideoResult) bool {
+func (p *VideoServiceInitDirectUploadResult) DeepEqual(ano *VideoServiceInitDirectUploadResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -978,7 +7804,7 @@ func (p *VideoServiceSubmitVideoResult) DeepEqual(ano *VideoServiceSubmitVideoRe
 	return true
 }
 
-func (p *VideoServiceSubmitVideoResult) Field0DeepEqual(src *VideoSubmissionResponse) bool {
+func (p *VideoServiceInitDirectUploadResult) Field0DeepEqual(src *InitDirectUploadResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -986,45 +7812,45 @@ func (p *VideoServiceSubmitVideoResult) Field0DeepEqual(src *VideoSubmissionResp
 	return true
 }
 
-var fieldIDToName_VideoServiceSubmitVideoResult = map[int16]string{
+var fieldIDToName_VideoServiceInitDirectUploadResult = map[int16]string{
 	0: "success",
 }
 
-type VideoServiceGetVideoArgs struct {
-	Req *VideoDetailRequest `thrift:"req,1" frugal:"1,default,VideoDetailRequest" json:"req"`
+type VideoServiceCompleteDirectUploadArgs struct {
+	Req *CompleteDirectUploadRequest `thrift:"req,1" frugal:"1,default,CompleteDirectUploadRequest" json:"req"`
 }
 
-func NewVideoServiceGetVideoArgs() *VideoServiceGetVideoArgs {
-	return &VideoServiceGetVideoArgs{}
+func NewVideoServiceCompleteDirectUploadArgs() *VideoServiceCompleteDirectUploadArgs {
+	return &VideoServiceCompleteDirectUploadArgs{}
 }
 
-func (p *VideoServiceGetVideoArgs) InitDefault() {
+func (p *VideoServiceCompleteDirectUploadArgs) InitDefault() {
 }
 
-var VideoServiceGetVideoArgs_Req_DEFAULT *VideoDetailRequest
+var VideoServiceCompleteDirectUploadArgs_Req_DEFAULT *CompleteDirectUploadRequest
 
-func (p *VideoServiceGetVideoArgs) GetReq() (v *VideoDetailRequest) {
+func (p *VideoServiceCompleteDirectUploadArgs) GetReq() (v *CompleteDirectUploadRequest) {
 	if !p.IsSetReq() {
-		return VideoServiceGetVideoArgs_Req_DEFAULT
+		return VideoServiceCompleteDirectUploadArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *VideoServiceGetVideoArgs) SetReq(val *VideoDetailRequest) {
+func (p *VideoServiceCompleteDirectUploadArgs) SetReq(val *CompleteDirectUploadRequest) {
 	p.Req = val
 }
 
-func (p *VideoServiceGetVideoArgs) IsSetReq() bool {
+func (p *VideoServiceCompleteDirectUploadArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceGetVideoArgs) String() string {
+func (p *VideoServiceCompleteDirectUploadArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceGetVideoArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceCompleteDirectUploadArgs(%+v)", *p)
 }
 
-func (p *VideoServiceGetVideoArgs) DeepEqual(ano *VideoServiceGetVideoArgs) bool {
+func (p *VideoServiceCompleteDirectUploadArgs) DeepEqual(ano *VideoServiceCompleteDirectUploadArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1036,7 +7862,7 @@ func (p *VideoServiceGetVideoArgs) DeepEqual(ano *VideoServiceGetVideoArgs) bool
 	return true
 }
 
-func (p *VideoServiceGetVideoArgs) Field1DeepEqual(src *VideoDetailRequest) bool {
+func (p *VideoServiceCompleteDirectUploadArgs) Field1DeepEqual(src *CompleteDirectUploadRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -1044,45 +7870,45 @@ func (p *VideoServiceGetVideoArgs) Field1DeepEqual(src *VideoDetailRequest) bool
 	return true
 }
 
-var fieldIDToName_VideoServiceGetVideoArgs = map[int16]string{
+var fieldIDToName_VideoServiceCompleteDirectUploadArgs = map[int16]string{
 	1: "req",
 }
 
-type VideoServiceGetVideoResult struct {
-	Success *VideoDetailResponse `thrift:"success,0,optional" frugal:"0,optional,VideoDetailResponse" json:"success,omitempty"`
+type VideoServiceCompleteDirectUploadResult struct {
+	Success *CompleteDirectUploadResponse `thrift:"success,0,optional" frugal:"0,optional,CompleteDirectUploadResponse" json:"success,omitempty"`
 }
 
-func NewVideoServiceGetVideoResult() *VideoServiceGetVideoResult {
-	return &VideoServiceGetVideoResult{}
+func NewVideoServiceCompleteDirectUploadResult() *VideoServiceCompleteDirectUploadResult {
+	return &VideoServiceCompleteDirectUploadResult{}
 }
 
-func (p *VideoServiceGetVideoResult) InitDefault() {
+func (p *VideoServiceCompleteDirectUploadResult) InitDefault() {
 }
 
-var VideoServiceGetVideoResult_Success_DEFAULT *VideoDetailResponse
+var VideoServiceCompleteDirectUploadResult_Success_DEFAULT *CompleteDirectUploadResponse
 
-func (p *VideoServiceGetVideoResult) GetSuccess() (v *VideoDetailResponse) {
+func (p *VideoServiceCompleteDirectUploadResult) GetSuccess() (v *CompleteDirectUploadResponse) {
 	if !p.IsSetSuccess() {
-		return VideoServiceGetVideoResult_Success_DEFAULT
+		return VideoServiceCompleteDirectUploadResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServiceGetVideoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*VideoDetailResponse)
+func (p *VideoServiceCompleteDirectUploadResult) SetSuccess(x interface{}) {
+	p.Success = x.(*CompleteDirectUploadResponse)
 }
 
-func (p *VideoServiceGetVideoResult) IsSetSuccess() bool {
+func (p *VideoServiceCompleteDirectUploadResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceGetVideoResult) String() string {
+func (p *VideoServiceCompleteDirectUploadResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceGetVideoResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceCompleteDirectUploadResult(%+v)", *p)
 }
 
-func (p *VideoServiceGetVideoResult) DeepEqual(ano *VideoServiceGetVideoResult) bool {
+func (p *VideoServiceCompleteDirectUploadResult) DeepEqual(ano *VideoServiceCompleteDirectUploadResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1094,7 +7920,7 @@ func (p *VideoServiceGetVideoResult) DeepEqual(ano *VideoServiceGetVideoResult)
 	return true
 }
 
-func (p *VideoServiceGetVideoResult) Field0DeepEqual(src *VideoDetailResponse) bool {
+func (p *VideoServiceCompleteDirectUploadResult) Field0DeepEqual(src *CompleteDirectUploadResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -1102,45 +7928,45 @@ func (p *VideoServiceGetVideoResult) Field0DeepEqual(src *VideoDetailResponse) b
 	return true
 }
 
-var fieldIDToName_VideoServiceGetVideoResult = map[int16]string{
+var fieldIDToName_VideoServiceCompleteDirectUploadResult = map[int16]string{
 	0: "success",
 }
 
-type VideoServiceSearchVideoArgs struct {
-	Req *VideoSearchRequest `thrift:"req,1" frugal:"1,default,VideoSearchRequest" json:"req"`
+type VideoServiceGetVideoObjectArgs struct {
+	Req *GetVideoObjectRequest `thrift:"req,1" frugal:"1,default,GetVideoObjectRequest" json:"req"`
 }
 
-func NewVideoServiceSearchVideoArgs() *VideoServiceSearchVideoArgs {
-	return &VideoServiceSearchVideoArgs{}
+func NewVideoServiceGetVideoObjectArgs() *VideoServiceGetVideoObjectArgs {
+	return &VideoServiceGetVideoObjectArgs{}
 }
 
-func (p *VideoServiceSearchVideoArgs) InitDefault() {
+func (p *VideoServiceGetVideoObjectArgs) InitDefault() {
 }
 
-var VideoServiceSearchVideoArgs_Req_DEFAULT *VideoSearchRequest
+var VideoServiceGetVideoObjectArgs_Req_DEFAULT *GetVideoObjectRequest
 
-func (p *VideoServiceSearchVideoArgs) GetReq() (v *VideoSearchRequest) {
+func (p *VideoServiceGetVideoObjectArgs) GetReq() (v *GetVideoObjectRequest) {
 	if !p.IsSetReq() {
-		return VideoServiceSearchVideoArgs_Req_DEFAULT
+		return VideoServiceGetVideoObjectArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *VideoServiceSearchVideoArgs) SetReq(val *VideoSearchRequest) {
+func (p *VideoServiceGetVideoObjectArgs) SetReq(val *GetVideoObjectRequest) {
 	p.Req = val
 }
 
-func (p *VideoServiceSearchVideoArgs) IsSetReq() bool {
+func (p *VideoServiceGetVideoObjectArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceSearchVideoArgs) String() string {
+func (p *VideoServiceGetVideoObjectArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceSearchVideoArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetVideoObjectArgs(%+v)", *p)
 }
 
-func (p *VideoServiceSearchVideoArgs) DeepEqual(ano *VideoServiceSearchVideoArgs) bool {
+func (p *VideoServiceGetVideoObjectArgs) DeepEqual(ano *VideoServiceGetVideoObjectArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1152,7 +7978,7 @@ func (p *VideoServiceSearchVideoArgs) DeepEqual(ano *VideoServiceSearchVideoArgs
 	return true
 }
 
-func (p *VideoServiceSearchVideoArgs) Field1DeepEqual(src *VideoSearchRequest) bool {
+func (p *VideoServiceGetVideoObjectArgs) Field1DeepEqual(src *GetVideoObjectRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -1160,45 +7986,45 @@ func (p *VideoServiceSearchVideoArgs) Field1DeepEqual(src *VideoSearchRequest) b
 	return true
 }
 
-var fieldIDToName_VideoServiceSearchVideoArgs = map[int16]string{
+var fieldIDToName_VideoServiceGetVideoObjectArgs = map[int16]string{
 	1: "req",
 }
 
-type VideoServiceSearchVideoResult struct {
-	Success *VideoSearchResponse `thrift:"success,0,optional" frugal:"0,optional,VideoSearchResponse" json:"success,omitempty"`
+type VideoServiceGetVideoObjectResult struct {
+	Success *GetVideoObjectResponse `thrift:"success,0,optional" frugal:"0,optional,GetVideoObjectResponse" json:"success,omitempty"`
 }
 
-func NewVideoServiceSearchVideoResult() *VideoServiceSearchVideoResult {
-	return &VideoServiceSearchVideoResult{}
+func NewVideoServiceGetVideoObjectResult() *VideoServiceGetVideoObjectResult {
+	return &VideoServiceGetVideoObjectResult{}
 }
 
-func (p *VideoServiceSearchVideoResult) InitDefault() {
+func (p *VideoServiceGetVideoObjectResult) InitDefault() {
 }
 
-var VideoServiceSearchVideoResult_Success_DEFAULT *VideoSearchResponse
+var VideoServiceGetVideoObjectResult_Success_DEFAULT *GetVideoObjectResponse
 
-func (p *VideoServiceSearchVideoResult) GetSuccess() (v *VideoSearchResponse) {
+func (p *VideoServiceGetVideoObjectResult) GetSuccess() (v *GetVideoObjectResponse) {
 	if !p.IsSetSuccess() {
-		return VideoServiceSearchVideoResult_Success_DEFAULT
+		return VideoServiceGetVideoObjectResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServiceSearchVideoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*VideoSearchResponse)
+func (p *VideoServiceGetVideoObjectResult) SetSuccess(x interface{}) {
+	p.Success = x.(*GetVideoObjectResponse)
 }
 
-func (p *VideoServiceSearchVideoResult) IsSetSuccess() bool {
+func (p *VideoServiceGetVideoObjectResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceSearchVideoResult) String() string {
+func (p *VideoServiceGetVideoObjectResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceSearchVideoResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetVideoObjectResult(%+v)", *p)
 }
 
-func (p *VideoServiceSearchVideoResult) DeepEqual(ano *VideoServiceSearchVideoResult) bool {
+func (p *VideoServiceGetVideoObjectResult) DeepEqual(ano *VideoServiceGetVideoObjectResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1210,7 +8036,7 @@ func (p *VideoServiceSearchVideoResult) DeepEqual(ano *VideoServiceSearchVideoRe
 	return true
 }
 
-func (p *VideoServiceSearchVideoResult) Field0DeepEqual(src *VideoSearchResponse) bool {
+func (p *VideoServiceGetVideoObjectResult) Field0DeepEqual(src *GetVideoObjectResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -1218,45 +8044,45 @@ func (p *VideoServiceSearchVideoResult) Field0DeepEqual(src *VideoSearchResponse
 	return true
 }
 
-var fieldIDToName_VideoServiceSearchVideoResult = map[int16]string{
+var fieldIDToName_VideoServiceGetVideoObjectResult = map[int16]string{
 	0: "success",
 }
 
-type VideoServiceTrendVideoArgs struct {
-	Req *VideoTrendingRequest `thrift:"req,1" frugal:"1,default,VideoTrendingRequest" json:"req"`
+type VideoServiceListUserVideosArgs struct {
+	Req *UserVideoListRequest `thrift:"req,1" frugal:"1,default,UserVideoListRequest" json:"req"`
 }
 
-func NewVideoServiceTrendVideoArgs() *VideoServiceTrendVideoArgs {
-	return &VideoServiceTrendVideoArgs{}
+func NewVideoServiceListUserVideosArgs() *VideoServiceListUserVideosArgs {
+	return &VideoServiceListUserVideosArgs{}
 }
 
-func (p *VideoServiceTrendVideoArgs) InitDefault() {
+func (p *VideoServiceListUserVideosArgs) InitDefault() {
 }
 
-var VideoServiceTrendVideoArgs_Req_DEFAULT *VideoTrendingRequest
+var VideoServiceListUserVideosArgs_Req_DEFAULT *UserVideoListRequest
 
-func (p *VideoServiceTrendVideoArgs) GetReq() (v *VideoTrendingRequest) {
+func (p *VideoServiceListUserVideosArgs) GetReq() (v *UserVideoListRequest) {
 	if !p.IsSetReq() {
-		return VideoServiceTrendVideoArgs_Req_DEFAULT
+		return VideoServiceListUserVideosArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *VideoServiceTrendVideoArgs) SetReq(val *VideoTrendingRequest) {
+func (p *VideoServiceListUserVideosArgs) SetReq(val *UserVideoListRequest) {
 	p.Req = val
 }
 
-func (p *VideoServiceTrendVideoArgs) IsSetReq() bool {
+func (p *VideoServiceListUserVideosArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceTrendVideoArgs) String() string {
+func (p *VideoServiceListUserVideosArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceTrendVideoArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceListUserVideosArgs(%+v)", *p)
 }
 
-func (p *VideoServiceTrendVideoArgs) DeepEqual(ano *VideoServiceTrendVideoArgs) bool {
+func (p *VideoServiceListUserVideosArgs) DeepEqual(ano *VideoServiceListUserVideosArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1268,7 +8094,7 @@ func (p *VideoServiceTrendVideoArgs) DeepEqual(ano *VideoServiceTrendVideoArgs)
 	return true
 }
 
-func (p *VideoServiceTrendVideoArgs) Field1DeepEqual(src *VideoTrendingRequest) bool {
+func (p *VideoServiceListUserVideosArgs) Field1DeepEqual(src *UserVideoListRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -1276,45 +8102,45 @@ func (p *VideoServiceTrendVideoArgs) Field1DeepEqual(src *VideoTrendingRequest)
 	return true
 }
 
-var fieldIDToName_VideoServiceTrendVideoArgs = map[int16]string{
+var fieldIDToName_VideoServiceListUserVideosArgs = map[int16]string{
 	1: "req",
 }
 
-type VideoServiceTrendVideoResult struct {
-	Success *VideoTrendingResponse `thrift:"success,0,optional" frugal:"0,optional,VideoTrendingResponse" json:"success,omitempty"`
+type VideoServiceListUserVideosResult struct {
+	Success *UserVideoListResponse `thrift:"success,0,optional" frugal:"0,optional,UserVideoListResponse" json:"success,omitempty"`
 }
 
-func NewVideoServiceTrendVideoResult() *VideoServiceTrendVideoResult {
-	return &VideoServiceTrendVideoResult{}
+func NewVideoServiceListUserVideosResult() *VideoServiceListUserVideosResult {
+	return &VideoServiceListUserVideosResult{}
 }
 
-func (p *VideoServiceTrendVideoResult) InitDefault() {
+func (p *VideoServiceListUserVideosResult) InitDefault() {
 }
 
-var VideoServiceTrendVideoResult_Success_DEFAULT *VideoTrendingResponse
+var VideoServiceListUserVideosResult_Success_DEFAULT *UserVideoListResponse
 
-func (p *VideoServiceTrendVideoResult) GetSuccess() (v *VideoTrendingResponse) {
+func (p *VideoServiceListUserVideosResult) GetSuccess() (v *UserVideoListResponse) {
 	if !p.IsSetSuccess() {
-		return VideoServiceTrendVideoResult_Success_DEFAULT
+		return VideoServiceListUserVideosResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServiceTrendVideoResult) SetSuccess(x interface{}) {
-	p.Success = x.(*VideoTrendingResponse)
+func (p *VideoServiceListUserVideosResult) SetSuccess(x interface{}) {
+	p.Success = x.(*UserVideoListResponse)
 }
 
-func (p *VideoServiceTrendVideoResult) IsSetSuccess() bool {
+func (p *VideoServiceListUserVideosResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceTrendVideoResult) String() string {
+func (p *VideoServiceListUserVideosResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceTrendVideoResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceListUserVideosResult(%+v)", *p)
 }
 
-func (p *VideoServiceTrendVideoResult) DeepEqual(ano *VideoServiceTrendVideoResult) bool {
+func (p *VideoServiceListUserVideosResult) DeepEqual(ano *VideoServiceListUserVideosResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1326,7 +8152,7 @@ func (p *VideoServiceTrendVideoResult) DeepEqual(ano *VideoServiceTrendVideoResu
 	return true
 }
 
-func (p *VideoServiceTrendVideoResult) Field0DeepEqual(src *VideoTrendingResponse) bool {
+func (p *VideoServiceListUserVideosResult) Field0DeepEqual(src *UserVideoListResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -1334,45 +8160,45 @@ func (p *VideoServiceTrendVideoResult) Field0DeepEqual(src *VideoTrendingRespons
 	return true
 }
 
-var fieldIDToName_VideoServiceTrendVideoResult = map[int16]string{
+var fieldIDToName_VideoServiceListUserVideosResult = map[int16]string{
 	0: "success",
 }
 
-type VideoServiceUpdateVideoHotArgs struct {
-	Req *VideoHotUpdateRequest `thrift:"req,1" frugal:"1,default,VideoHotUpdateRequest" json:"req"`
+type VideoServiceGetFeedArgs struct {
+	Req *FeedRequest `thrift:"req,1" frugal:"1,default,FeedRequest" json:"req"`
 }
 
-func NewVideoServiceUpdateVideoHotArgs() *VideoServiceUpdateVideoHotArgs {
-	return &VideoServiceUpdateVideoHotArgs{}
+func NewVideoServiceGetFeedArgs() *VideoServiceGetFeedArgs {
+	return &VideoServiceGetFeedArgs{}
 }
 
-func (p *VideoServiceUpdateVideoHotArgs) InitDefault() {
+func (p *VideoServiceGetFeedArgs) InitDefault() {
 }
 
-var VideoServiceUpdateVideoHotArgs_Req_DEFAULT *VideoHotUpdateRequest
+var VideoServiceGetFeedArgs_Req_DEFAULT *FeedRequest
 
-func (p *VideoServiceUpdateVideoHotArgs) GetReq() (v *VideoHotUpdateRequest) {
+func (p *VideoServiceGetFeedArgs) GetReq() (v *FeedRequest) {
 	if !p.IsSetReq() {
-		return VideoServiceUpdateVideoHotArgs_Req_DEFAULT
+		return VideoServiceGetFeedArgs_Req_DEFAULT
 	}
 	return p.Req
 }
-func (p *VideoServiceUpdateVideoHotArgs) SetReq(val *VideoHotUpdateRequest) {
+func (p *VideoServiceGetFeedArgs) SetReq(val *FeedRequest) {
 	p.Req = val
 }
 
-func (p *VideoServiceUpdateVideoHotArgs) IsSetReq() bool {
+func (p *VideoServiceGetFeedArgs) IsSetReq() bool {
 	return p.Req != nil
 }
 
-func (p *VideoServiceUpdateVideoHotArgs) String() string {
+func (p *VideoServiceGetFeedArgs) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceUpdateVideoHotArgs(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetFeedArgs(%+v)", *p)
 }
 
-func (p *VideoServiceUpdateVideoHotArgs) DeepEqual(ano *VideoServiceUpdateVideoHotArgs) bool {
+func (p *VideoServiceGetFeedArgs) DeepEqual(ano *VideoServiceGetFeedArgs) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1384,7 +8210,7 @@ func (p *VideoServiceUpdateVideoHotArgs) DeepEqual(ano *VideoServiceUpdateVideoH
 	return true
 }
 
-func (p *VideoServiceUpdateVideoHotArgs) Field1DeepEqual(src *VideoHotUpdateRequest) bool {
+func (p *VideoServiceGetFeedArgs) Field1DeepEqual(src *FeedRequest) bool {
 
 	if !p.Req.DeepEqual(src) {
 		return false
@@ -1392,45 +8218,45 @@ func (p *VideoServiceUpdateVideoHotArgs) Field1DeepEqual(src *VideoHotUpdateRequ
 	return true
 }
 
-var fieldIDToName_VideoServiceUpdateVideoHotArgs = map[int16]string{
+var fieldIDToName_VideoServiceGetFeedArgs = map[int16]string{
 	1: "req",
 }
 
-type VideoServiceUpdateVideoHotResult struct {
-	Success *VideoHotUpdateResponse `thrift:"success,0,optional" frugal:"0,optional,VideoHotUpdateResponse" json:"success,omitempty"`
+type VideoServiceGetFeedResult struct {
+	Success *FeedResponse `thrift:"success,0,optional" frugal:"0,optional,FeedResponse" json:"success,omitempty"`
 }
 
-func NewVideoServiceUpdateVideoHotResult() *VideoServiceUpdateVideoHotResult {
-	return &VideoServiceUpdateVideoHotResult{}
+func NewVideoServiceGetFeedResult() *VideoServiceGetFeedResult {
+	return &VideoServiceGetFeedResult{}
 }
 
-func (p *VideoServiceUpdateVideoHotResult) InitDefault() {
+func (p *VideoServiceGetFeedResult) InitDefault() {
 }
 
-var VideoServiceUpdateVideoHotResult_Success_DEFAULT *VideoHotUpdateResponse
+var VideoServiceGetFeedResult_Success_DEFAULT *FeedResponse
 
-func (p *VideoServiceUpdateVideoHotResult) GetSuccess() (v *VideoHotUpdateResponse) {
+func (p *VideoServiceGetFeedResult) GetSuccess() (v *FeedResponse) {
 	if !p.IsSetSuccess() {
-		return VideoServiceUpdateVideoHotResult_Success_DEFAULT
+		return VideoServiceGetFeedResult_Success_DEFAULT
 	}
 	return p.Success
 }
-func (p *VideoServiceUpdateVideoHotResult) SetSuccess(x interface{}) {
-	p.Success = x.(*VideoHotUpdateResponse)
+func (p *VideoServiceGetFeedResult) SetSuccess(x interface{}) {
+	p.Success = x.(*FeedResponse)
 }
 
-func (p *VideoServiceUpdateVideoHotResult) IsSetSuccess() bool {
+func (p *VideoServiceGetFeedResult) IsSetSuccess() bool {
 	return p.Success != nil
 }
 
-func (p *VideoServiceUpdateVideoHotResult) String() string {
+func (p *VideoServiceGetFeedResult) String() string {
 	if p == nil {
 		return "<nil>"
 	}
-	return fmt.Sprintf("VideoServiceUpdateVideoHotResult(%+v)", *p)
+	return fmt.Sprintf("VideoServiceGetFeedResult(%+v)", *p)
 }
 
-func (p *VideoServiceUpdateVideoHotResult) DeepEqual(ano *VideoServiceUpdateVideoHotResult) bool {
+func (p *VideoServiceGetFeedResult) DeepEqual(ano *VideoServiceGetFeedResult) bool {
 	if p == ano {
 		return true
 	} else if p == nil || ano == nil {
@@ -1442,7 +8268,7 @@ func (p *VideoServiceUpdateVideoHotResult) DeepEqual(ano *VideoServiceUpdateVide
 	return true
 }
 
-func (p *VideoServiceUpdateVideoHotResult) Field0DeepEqual(src *VideoHotUpdateResponse) bool {
+func (p *VideoServiceGetFeedResult) Field0DeepEqual(src *FeedResponse) bool {
 
 	if !p.Success.DeepEqual(src) {
 		return false
@@ -1450,6 +8276,122 @@ func (p *VideoServiceUpdateVideoHotResult) Field0DeepEqual(src *VideoHotUpdateRe
 	return true
 }
 
-var fieldIDToName_VideoServiceUpdateVideoHotResult = map[int16]string{
+var fieldIDToName_VideoServiceGetFeedResult = map[int16]string{
+	0: "success",
+}
+
+type VideoServiceRelatedVideosArgs struct {
+	Req *RelatedVideosRequest `thrift:"req,1" frugal:"1,default,RelatedVideosRequest" json:"req"`
+}
+
+func NewVideoServiceRelatedVideosArgs() *VideoServiceRelatedVideosArgs {
+	return &VideoServiceRelatedVideosArgs{}
+}
+
+func (p *VideoServiceRelatedVideosArgs) InitDefault() {
+}
+
+var VideoServiceRelatedVideosArgs_Req_DEFAULT *RelatedVideosRequest
+
+func (p *VideoServiceRelatedVideosArgs) GetReq() (v *RelatedVideosRequest) {
+	if !p.IsSetReq() {
+		return VideoServiceRelatedVideosArgs_Req_DEFAULT
+	}
+	return p.Req
+}
+func (p *VideoServiceRelatedVideosArgs) SetReq(val *RelatedVideosRequest) {
+	p.Req = val
+}
+
+func (p *VideoServiceRelatedVideosArgs) IsSetReq() bool {
+	return p.Req != nil
+}
+
+func (p *VideoServiceRelatedVideosArgs) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceRelatedVideosArgs(%+v)", *p)
+}
+
+func (p *VideoServiceRelatedVideosArgs) DeepEqual(ano *VideoServiceRelatedVideosArgs) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field1DeepEqual(ano.Req) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceRelatedVideosArgs) Field1DeepEqual(src *RelatedVideosRequest) bool {
+
+	if !p.Req.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceRelatedVideosArgs = map[int16]string{
+	1: "req",
+}
+
+type VideoServiceRelatedVideosResult struct {
+	Success *RelatedVideosResponse `thrift:"success,0,optional" frugal:"0,optional,RelatedVideosResponse" json:"success,omitempty"`
+}
+
+func NewVideoServiceRelatedVideosResult() *VideoServiceRelatedVideosResult {
+	return &VideoServiceRelatedVideosResult{}
+}
+
+func (p *VideoServiceRelatedVideosResult) InitDefault() {
+}
+
+var VideoServiceRelatedVideosResult_Success_DEFAULT *RelatedVideosResponse
+
+func (p *VideoServiceRelatedVideosResult) GetSuccess() (v *RelatedVideosResponse) {
+	if !p.IsSetSuccess() {
+		return VideoServiceRelatedVideosResult_Success_DEFAULT
+	}
+	return p.Success
+}
+func (p *VideoServiceRelatedVideosResult) SetSuccess(x interface{}) {
+	p.Success = x.(*RelatedVideosResponse)
+}
+
+func (p *VideoServiceRelatedVideosResult) IsSetSuccess() bool {
+	return p.Success != nil
+}
+
+func (p *VideoServiceRelatedVideosResult) String() string {
+	if p == nil {
+		return "<nil>"
+	}
+	return fmt.Sprintf("VideoServiceRelatedVideosResult(%+v)", *p)
+}
+
+func (p *VideoServiceRelatedVideosResult) DeepEqual(ano *VideoServiceRelatedVideosResult) bool {
+	if p == ano {
+		return true
+	} else if p == nil || ano == nil {
+		return false
+	}
+	if !p.Field0DeepEqual(ano.Success) {
+		return false
+	}
+	return true
+}
+
+func (p *VideoServiceRelatedVideosResult) Field0DeepEqual(src *RelatedVideosResponse) bool {
+
+	if !p.Success.DeepEqual(src) {
+		return false
+	}
+	return true
+}
+
+var fieldIDToName_VideoServiceRelatedVideosResult = map[int16]string{
 	0: "success",
 }
@@ -18,6 +18,29 @@ type Client interface {
 	SearchVideo(ctx context.Context, req *video.VideoSearchRequest, callOptions ...callopt.Option) (r *video.VideoSearchResponse, err error)
 	TrendVideo(ctx context.Context, req *video.VideoTrendingRequest, callOptions ...callopt.Option) (r *video.VideoTrendingResponse, err error)
 	UpdateVideoHot(ctx context.Context, req *video.VideoHotUpdateRequest, callOptions ...callopt.Option) (r *video.VideoHotUpdateResponse, err error)
+	UpdateVideo(ctx context.Context, req *video.VideoUpdateRequest, callOptions ...callopt.Option) (r *video.VideoUpdateResponse, err error)
+	DeleteVideo(ctx context.Context, req *video.VideoDeleteRequest, callOptions ...callopt.Option) (r *video.VideoDeleteResponse, err error)
+	ListDrafts(ctx context.Context, req *video.VideoDraftListRequest, callOptions ...callopt.Option) (r *video.VideoDraftListResponse, err error)
+	ScheduleVideo(ctx context.Context, req *video.VideoScheduleRequest, callOptions ...callopt.Option) (r *video.VideoScheduleResponse, err error)
+	ListVideosByTag(ctx context.Context, req *video.VideoTagListRequest, callOptions ...callopt.Option) (r *video.VideoTagListResponse, err error)
+	PopularTags(ctx context.Context, req *video.PopularTagsRequest, callOptions ...callopt.Option) (r *video.PopularTagsResponse, err error)
+	SuggestSearch(ctx context.Context, req *video.SearchSuggestRequest, callOptions ...callopt.Option) (r *video.SearchSuggestResponse, err error)
+	TrendingSearches(ctx context.Context, req *video.TrendingSearchesRequest, callOptions ...callopt.Option) (r *video.TrendingSearchesResponse, err error)
+	ListSearchHistory(ctx context.Context, req *video.SearchHistoryListRequest, callOptions ...callopt.Option) (r *video.SearchHistoryListResponse, err error)
+	DeleteSearchHistoryItem(ctx context.Context, req *video.SearchHistoryDeleteRequest, callOptions ...callopt.Option) (r *video.SearchHistoryDeleteResponse, err error)
+	ClearSearchHistory(ctx context.Context, req *video.SearchHistoryClearRequest, callOptions ...callopt.Option) (r *video.SearchHistoryClearResponse, err error)
+	GetVideoProcessingStatus(ctx context.Context, req *video.VideoProcessingStatusRequest, callOptions ...callopt.Option) (r *video.VideoProcessingStatusResponse, err error)
+	InitVideoUpload(ctx context.Context, req *video.InitVideoUploadRequest, callOptions ...callopt.Option) (r *video.InitVideoUploadResponse, err error)
+	UploadVideoChunk(ctx context.Context, req *video.UploadVideoChunkRequest, callOptions ...callopt.Option) (r *video.UploadVideoChunkResponse, err error)
+	GetVideoUpload(ctx context.Context, req *video.GetVideoUploadRequest, callOptions ...callopt.Option) (r *video.GetVideoUploadResponse, err error)
+	CompleteVideoUpload(ctx context.Context, req *video.CompleteVideoUploadRequest, callOptions ...callopt.Option) (r *video.CompleteVideoUploadResponse, err error)
+	AbortVideoUpload(ctx context.Context, req *video.AbortVideoUploadRequest, callOptions ...callopt.Option) (r *video.AbortVideoUploadResponse, err error)
+	InitDirectUpload(ctx context.Context, req *video.InitDirectUploadRequest, callOptions ...callopt.Option) (r *video.InitDirectUploadResponse, err error)
+	CompleteDirectUpload(ctx context.Context, req *video.CompleteDirectUploadRequest, callOptions ...callopt.Option) (r *video.CompleteDirectUploadResponse, err error)
+	GetVideoObject(ctx context.Context, req *video.GetVideoObjectRequest, callOptions ...callopt.Option) (r *video.GetVideoObjectResponse, err error)
+	ListUserVideos(ctx context.Context, req *video.UserVideoListRequest, callOptions ...callopt.Option) (r *video.UserVideoListResponse, err error)
+	GetFeed(ctx context.Context, req *video.FeedRequest, callOptions ...callopt.Option) (r *video.FeedResponse, err error)
+	RelatedVideos(ctx context.Context, req *video.RelatedVideosRequest, callOptions ...callopt.Option) (r *video.RelatedVideosResponse, err error)
 }
 
 // NewClient creates a client for the service defined in IDL.
@@ -73,3 +96,118 @@ func (p *kVideoServiceClient) UpdateVideoHot(ctx context.Context, req *video.Vid
 	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
 	return p.kClient.UpdateVideoHot(ctx, req)
 }
+
+func (p *kVideoServiceClient) UpdateVideo(ctx context.Context, req *video.VideoUpdateRequest, callOptions ...callopt.Option) (r *video.VideoUpdateResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UpdateVideo(ctx, req)
+}
+
+func (p *kVideoServiceClient) DeleteVideo(ctx context.Context, req *video.VideoDeleteRequest, callOptions ...callopt.Option) (r *video.VideoDeleteResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteVideo(ctx, req)
+}
+
+func (p *kVideoServiceClient) ListDrafts(ctx context.Context, req *video.VideoDraftListRequest, callOptions ...callopt.Option) (r *video.VideoDraftListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListDrafts(ctx, req)
+}
+
+func (p *kVideoServiceClient) ScheduleVideo(ctx context.Context, req *video.VideoScheduleRequest, callOptions ...callopt.Option) (r *video.VideoScheduleResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ScheduleVideo(ctx, req)
+}
+
+func (p *kVideoServiceClient) ListVideosByTag(ctx context.Context, req *video.VideoTagListRequest, callOptions ...callopt.Option) (r *video.VideoTagListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListVideosByTag(ctx, req)
+}
+
+func (p *kVideoServiceClient) PopularTags(ctx context.Context, req *video.PopularTagsRequest, callOptions ...callopt.Option) (r *video.PopularTagsResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.PopularTags(ctx, req)
+}
+
+func (p *kVideoServiceClient) SuggestSearch(ctx context.Context, req *video.SearchSuggestRequest, callOptions ...callopt.Option) (r *video.SearchSuggestResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.SuggestSearch(ctx, req)
+}
+
+func (p *kVideoServiceClient) TrendingSearches(ctx context.Context, req *video.TrendingSearchesRequest, callOptions ...callopt.Option) (r *video.TrendingSearchesResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.TrendingSearches(ctx, req)
+}
+
+func (p *kVideoServiceClient) ListSearchHistory(ctx context.Context, req *video.SearchHistoryListRequest, callOptions ...callopt.Option) (r *video.SearchHistoryListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListSearchHistory(ctx, req)
+}
+
+func (p *kVideoServiceClient) DeleteSearchHistoryItem(ctx context.Context, req *video.SearchHistoryDeleteRequest, callOptions ...callopt.Option) (r *video.SearchHistoryDeleteResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.DeleteSearchHistoryItem(ctx, req)
+}
+
+func (p *kVideoServiceClient) ClearSearchHistory(ctx context.Context, req *video.SearchHistoryClearRequest, callOptions ...callopt.Option) (r *video.SearchHistoryClearResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ClearSearchHistory(ctx, req)
+}
+
+func (p *kVideoServiceClient) GetVideoProcessingStatus(ctx context.Context, req *video.VideoProcessingStatusRequest, callOptions ...callopt.Option) (r *video.VideoProcessingStatusResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetVideoProcessingStatus(ctx, req)
+}
+
+func (p *kVideoServiceClient) InitVideoUpload(ctx context.Context, req *video.InitVideoUploadRequest, callOptions ...callopt.Option) (r *video.InitVideoUploadResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.InitVideoUpload(ctx, req)
+}
+
+func (p *kVideoServiceClient) UploadVideoChunk(ctx context.Context, req *video.UploadVideoChunkRequest, callOptions ...callopt.Option) (r *video.UploadVideoChunkResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.UploadVideoChunk(ctx, req)
+}
+
+func (p *kVideoServiceClient) GetVideoUpload(ctx context.Context, req *video.GetVideoUploadRequest, callOptions ...callopt.Option) (r *video.GetVideoUploadResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetVideoUpload(ctx, req)
+}
+
+func (p *kVideoServiceClient) CompleteVideoUpload(ctx context.Context, req *video.CompleteVideoUploadRequest, callOptions ...callopt.Option) (r *video.CompleteVideoUploadResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CompleteVideoUpload(ctx, req)
+}
+
+func (p *kVideoServiceClient) AbortVideoUpload(ctx context.Context, req *video.AbortVideoUploadRequest, callOptions ...callopt.Option) (r *video.AbortVideoUploadResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.AbortVideoUpload(ctx, req)
+}
+
+func (p *kVideoServiceClient) InitDirectUpload(ctx context.Context, req *video.InitDirectUploadRequest, callOptions ...callopt.Option) (r *video.InitDirectUploadResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.InitDirectUpload(ctx, req)
+}
+
+func (p *kVideoServiceClient) CompleteDirectUpload(ctx context.Context, req *video.CompleteDirectUploadRequest, callOptions ...callopt.Option) (r *video.CompleteDirectUploadResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.CompleteDirectUpload(ctx, req)
+}
+
+func (p *kVideoServiceClient) GetVideoObject(ctx context.Context, req *video.GetVideoObjectRequest, callOptions ...callopt.Option) (r *video.GetVideoObjectResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetVideoObject(ctx, req)
+}
+
+func (p *kVideoServiceClient) ListUserVideos(ctx context.Context, req *video.UserVideoListRequest, callOptions ...callopt.Option) (r *video.UserVideoListResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.ListUserVideos(ctx, req)
+}
+
+func (p *kVideoServiceClient) GetFeed(ctx context.Context, req *video.FeedRequest, callOptions ...callopt.Option) (r *video.FeedResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.GetFeed(ctx, req)
+}
+
+func (p *kVideoServiceClient) RelatedVideos(ctx context.Context, req *video.RelatedVideosRequest, callOptions ...callopt.Option) (r *video.RelatedVideosResponse, err error) {
+	ctx = client.NewCtxWithCallOptions(ctx, callOptions)
+	return p.kClient.RelatedVideos(ctx, req)
+}
@@ -50,6 +50,167 @@ var serviceMethods = map[string]kitex.MethodInfo{
 		false,
 		kitex.WithStreamingMode(kitex.StreamingNone),
 	),
+	"UpdateVideo": kitex.NewMethodInfo(
+		updateVideoHandler,
+		newVideoServiceUpdateVideoArgs,
+		newVideoServiceUpdateVideoResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteVideo": kitex.NewMethodInfo(
+		deleteVideoHandler,
+		newVideoServiceDeleteVideoArgs,
+		newVideoServiceDeleteVideoResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListDrafts": kitex.NewMethodInfo(
+		listDraftsHandler,
+		newVideoServiceListDraftsArgs,
+		newVideoServiceListDraftsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ScheduleVideo": kitex.NewMethodInfo(
+		scheduleVideoHandler,
+		newVideoServiceScheduleVideoArgs,
+		newVideoServiceScheduleVideoResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListVideosByTag": kitex.NewMethodInfo(
+		listVideosByTagHandler,
+		newVideoServiceListVideosByTagArgs,
+		newVideoServiceListVideosByTagResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"PopularTags": kitex.NewMethodInfo(
+		popularTagsHandler,
+		newVideoServicePopularTagsArgs,
+		newVideoServicePopularTagsResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"SuggestSearch": kitex.NewMethodInfo(
+		suggestSearchHandler,
+		newVideoServiceSuggestSearchArgs,
+		newVideoServiceSuggestSearchResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"TrendingSearches": kitex.NewMethodInfo(
+		trendingSearchesHandler,
+		newVideoServiceTrendingSearchesArgs,
+		newVideoServiceTrendingSearchesResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListSearchHistory": kitex.NewMethodInfo(
+		listSearchHistoryHandler,
+		newVideoServiceListSearchHistoryArgs,
+		newVideoServiceListSearchHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"DeleteSearchHistoryItem": kitex.NewMethodInfo(
+		deleteSearchHistoryItemHandler,
+		newVideoServiceDeleteSearchHistoryItemArgs,
+		newVideoServiceDeleteSearchHistoryItemResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ClearSearchHistory": kitex.NewMethodInfo(
+		clearSearchHistoryHandler,
+		newVideoServiceClearSearchHistoryArgs,
+		newVideoServiceClearSearchHistoryResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetVideoProcessingStatus": kitex.NewMethodInfo(
+		getVideoProcessingStatusHandler,
+		newVideoServiceGetVideoProcessingStatusArgs,
+		newVideoServiceGetVideoProcessingStatusResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"InitVideoUpload": kitex.NewMethodInfo(
+		initVideoUploadHandler,
+		newVideoServiceInitVideoUploadArgs,
+		newVideoServiceInitVideoUploadResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"UploadVideoChunk": kitex.NewMethodInfo(
+		uploadVideoChunkHandler,
+		newVideoServiceUploadVideoChunkArgs,
+		newVideoServiceUploadVideoChunkResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetVideoUpload": kitex.NewMethodInfo(
+		getVideoUploadHandler,
+		newVideoServiceGetVideoUploadArgs,
+		newVideoServiceGetVideoUploadResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CompleteVideoUpload": kitex.NewMethodInfo(
+		completeVideoUploadHandler,
+		newVideoServiceCompleteVideoUploadArgs,
+		newVideoServiceCompleteVideoUploadResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"AbortVideoUpload": kitex.NewMethodInfo(
+		abortVideoUploadHandler,
+		newVideoServiceAbortVideoUploadArgs,
+		newVideoServiceAbortVideoUploadResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"InitDirectUpload": kitex.NewMethodInfo(
+		initDirectUploadHandler,
+		newVideoServiceInitDirectUploadArgs,
+		newVideoServiceInitDirectUploadResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"CompleteDirectUpload": kitex.NewMethodInfo(
+		completeDirectUploadHandler,
+		newVideoServiceCompleteDirectUploadArgs,
+		newVideoServiceCompleteDirectUploadResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetVideoObject": kitex.NewMethodInfo(
+		getVideoObjectHandler,
+		newVideoServiceGetVideoObjectArgs,
+		newVideoServiceGetVideoObjectResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"ListUserVideos": kitex.NewMethodInfo(
+		listUserVideosHandler,
+		newVideoServiceListUserVideosArgs,
+		newVideoServiceListUserVideosResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"GetFeed": kitex.NewMethodInfo(
+		getFeedHandler,
+		newVideoServiceGetFeedArgs,
+		newVideoServiceGetFeedResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
+	"RelatedVideos": kitex.NewMethodInfo(
+		relatedVideosHandler,
+		newVideoServiceRelatedVideosArgs,
+		newVideoServiceRelatedVideosResult,
+		false,
+		kitex.WithStreamingMode(kitex.StreamingNone),
+	),
 }
 
 var (
@@ -206,6 +367,420 @@ func newVideoServiceUpdateVideoHotResult() interface{} {
 	return video.NewVideoServiceUpdateVideoHotResult()
 }
 
+func updateVideoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceUpdateVideoArgs)
+	realResult := result.(*video.VideoServiceUpdateVideoResult)
+	success, err := handler.(video.VideoService).UpdateVideo(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceUpdateVideoArgs() interface{} {
+	return video.NewVideoServiceUpdateVideoArgs()
+}
+
+func newVideoServiceUpdateVideoResult() interface{} {
+	return video.NewVideoServiceUpdateVideoResult()
+}
+
+func deleteVideoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceDeleteVideoArgs)
+	realResult := result.(*video.VideoServiceDeleteVideoResult)
+	success, err := handler.(video.VideoService).DeleteVideo(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceDeleteVideoArgs() interface{} {
+	return video.NewVideoServiceDeleteVideoArgs()
+}
+
+func newVideoServiceDeleteVideoResult() interface{} {
+	return video.NewVideoServiceDeleteVideoResult()
+}
+
+func listDraftsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceListDraftsArgs)
+	realResult := result.(*video.VideoServiceListDraftsResult)
+	success, err := handler.(video.VideoService).ListDrafts(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceListDraftsArgs() interface{} {
+	return video.NewVideoServiceListDraftsArgs()
+}
+
+func newVideoServiceListDraftsResult() interface{} {
+	return video.NewVideoServiceListDraftsResult()
+}
+
+func scheduleVideoHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceScheduleVideoArgs)
+	realResult := result.(*video.VideoServiceScheduleVideoResult)
+	success, err := handler.(video.VideoService).ScheduleVideo(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceScheduleVideoArgs() interface{} {
+	return video.NewVideoServiceScheduleVideoArgs()
+}
+
+func newVideoServiceScheduleVideoResult() interface{} {
+	return video.NewVideoServiceScheduleVideoResult()
+}
+
+func listVideosByTagHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceListVideosByTagArgs)
+	realResult := result.(*video.VideoServiceListVideosByTagResult)
+	success, err := handler.(video.VideoService).ListVideosByTag(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceListVideosByTagArgs() interface{} {
+	return video.NewVideoServiceListVideosByTagArgs()
+}
+
+func newVideoServiceListVideosByTagResult() interface{} {
+	return video.NewVideoServiceListVideosByTagResult()
+}
+
+func popularTagsHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServicePopularTagsArgs)
+	realResult := result.(*video.VideoServicePopularTagsResult)
+	success, err := handler.(video.VideoService).PopularTags(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServicePopularTagsArgs() interface{} {
+	return video.NewVideoServicePopularTagsArgs()
+}
+
+func newVideoServicePopularTagsResult() interface{} {
+	return video.NewVideoServicePopularTagsResult()
+}
+
+func suggestSearchHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceSuggestSearchArgs)
+	realResult := result.(*video.VideoServiceSuggestSearchResult)
+	success, err := handler.(video.VideoService).SuggestSearch(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceSuggestSearchArgs() interface{} {
+	return video.NewVideoServiceSuggestSearchArgs()
+}
+
+func newVideoServiceSuggestSearchResult() interface{} {
+	return video.NewVideoServiceSuggestSearchResult()
+}
+
+func trendingSearchesHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceTrendingSearchesArgs)
+	realResult := result.(*video.VideoServiceTrendingSearchesResult)
+	success, err := handler.(video.VideoService).TrendingSearches(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceTrendingSearchesArgs() interface{} {
+	return video.NewVideoServiceTrendingSearchesArgs()
+}
+
+func newVideoServiceTrendingSearchesResult() interface{} {
+	return video.NewVideoServiceTrendingSearchesResult()
+}
+
+func listSearchHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceListSearchHistoryArgs)
+	realResult := result.(*video.VideoServiceListSearchHistoryResult)
+	success, err := handler.(video.VideoService).ListSearchHistory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceListSearchHistoryArgs() interface{} {
+	return video.NewVideoServiceListSearchHistoryArgs()
+}
+
+func newVideoServiceListSearchHistoryResult() interface{} {
+	return video.NewVideoServiceListSearchHistoryResult()
+}
+
+func deleteSearchHistoryItemHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceDeleteSearchHistoryItemArgs)
+	realResult := result.(*video.VideoServiceDeleteSearchHistoryItemResult)
+	success, err := handler.(video.VideoService).DeleteSearchHistoryItem(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceDeleteSearchHistoryItemArgs() interface{} {
+	return video.NewVideoServiceDeleteSearchHistoryItemArgs()
+}
+
+func newVideoServiceDeleteSearchHistoryItemResult() interface{} {
+	return video.NewVideoServiceDeleteSearchHistoryItemResult()
+}
+
+func clearSearchHistoryHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceClearSearchHistoryArgs)
+	realResult := result.(*video.VideoServiceClearSearchHistoryResult)
+	success, err := handler.(video.VideoService).ClearSearchHistory(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceClearSearchHistoryArgs() interface{} {
+	return video.NewVideoServiceClearSearchHistoryArgs()
+}
+
+func newVideoServiceClearSearchHistoryResult() interface{} {
+	return video.NewVideoServiceClearSearchHistoryResult()
+}
+
+func getVideoProcessingStatusHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceGetVideoProcessingStatusArgs)
+	realResult := result.(*video.VideoServiceGetVideoProcessingStatusResult)
+	success, err := handler.(video.VideoService).GetVideoProcessingStatus(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceGetVideoProcessingStatusArgs() interface{} {
+	return video.NewVideoServiceGetVideoProcessingStatusArgs()
+}
+
+func newVideoServiceGetVideoProcessingStatusResult() interface{} {
+	return video.NewVideoServiceGetVideoProcessingStatusResult()
+}
+
+func initVideoUploadHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceInitVideoUploadArgs)
+	realResult := result.(*video.VideoServiceInitVideoUploadResult)
+	success, err := handler.(video.VideoService).InitVideoUpload(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceInitVideoUploadArgs() interface{} {
+	return video.NewVideoServiceInitVideoUploadArgs()
+}
+
+func newVideoServiceInitVideoUploadResult() interface{} {
+	return video.NewVideoServiceInitVideoUploadResult()
+}
+
+func uploadVideoChunkHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceUploadVideoChunkArgs)
+	realResult := result.(*video.VideoServiceUploadVideoChunkResult)
+	success, err := handler.(video.VideoService).UploadVideoChunk(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceUploadVideoChunkArgs() interface{} {
+	return video.NewVideoServiceUploadVideoChunkArgs()
+}
+
+func newVideoServiceUploadVideoChunkResult() interface{} {
+	return video.NewVideoServiceUploadVideoChunkResult()
+}
+
+func getVideoUploadHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceGetVideoUploadArgs)
+	realResult := result.(*video.VideoServiceGetVideoUploadResult)
+	success, err := handler.(video.VideoService).GetVideoUpload(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceGetVideoUploadArgs() interface{} {
+	return video.NewVideoServiceGetVideoUploadArgs()
+}
+
+func newVideoServiceGetVideoUploadResult() interface{} {
+	return video.NewVideoServiceGetVideoUploadResult()
+}
+
+func completeVideoUploadHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceCompleteVideoUploadArgs)
+	realResult := result.(*video.VideoServiceCompleteVideoUploadResult)
+	success, err := handler.(video.VideoService).CompleteVideoUpload(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceCompleteVideoUploadArgs() interface{} {
+	return video.NewVideoServiceCompleteVideoUploadArgs()
+}
+
+func newVideoServiceCompleteVideoUploadResult() interface{} {
+	return video.NewVideoServiceCompleteVideoUploadResult()
+}
+
+func abortVideoUploadHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceAbortVideoUploadArgs)
+	realResult := result.(*video.VideoServiceAbortVideoUploadResult)
+	success, err := handler.(video.VideoService).AbortVideoUpload(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceAbortVideoUploadArgs() interface{} {
+	return video.NewVideoServiceAbortVideoUploadArgs()
+}
+
+func newVideoServiceAbortVideoUploadResult() interface{} {
+	return video.NewVideoServiceAbortVideoUploadResult()
+}
+
+func initDirectUploadHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceInitDirectUploadArgs)
+	realResult := result.(*video.VideoServiceInitDirectUploadResult)
+	success, err := handler.(video.VideoService).InitDirectUpload(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceInitDirectUploadArgs() interface{} {
+	return video.NewVideoServiceInitDirectUploadArgs()
+}
+
+func newVideoServiceInitDirectUploadResult() interface{} {
+	return video.NewVideoServiceInitDirectUploadResult()
+}
+
+func completeDirectUploadHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceCompleteDirectUploadArgs)
+	realResult := result.(*video.VideoServiceCompleteDirectUploadResult)
+	success, err := handler.(video.VideoService).CompleteDirectUpload(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceCompleteDirectUploadArgs() interface{} {
+	return video.NewVideoServiceCompleteDirectUploadArgs()
+}
+
+func newVideoServiceCompleteDirectUploadResult() interface{} {
+	return video.NewVideoServiceCompleteDirectUploadResult()
+}
+
+func getVideoObjectHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceGetVideoObjectArgs)
+	realResult := result.(*video.VideoServiceGetVideoObjectResult)
+	success, err := handler.(video.VideoService).GetVideoObject(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceGetVideoObjectArgs() interface{} {
+	return video.NewVideoServiceGetVideoObjectArgs()
+}
+
+func newVideoServiceGetVideoObjectResult() interface{} {
+	return video.NewVideoServiceGetVideoObjectResult()
+}
+
+func listUserVideosHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceListUserVideosArgs)
+	realResult := result.(*video.VideoServiceListUserVideosResult)
+	success, err := handler.(video.VideoService).ListUserVideos(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceListUserVideosArgs() interface{} {
+	return video.NewVideoServiceListUserVideosArgs()
+}
+
+func newVideoServiceListUserVideosResult() interface{} {
+	return video.NewVideoServiceListUserVideosResult()
+}
+
+func getFeedHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceGetFeedArgs)
+	realResult := result.(*video.VideoServiceGetFeedResult)
+	success, err := handler.(video.VideoService).GetFeed(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceGetFeedArgs() interface{} {
+	return video.NewVideoServiceGetFeedArgs()
+}
+
+func newVideoServiceGetFeedResult() interface{} {
+	return video.NewVideoServiceGetFeedResult()
+}
+
+func relatedVideosHandler(ctx context.Context, handler interface{}, arg, result interface{}) error {
+	realArg := arg.(*video.VideoServiceRelatedVideosArgs)
+	realResult := result.(*video.VideoServiceRelatedVideosResult)
+	success, err := handler.(video.VideoService).RelatedVideos(ctx, realArg.Req)
+	if err != nil {
+		return err
+	}
+	realResult.Success = success
+	return nil
+}
+func newVideoServiceRelatedVideosArgs() interface{} {
+	return video.NewVideoServiceRelatedVideosArgs()
+}
+
+func newVideoServiceRelatedVideosResult() interface{} {
+	return video.NewVideoServiceRelatedVideosResult()
+}
+
 type kClient struct {
 	c client.Client
 }
@@ -265,3 +840,233 @@ func (p *kClient) UpdateVideoHot(ctx context.Context, req *video.VideoHotUpdateR
 	}
 	return _result.GetSuccess(), nil
 }
+
+func (p *kClient) UpdateVideo(ctx context.Context, req *video.VideoUpdateRequest) (r *video.VideoUpdateResponse, err error) {
+	var _args video.VideoServiceUpdateVideoArgs
+	_args.Req = req
+	var _result video.VideoServiceUpdateVideoResult
+	if err = p.c.Call(ctx, "UpdateVideo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteVideo(ctx context.Context, req *video.VideoDeleteRequest) (r *video.VideoDeleteResponse, err error) {
+	var _args video.VideoServiceDeleteVideoArgs
+	_args.Req = req
+	var _result video.VideoServiceDeleteVideoResult
+	if err = p.c.Call(ctx, "DeleteVideo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListDrafts(ctx context.Context, req *video.VideoDraftListRequest) (r *video.VideoDraftListResponse, err error) {
+	var _args video.VideoServiceListDraftsArgs
+	_args.Req = req
+	var _result video.VideoServiceListDraftsResult
+	if err = p.c.Call(ctx, "ListDrafts", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ScheduleVideo(ctx context.Context, req *video.VideoScheduleRequest) (r *video.VideoScheduleResponse, err error) {
+	var _args video.VideoServiceScheduleVideoArgs
+	_args.Req = req
+	var _result video.VideoServiceScheduleVideoResult
+	if err = p.c.Call(ctx, "ScheduleVideo", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListVideosByTag(ctx context.Context, req *video.VideoTagListRequest) (r *video.VideoTagListResponse, err error) {
+	var _args video.VideoServiceListVideosByTagArgs
+	_args.Req = req
+	var _result video.VideoServiceListVideosByTagResult
+	if err = p.c.Call(ctx, "ListVideosByTag", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) PopularTags(ctx context.Context, req *video.PopularTagsRequest) (r *video.PopularTagsResponse, err error) {
+	var _args video.VideoServicePopularTagsArgs
+	_args.Req = req
+	var _result video.VideoServicePopularTagsResult
+	if err = p.c.Call(ctx, "PopularTags", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) SuggestSearch(ctx context.Context, req *video.SearchSuggestRequest) (r *video.SearchSuggestResponse, err error) {
+	var _args video.VideoServiceSuggestSearchArgs
+	_args.Req = req
+	var _result video.VideoServiceSuggestSearchResult
+	if err = p.c.Call(ctx, "SuggestSearch", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) TrendingSearches(ctx context.Context, req *video.TrendingSearchesRequest) (r *video.TrendingSearchesResponse, err error) {
+	var _args video.VideoServiceTrendingSearchesArgs
+	_args.Req = req
+	var _result video.VideoServiceTrendingSearchesResult
+	if err = p.c.Call(ctx, "TrendingSearches", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListSearchHistory(ctx context.Context, req *video.SearchHistoryListRequest) (r *video.SearchHistoryListResponse, err error) {
+	var _args video.VideoServiceListSearchHistoryArgs
+	_args.Req = req
+	var _result video.VideoServiceListSearchHistoryResult
+	if err = p.c.Call(ctx, "ListSearchHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) DeleteSearchHistoryItem(ctx context.Context, req *video.SearchHistoryDeleteRequest) (r *video.SearchHistoryDeleteResponse, err error) {
+	var _args video.VideoServiceDeleteSearchHistoryItemArgs
+	_args.Req = req
+	var _result video.VideoServiceDeleteSearchHistoryItemResult
+	if err = p.c.Call(ctx, "DeleteSearchHistoryItem", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ClearSearchHistory(ctx context.Context, req *video.SearchHistoryClearRequest) (r *video.SearchHistoryClearResponse, err error) {
+	var _args video.VideoServiceClearSearchHistoryArgs
+	_args.Req = req
+	var _result video.VideoServiceClearSearchHistoryResult
+	if err = p.c.Call(ctx, "ClearSearchHistory", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetVideoProcessingStatus(ctx context.Context, req *video.VideoProcessingStatusRequest) (r *video.VideoProcessingStatusResponse, err error) {
+	var _args video.VideoServiceGetVideoProcessingStatusArgs
+	_args.Req = req
+	var _result video.VideoServiceGetVideoProcessingStatusResult
+	if err = p.c.Call(ctx, "GetVideoProcessingStatus", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) InitVideoUpload(ctx context.Context, req *video.InitVideoUploadRequest) (r *video.InitVideoUploadResponse, err error) {
+	var _args video.VideoServiceInitVideoUploadArgs
+	_args.Req = req
+	var _result video.VideoServiceInitVideoUploadResult
+	if err = p.c.Call(ctx, "InitVideoUpload", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) UploadVideoChunk(ctx context.Context, req *video.UploadVideoChunkRequest) (r *video.UploadVideoChunkResponse, err error) {
+	var _args video.VideoServiceUploadVideoChunkArgs
+	_args.Req = req
+	var _result video.VideoServiceUploadVideoChunkResult
+	if err = p.c.Call(ctx, "UploadVideoChunk", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetVideoUpload(ctx context.Context, req *video.GetVideoUploadRequest) (r *video.GetVideoUploadResponse, err error) {
+	var _args video.VideoServiceGetVideoUploadArgs
+	_args.Req = req
+	var _result video.VideoServiceGetVideoUploadResult
+	if err = p.c.Call(ctx, "GetVideoUpload", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CompleteVideoUpload(ctx context.Context, req *video.CompleteVideoUploadRequest) (r *video.CompleteVideoUploadResponse, err error) {
+	var _args video.VideoServiceCompleteVideoUploadArgs
+	_args.Req = req
+	var _result video.VideoServiceCompleteVideoUploadResult
+	if err = p.c.Call(ctx, "CompleteVideoUpload", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) AbortVideoUpload(ctx context.Context, req *video.AbortVideoUploadRequest) (r *video.AbortVideoUploadResponse, err error) {
+	var _args video.VideoServiceAbortVideoUploadArgs
+	_args.Req = req
+	var _result video.VideoServiceAbortVideoUploadResult
+	if err = p.c.Call(ctx, "AbortVideoUpload", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) InitDirectUpload(ctx context.Context, req *video.InitDirectUploadRequest) (r *video.InitDirectUploadResponse, err error) {
+	var _args video.VideoServiceInitDirectUploadArgs
+	_args.Req = req
+	var _result video.VideoServiceInitDirectUploadResult
+	if err = p.c.Call(ctx, "InitDirectUpload", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) CompleteDirectUpload(ctx context.Context, req *video.CompleteDirectUploadRequest) (r *video.CompleteDirectUploadResponse, err error) {
+	var _args video.VideoServiceCompleteDirectUploadArgs
+	_args.Req = req
+	var _result video.VideoServiceCompleteDirectUploadResult
+	if err = p.c.Call(ctx, "CompleteDirectUpload", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetVideoObject(ctx context.Context, req *video.GetVideoObjectRequest) (r *video.GetVideoObjectResponse, err error) {
+	var _args video.VideoServiceGetVideoObjectArgs
+	_args.Req = req
+	var _result video.VideoServiceGetVideoObjectResult
+	if err = p.c.Call(ctx, "GetVideoObject", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) ListUserVideos(ctx context.Context, req *video.UserVideoListRequest) (r *video.UserVideoListResponse, err error) {
+	var _args video.VideoServiceListUserVideosArgs
+	_args.Req = req
+	var _result video.VideoServiceListUserVideosResult
+	if err = p.c.Call(ctx, "ListUserVideos", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) GetFeed(ctx context.Context, req *video.FeedRequest) (r *video.FeedResponse, err error) {
+	var _args video.VideoServiceGetFeedArgs
+	_args.Req = req
+	var _result video.VideoServiceGetFeedResult
+	if err = p.c.Call(ctx, "GetFeed", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
+
+func (p *kClient) RelatedVideos(ctx context.Context, req *video.RelatedVideosRequest) (r *video.RelatedVideosResponse, err error) {
+	var _args video.VideoServiceRelatedVideosArgs
+	_args.Req = req
+	var _result video.VideoServiceRelatedVideosResult
+	if err = p.c.Call(ctx, "RelatedVideos", &_args, &_result); err != nil {
+		return
+	}
+	return _result.GetSuccess(), nil
+}
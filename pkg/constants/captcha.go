@@ -0,0 +1,28 @@
+package constants
+
+import "time"
+
+// 图形验证码相关
+const (
+	CaptchaIdHeader   = "Captcha-Id"   // 请求时携带的验证码 ID
+	CaptchaCodeHeader = "Captcha-Code" // 请求时携带的验证码答案
+
+	CaptchaLength = 5
+	// CaptchaAlphabet 去掉了容易混淆的 0/O、1/I/L
+	CaptchaAlphabet = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+	CaptchaWidth    = 120
+	CaptchaHeight   = 40
+
+	CaptchaTTL = 2 * time.Minute // 验证码有效期，过期或使用过一次后失效
+
+	// LoginFailureThreshold 同一个 IP 登录失败达到该次数后，登录也需要验证码
+	LoginFailureThreshold = 3
+	// LoginFailureWindow 登录失败次数的统计窗口
+	LoginFailureWindow = 15 * time.Minute
+)
+
+// Redis Key
+const (
+	CaptchaKeyPrefix      = "captcha:"
+	LoginFailureKeyPrefix = "captcha:login_failure:"
+)
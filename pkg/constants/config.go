@@ -0,0 +1,14 @@
+package constants
+
+import "time"
+
+// ConfigWatchInterval 是轮询 etcd 中配置变更的间隔
+// etcd 远程配置不会触发 viper 的 OnConfigChange，需要定时拉取
+const ConfigWatchInterval = 10 * time.Second
+
+// 密钥不写在 config.yaml 中，部署时通过以下环境变量注入，也可以直接写入 etcd 中的配置
+const (
+	PIIMasterKeyEnvPrefix = "PII_MASTER_KEY_V" // 后面接主密钥版本号，如 PII_MASTER_KEY_V1
+	PIIBlindIndexKeyEnv   = "PII_BLIND_INDEX_KEY"
+	SearchCursorKeyEnv    = "SEARCH_CURSOR_KEY"
+)
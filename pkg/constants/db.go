@@ -3,10 +3,22 @@ package constants
 import "time"
 
 const (
-	UserTableName       = "users"
-	ImageTableName      = "images"
-	VideoTableName      = "videos"
-	VideoStatsTableName = "video_stats"
+	UserTableName            = "users"
+	ImageTableName           = "images"
+	VideoTableName           = "videos"
+	VideoStatsTableName      = "video_stats"
+	VideoTagTableName        = "video_tags"
+	UserActivityLogTableName = "user_activity_logs"
+	UserLoginDeviceTableName = "user_login_devices"
+	InviteCodeTableName      = "invite_codes"
+	InviteRecordTableName    = "invite_records"
+	MembershipTableName      = "memberships"
+	RelationshipTableName    = "relationships"
+	PrivacySettingTableName  = "user_privacy_settings"
+	VideoProcessingTableName = "video_processing"
+	VideoRenditionTableName  = "video_renditions"
+	VideoMetadataTableName   = "video_metadata"
+	VideoLikeTableName       = "video_likes"
 )
 
 const (
@@ -0,0 +1,17 @@
+package constants
+
+// 关注流
+// 粉丝数低于 FeedFanOutThreshold 的作者发布视频时写入每个粉丝的收件箱(推模式)
+// 粉丝更多的作者只写入自己的发件箱，粉丝读取关注流时再合并(拉模式)
+const (
+	FeedInboxKeyPrefix   = "video:feed:inbox:"       // 收件箱 video:feed:inbox:<uid>，有序集合的成员是视频 ID，分数是发布时间(毫秒)
+	FeedOutboxKeyPrefix  = "video:feed:outbox:"      // 大 V 的发件箱 video:feed:outbox:<作者 ID>，结构和收件箱相同
+	FeedBigCreatorKey    = "video:feed:big_creators" // 使用过发件箱的作者集合，读取时只合并这些作者的发件箱
+	FeedFanOutThreshold  = 5000                      // 粉丝数达到这个值的作者改为拉模式
+	FeedFanOutBatchSize  = 500                       // 推送时每批查询和写入的粉丝数
+	FeedInboxMaxLen      = 800                       // 收件箱最多保留的视频数，超出时删除最早的视频
+	FeedOutboxMaxLen     = 200                       // 发件箱最多保留的视频数
+	FeedDefaultLimit     = 20                        // 默认每页数量
+	FeedMaxLimit         = 50                        // 每页最大数量
+	FollowerListMaxLimit = 1000                      // 每次最多列出的粉丝数
+)
@@ -0,0 +1,24 @@
+package constants
+
+// 邀请码相关
+const (
+	InviteCodeLength = 10 // 邀请码长度
+	// InviteCodeAlphabet 去掉了容易混淆的 0/O、1/I/L
+	InviteCodeAlphabet = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+
+	InviteMintMaxCount     = 500 // 管理员单次最多生成的邀请码数量
+	InviteCodeMaxUses      = 10000
+	InviteDefaultPageSize  = 20
+	InviteMaxPageSize      = 100
+	InviteCodeUserMaxUses  = 1 // 用户用自己的额度生成的邀请码只能使用一次
+	InviteCodeUserBatchID  = 0 // 用户自己生成的邀请码没有批次
+	InviteCodeNeverExpires = 0
+)
+
+// 不同会员等级的邀请额度，即用户最多可以生成的邀请码数量
+const (
+	InviteQuotaFree     = 1
+	InviteQuotaSilver   = 3
+	InviteQuotaGold     = 10
+	InviteQuotaPlatinum = 30
+)
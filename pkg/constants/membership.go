@@ -14,3 +14,10 @@ const (
 	MembershipLevelGoldCode
 	MembershipLevelPlatinumCode
 )
+
+// 'active', 'expired', 'pending'
+const (
+	MembershipStatusActive  = "active"
+	MembershipStatusExpired = "expired"
+	MembershipStatusPending = "pending"
+)
@@ -1,9 +1,11 @@
 package constants
 
 const (
-	ImageBucket = "avatar"
-	VideoBucket = "video"
-	Location    = "us-east-1"
-	ImageType   = "image/jpeg"
-	VideoType   = "video/mp4"
+	ImageBucket  = "avatar"
+	VideoBucket  = "video"
+	IndexBucket  = "search-index"
+	UploadBucket = "video-upload" // 分片上传的暂存桶
+	Location     = "us-east-1"
+	ImageType    = "image/jpeg"
+	VideoType    = "video/mp4"
 )
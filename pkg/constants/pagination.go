@@ -0,0 +1,12 @@
+package constants
+
+import "time"
+
+// 热榜和搜索的游标分页
+// 第一页时把排好序的视频 ID 冻结成快照，之后的页都从快照中读取，翻页期间热度和排序变化不会导致重复或遗漏
+const (
+	VideoPageSnapshotKeyPrefix = "video:page:"    // 分页快照 video:page:<快照 ID>，按顺序保存视频 ID 的列表
+	VideoPageSnapshotTTL       = 30 * time.Minute // 快照过期后游标失效，需要从第一页重新开始
+	VideoPageSnapshotSize      = 500              // 快照最多保存的视频数，翻到末尾后不再返回游标
+	VideoPageMaxPageSize       = 50               // 游标分页时每页的最大数量
+)
@@ -0,0 +1,14 @@
+package constants
+
+// 个人敏感信息(PII)加密相关
+const (
+	PIIKeySize             = 32 // AES-256
+	PIICiphertextPrefix    = "pii"
+	PIICiphertextSeparator = ":"
+	PIIDataKeyAAD          = "pii-data-key" // 加密数据密钥时使用的附加数据
+
+	PIIFieldEmail = "email"
+	PIIFieldPhone = "phone"
+
+	PIIMigrateBatchSize = 200 // 迁移存量数据时每批处理的用户数
+)
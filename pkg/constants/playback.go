@@ -0,0 +1,12 @@
+package constants
+
+import "time"
+
+// 视频播放地址
+// videos.video_url 只保存对象名，每次获取视频详情时生成带签名、会过期的播放地址
+const (
+	DefaultVideoPlaybackURLTTL = time.Hour
+
+	VideoPlaybackURLCacheTTL  = 5 * time.Minute   // 播放地址的缓存时间，不超过有效期的一半，保证返回的地址至少还有一半的有效期
+	VideoPlaybackURLKeyPrefix = "video:playback:" // 播放地址缓存 video:playback:<对象名>
+)
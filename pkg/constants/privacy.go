@@ -0,0 +1,28 @@
+package constants
+
+// 个人资料可见范围，对应 user_privacy_settings.profile_visibility
+const (
+	ProfileVisibilityPublic    = "public"
+	ProfileVisibilityFollowers = "followers"
+	ProfileVisibilityPrivate   = "private"
+)
+
+// 私信/关注权限，对应 user_privacy_settings.who_can_message 和 who_can_follow
+const (
+	PrivacyAudienceEveryone  = "everyone"
+	PrivacyAudienceFollowers = "followers"
+	PrivacyAudienceNobody    = "nobody"
+)
+
+// 其他服务调用 CheckPrivacy 时传入的操作类型
+const (
+	PrivacyActionViewProfile  = "view_profile"  // 查看个人资料
+	PrivacyActionViewLikes    = "view_likes"    // 查看点赞过的视频
+	PrivacyActionMessage      = "message"       // 发私信
+	PrivacyActionFollow       = "follow"        // 关注
+	PrivacyActionRecordSearch = "record_search" // 记录搜索历史，只对本人有效
+	PrivacyActionViewFollowed = "view_followed" // 观看仅粉丝可见的视频，只要求 viewer 关注了 owner
+)
+
+// RelationshipStatusFollow 是 relationships.status 中表示关注的取值
+const RelationshipStatusFollow = "follow"
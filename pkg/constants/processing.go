@@ -0,0 +1,38 @@
+package constants
+
+import "time"
+
+// 视频处理状态，对应 video_processing.status
+// uploaded → processing → ready/failed，failed 的视频重新投递后回到 processing，ready 是终态
+const (
+	VideoProcessingStatusUploaded   = "uploaded"
+	VideoProcessingStatusProcessing = "processing"
+	VideoProcessingStatusReady      = "ready"
+	VideoProcessingStatusFailed     = "failed"
+)
+
+// 视频处理任务
+const (
+	VideoRenditionObjectFormat = "renditions/%d/%s.mp4" // 转码产物在 video 桶中的对象名 renditions/<视频ID>/<清晰度>.mp4
+
+	VideoProcessingMaxAttempts  = 3                // 下载、上传、写库等失败时的最大尝试次数，文件本身有问题时不重试
+	VideoProcessingRetryBackoff = 5 * time.Second  // 第 n 次重试前等待 n 倍的时间
+	VideoProcessingTimeout      = 30 * time.Minute // 单个视频的处理超时
+	VideoFailReasonMaxLength    = 255              // 失败原因最大字符数，与 video_processing.fail_reason 列宽一致
+
+	// 处理进度的各个阶段，转码占大头，按清晰度和 ffmpeg 报告的进度细分
+	VideoProgressDownloaded = 5
+	VideoProgressValidated  = 10
+	VideoProgressTranscoded = 90
+	VideoProgressReady      = 100
+	VideoProgressStep       = 5 // 进度至少增加这么多才写库
+
+	VideoMinDurationSeconds = 1    // 时长下限
+	VideoMaxDurationSeconds = 3600 // 时长上限
+	VideoMaxDimension       = 4096 // 宽高上限
+	VideoDurationTolerance  = 2    // 投稿时客户端声明的时长与文件实际时长允许相差的秒数
+
+	DefaultFFmpegPath             = "ffmpeg"
+	DefaultFFprobePath            = "ffprobe"
+	DefaultVideoWorkerConcurrency = 2 // 每个 worker 进程同时处理的视频数
+)
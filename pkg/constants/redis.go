@@ -25,6 +25,9 @@ const (
 	// RedisDBUser 表示用于存储User相关数据的 Redis 数据库编号为 0。
 	RedisDBUser = 0
 
+	// RedisDBGateway 表示网关（如图形验证码）使用的 Redis 数据库编号为 1。
+	RedisDBGateway = 1
+
 	// RedSyncDBId 是 RedSync（分布式锁库）使用的 Redis 数据库编号为 0。
 	RedSyncDBId = 0
 )
@@ -0,0 +1,28 @@
+package constants
+
+import "time"
+
+// 相关视频推荐
+// 定时为每个公开视频计算相关视频写入 Redis，分数 = 共同标签数 * 标签权重 + 同一作者权重 + ln(1 + 共同点赞人数) * 点赞权重
+const (
+	RelatedVideoKeyPrefix       = "video:related:"                // 相关视频 video:related:<视频 ID>，有序集合的成员是视频 ID，分数是相关度
+	RelatedVideoLockKey         = "video:related_lock"            // 计算相关视频的锁，多个实例每个周期只计算一次
+	RelatedVideoRefreshInterval = 30 * time.Minute                // 重新计算的间隔
+	RelatedVideoTTL             = 3 * RelatedVideoRefreshInterval // 视频删除或不再公开后，之前计算的结果过期
+	RelatedVideoBatchSize       = 200                             // 每批计算的视频数
+	RelatedVideoCandidateLimit  = 100                             // 每种信号最多取的候选视频数
+	RelatedVideoMaxSize         = 50                              // 每个视频最多保存的相关视频数
+	RelatedVideoTagWeight       = 3.0                             // 每个共同标签的权重
+	RelatedVideoAuthorWeight    = 2.0                             // 同一作者的权重
+	RelatedVideoCoLikeWeight    = 4.0                             // 共同点赞的权重
+	RelatedVideoDefaultLimit    = 10                              // 默认返回数量
+	RelatedVideoMaxLimit        = 30                              // 最多返回数量
+	RelatedVideoFallbackSize    = 100                             // 相关视频不足时从热榜前这么多个视频中补足
+)
+
+// 观看记录，推荐相关视频时排除看过的视频
+const (
+	WatchHistoryKeyPrefix = "video:watched:"    // 观看记录 video:watched:<uid>，有序集合的成员是视频 ID，分数是观看时间
+	WatchHistoryMaxSize   = 500                 // 最多保留的视频数，超出时删除最早的记录
+	WatchHistoryTTL       = 30 * 24 * time.Hour // 用户一段时间没有观看视频后清空记录
+)
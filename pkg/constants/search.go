@@ -0,0 +1,57 @@
+package constants
+
+import "time"
+
+// 视频搜索引擎相关
+const (
+	SearchEngineMySQL    = "mysql"    // 基于 MySQL 全文索引搜索
+	SearchEngineEmbedded = "embedded" // 基于进程内的倒排索引搜索
+
+	SearchFieldTitle       = "title"
+	SearchFieldDescription = "description"
+	SearchFieldTags        = "tags"
+	SearchTitleBoost       = 3.0 // 标题命中的权重
+	SearchTagsBoost        = 2.0 // 标签命中的权重
+	SearchDescriptionBoost = 1.0 // 描述命中的权重
+
+	SearchSnapshotObject          = "video_index.gob.gz" // 索引快照的文件名，本地和 MinIO 中相同
+	DefaultSearchSnapshotDir      = "./data/search"
+	DefaultSearchSnapshotInterval = 10 * time.Minute
+
+	SearchIndexEventChannel     = "video:search:index" // 通知各实例更新索引的 Redis 频道，消息内容是视频 ID
+	SearchIndexRebuildBatchSize = 500                  // 从数据库重建索引时每批读取的视频数
+	SearchIndexCatchUpMargin    = time.Minute          // 从快照恢复后向前多追补的时间，容忍各实例时钟误差
+	SearchIndexRetryInterval    = 5 * time.Second      // 订阅索引事件或初始化索引失败后的重试间隔
+)
+
+// 搜索结果缓存的键为 video:search:v<版本号>:<page>:<size>:<标签过滤>:<keyword>，
+// 视频编辑或删除时版本号加一，旧版本的缓存不再被读取，随 TTL 过期
+const SearchCacheVersionKey = "video:search_version" // 不能放在 video:search: 下
+
+// 搜索联想和热搜榜
+const (
+	SearchSuggestKeyPrefix    = "video:suggest:" // 前缀索引 video:suggest:<前缀>，有序集合的成员是补全建议，分数是权重
+	SearchSuggestMaxPrefixLen = 20               // 只为前 20 个字建立前缀索引，更长的输入用前 20 个字查询后再过滤
+	SearchSuggestMaxPhraseLen = 50               // 超过这个长度的标题和搜索词不进入联想
+	SearchSuggestSetSize      = 50               // 每个前缀只保留权重最高的建议
+	SearchSuggestTitleWeight  = 1.0              // 视频标题的权重，搜索词每被搜索一次权重加 1
+	SearchSuggestDefaultLimit = 10
+	SearchSuggestMaxLimit     = 20
+
+	TrendingSearchKey           = "video:trending_search"            // 热搜榜，不能放在 video:search: 下，避免和搜索缓存混在一起
+	TrendingSearchDecayedAtKey  = "video:trending_search:decayed_at" // 热搜榜上次衰减的时间
+	TrendingSearchHalfLife      = 6 * time.Hour                      // 热度的半衰期
+	TrendingSearchDecayInterval = 10 * time.Minute                   // 衰减的间隔，多个实例共用一个衰减时间，不会重复衰减
+	TrendingSearchMinScore      = 0.05                               // 衰减到这个分数以下的搜索词被移出榜单
+	TrendingSearchMaxSize       = 1000                               // 榜单最多保留的搜索词数
+	TrendingSearchDefaultLimit  = 10
+	TrendingSearchMaxLimit      = 50
+)
+
+// 用户搜索历史
+const (
+	SearchHistoryKeyPrefix     = "video:search_history:" // 搜索历史 video:search_history:<uid>，列表头部是最近的搜索词
+	SearchHistoryMaxSize       = 50                      // 每个用户保留的搜索词数
+	SearchHistoryTTL           = 90 * 24 * time.Hour     // 长时间没有搜索的用户，搜索历史自动过期
+	SearchHistoryMaxKeywordLen = 100                     // 超过这个长度的搜索词不记录
+)
@@ -0,0 +1,22 @@
+package constants
+
+// 安全事件类型，写入 user_activity_logs.action
+const (
+	SecurityActionLoginSuccess   = "login_success"   // 登录成功
+	SecurityActionLoginFailure   = "login_failure"   // 登录失败（密码错误）
+	SecurityActionPasswordChange = "password_change" // 修改密码
+	SecurityActionTokenRevoke    = "token_revoke"    // 吊销令牌
+	SecurityAction2FAEnable      = "2fa_enable"      // 开启两步验证
+	SecurityAction2FADisable     = "2fa_disable"     // 关闭两步验证
+)
+
+const (
+	// SecurityEventTargetType 是安全事件在 user_activity_logs.target_type 中的取值
+	SecurityEventTargetType = "security"
+
+	SecurityEventDefaultPageSize = 20  // 安全事件默认每页条数
+	SecurityEventMaxPageSize     = 100 // 安全事件每页最大条数
+
+	UserAgentMaxLength = 512 // 与 user_activity_logs、user_login_devices 的 user_agent 列长度保持一致
+	ClientIPMaxLength  = 64  // 与 ip 列长度保持一致
+)
@@ -4,6 +4,7 @@ const (
 	GatewayServiceName       = "gateway"
 	UserServiceName          = "user"
 	VideoServiceName         = "video"
+	VideoWorkerServiceName   = "video_worker"
 	CommentServiceName       = "comment"
 	UserBehaviourServiceName = "user_behaviour"
 	ChatServiceName          = "chat"
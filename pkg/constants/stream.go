@@ -0,0 +1,10 @@
+package constants
+
+import "time"
+
+// 网关代理播放
+const (
+	StreamBandwidthKeyPrefix = "stream:bandwidth:" // 用户每天的播放流量（字节）stream:bandwidth:<用户ID>:<yyyymmdd>
+	StreamBandwidthTTL       = 31 * 24 * time.Hour // 播放流量的保留时间
+	StreamDefaultContentType = VideoType           // 对象没有记录内容类型时使用
+)
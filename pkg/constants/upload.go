@@ -0,0 +1,18 @@
+package constants
+
+import "time"
+
+// 分片上传
+// 分片先写入 UploadBucket 中的暂存对象，全部上传并校验通过后再放进 video 桶
+// 直传时客户端使用预签名的表单把整个文件上传到同一个暂存对象
+const (
+	VideoUploadObjectFormat = "%d.mp4" // 暂存对象名 <上传ID>.mp4
+
+	VideoUploadChunkSize   = 8 << 20          // 分片大小，MinIO 要求除最后一片外每片不小于 5MiB
+	VideoUploadMaxFileSize = 2 << 30          // 文件大小上限，与整文件投稿的请求体上限一致
+	VideoUploadSessionTTL  = 24 * time.Hour   // 上传会话在最后一次上传分片后保留的时间，MinIO 默认也会清理 24 小时前未完成的分片上传
+	VideoUploadCompleteTTL = 30 * time.Minute // 合并分片时持有锁的最长时间，期间不能继续上传分片或重复合并
+	VideoDirectUploadTTL   = time.Hour        // 直传表单的有效期，客户端需要在此之前上传完文件
+
+	VideoUploadKeyPrefix = "video:upload:" // 上传会话 video:upload:<上传ID>，已上传的分片 video:upload:<上传ID>:chunks，合并锁 video:upload:<上传ID>:lock
+)
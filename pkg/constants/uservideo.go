@@ -0,0 +1,11 @@
+package constants
+
+// 作者视频列表
+const (
+	UserVideoSortNewest     = "newest"      // 最新发布
+	UserVideoSortMostViewed = "most_viewed" // 最多播放
+	UserVideoSortMostLiked  = "most_liked"  // 最多点赞
+
+	UserVideoDefaultLimit = 20 // 默认每页数量
+	UserVideoMaxLimit     = 50 // 每页最大数量
+)
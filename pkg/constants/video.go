@@ -1,5 +1,7 @@
 package constants
 
+import "time"
+
 const (
     DefaultVideoCoverUrl = ""
     RedisMinute          = 60
@@ -12,6 +14,55 @@ const (
     HotRankKey                   = "video:hot_rank"
 )
 
+// 视频状态，对应 videos.status
+const (
+	VideoStatusPublished = "published"
+	VideoStatusDeleted   = "deleted"
+	VideoStatusDraft     = "draft"
+)
+
+// 视频编辑与删除
+const (
+	VideoTitleMaxLength       = 255                   // 标题最大字符数，与 videos.title 列宽一致
+	VideoSearchCacheKeyPrefix = "video:search:"       // 搜索结果缓存 video:search:<page>:<size>:<标签过滤>:<keyword>
+	VideoPendingPurgeKey      = "video:pending_purge" // 待清理的 MinIO 对象，ZSet，score 为可以清理的时间戳
+	VideoPurgeDelay           = 24 * time.Hour        // 删除视频后保留文件的时间，方便误删恢复
+	VideoPurgeInterval        = 10 * time.Minute      // 清理任务执行间隔
+	VideoPurgeBatchSize       = 100                   // 每次最多清理的对象数
+)
+
+// 草稿定时发布
+const (
+	VideoPublishScanInterval = 30 * time.Second // 扫描到期草稿的间隔
+	VideoPublishBatchSize    = 100              // 每次最多发布的草稿数
+)
+
+// 视频标签
+const (
+	VideoTagMaxCount        = 10                   // 每个视频最多的标签数
+	VideoTagMaxLength       = 64                   // 标签最大字符数，与 video_tags.tag 列宽一致
+	VideoTagModeAny         = "any"                // 搜索时命中任一标签
+	VideoTagModeAll         = "all"                // 搜索时命中全部标签
+	PopularTagsDefaultLimit = 20                   // 热门标签默认返回数量
+	PopularTagsMaxLimit     = 100                  // 热门标签最多返回数量
+	PopularTagsCacheKey     = "video:tags:popular" // 热门标签缓存
+	PopularTagsCacheTTL     = 10 * time.Minute
+)
+
+// 视频搜索
+const (
+	VideoSearchSortRelevance  = "relevance"   // 综合文本相关度、热度和新鲜度
+	VideoSearchSortNewest     = "newest"      // 最新发布
+	VideoSearchSortMostViewed = "most_viewed" // 最多播放
+
+	VideoSearchTextWeight     = 1.0  // 文本相关度权重
+	VideoSearchHotWeight      = 0.3  // 热度权重
+	VideoSearchFreshWeight    = 0.5  // 新鲜度权重
+	VideoSearchFreshnessHours = 72.0 // 发布 72 小时后新鲜度减半
+	VideoSearchNgramTokenSize = 2    // 与 MySQL ngram_token_size 一致，更短的关键词无法走全文索引
+	VideoSearchCacheTTL       = 5 * time.Minute
+)
+
 const (
 	DecayFactor float64 = 3600 * 6 // 每 6 小时衰减一分
 	HotRankKey          = "video:hot_rank"
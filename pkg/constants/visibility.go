@@ -0,0 +1,10 @@
+package constants
+
+// 视频可见范围，对应 videos.visibility
+// 只有公开视频进入热榜、搜索和标签列表，不公开的视频知道链接就能观看
+const (
+	VideoVisibilityPublic    = "public"    // 公开
+	VideoVisibilityUnlisted  = "unlisted"  // 不公开，不出现在热榜和搜索中
+	VideoVisibilityPrivate   = "private"   // 仅作者本人可见
+	VideoVisibilityFollowers = "followers" // 仅作者的粉丝可见
+)
@@ -26,6 +26,8 @@ const (
 	AuthMissingTokenCode        // 缺少 token
 	IllegalOperatorCode         // 不合格的操作(比如传入 payment status时传入了一个不存在的 status)
 	DBNotFound
+	AuthCaptchaRequiredCode // 缺少图形验证码
+	AuthCaptchaInvalidCode  // 图形验证码错误或已过期
 )
 
 // 内部错误,服务级别的错误
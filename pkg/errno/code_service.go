@@ -8,4 +8,8 @@ const (
 	ServiceUserNotExist
 	ErrRecordNotFound
 	DatabaseError
+	ServiceInviteCodeRequired  // 开启邀请码注册时未填写邀请码
+	ServiceInviteCodeInvalid   // 邀请码不存在、已过期或已用完
+	ServiceInviteQuotaExceeded // 邀请额度已用完
+	ServicePrivacyRestricted   // 对方的隐私设置不允许该操作
 )
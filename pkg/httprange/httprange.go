@@ -0,0 +1,143 @@
+// Package httprange 判断 HTTP 条件请求(RFC 7232)和范围请求(RFC 7233)应该返回的状态码和内容范围
+// 只支持单个范围，包含多个范围或格式不对的 Range 按没有 Range 处理，返回完整内容
+package httprange
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Resource 是被请求的资源
+type Resource struct {
+	Size         int64
+	ETag         string // 带引号的强 ETag，比如 "abc"
+	LastModified time.Time
+}
+
+// Request 是请求中相关的请求头，为空表示没有这个请求头
+type Request struct {
+	Range           string
+	IfRange         string
+	IfMatch         string
+	IfNoneMatch     string
+	IfModifiedSince string
+}
+
+// Result 是应该返回的状态码和内容范围
+type Result struct {
+	Status int   // 200、206、304、412 或 416
+	Start  int64 // 返回的内容在资源中的起始位置
+	Length int64 // 返回的内容长度，304、412、416 时为 0
+}
+
+// ContentRange 返回 206 和 416 响应的 Content-Range 头，其他状态返回空字符串
+func (r Result) ContentRange(size int64) string {
+	switch r.Status {
+	case http.StatusPartialContent:
+		return fmt.Sprintf("bytes %d-%d/%d", r.Start, r.Start+r.Length-1, size)
+	case http.StatusRequestedRangeNotSatisfiable:
+		return fmt.Sprintf("bytes */%d", size)
+	}
+	return ""
+}
+
+// Evaluate 按 RFC 7232 第 6 节的顺序依次判断条件请求头，最后处理 Range
+func Evaluate(req Request, res Resource) Result {
+	if req.IfMatch != "" && !matchETag(req.IfMatch, res.ETag, false) {
+		return Result{Status: http.StatusPreconditionFailed}
+	}
+	if req.IfNoneMatch != "" {
+		if matchETag(req.IfNoneMatch, res.ETag, true) {
+			return Result{Status: http.StatusNotModified}
+		}
+	} else if req.IfModifiedSince != "" {
+		if t, err := http.ParseTime(req.IfModifiedSince); err == nil && !res.LastModified.Truncate(time.Second).After(t) {
+			return Result{Status: http.StatusNotModified}
+		}
+	}
+
+	full := Result{Status: http.StatusOK, Length: res.Size}
+	if req.Range == "" || (req.IfRange != "" && !matchIfRange(req.IfRange, res)) {
+		return full
+	}
+	start, length, ok, satisfiable := parseRange(req.Range, res.Size)
+	switch {
+	case !ok:
+		return full
+	case !satisfiable:
+		return Result{Status: http.StatusRequestedRangeNotSatisfiable}
+	}
+	return Result{Status: http.StatusPartialContent, Start: start, Length: length}
+}
+
+// matchETag 判断 If-Match 或 If-None-Match 中是否有与 etag 匹配的值，weak 为 true 时使用弱比较
+func matchETag(header, etag string, weak bool) bool {
+	if strings.TrimSpace(header) == "*" {
+		return etag != ""
+	}
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if weak {
+			if strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		} else if candidate == etag && !strings.HasPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// matchIfRange 判断 If-Range 是否与资源匹配，不匹配时忽略 Range 返回完整内容
+// If-Range 可以是 ETag(使用强比较)或者 HTTP 日期(必须与 Last-Modified 完全相同)
+func matchIfRange(header string, res Resource) bool {
+	header = strings.TrimSpace(header)
+	if strings.HasPrefix(header, `"`) || strings.HasPrefix(header, "W/") {
+		return header == res.ETag && !strings.HasPrefix(header, "W/")
+	}
+	t, err := http.ParseTime(header)
+	return err == nil && t.Equal(res.LastModified.Truncate(time.Second))
+}
+
+// parseRange 解析只包含一个范围的 Range 头，ok 为 false 表示应该忽略这个头
+// 范围的起点超出资源大小时 satisfiable 为 false，终点超出时截断到资源末尾
+func parseRange(header string, size int64) (start, length int64, ok, satisfiable bool) {
+	spec, found := strings.CutPrefix(strings.TrimSpace(header), "bytes=")
+	if !found || strings.Contains(spec, ",") {
+		return 0, 0, false, false
+	}
+	first, last, found := strings.Cut(strings.TrimSpace(spec), "-")
+	if !found {
+		return 0, 0, false, false
+	}
+	if first == "" {
+		// bytes=-N 表示最后 N 个字节
+		n, err := strconv.ParseInt(last, 10, 64)
+		if err != nil || n < 0 {
+			return 0, 0, false, false
+		}
+		if n == 0 || size == 0 {
+			return 0, 0, true, false
+		}
+		n = min(n, size)
+		return size - n, n, true, true
+	}
+	start, err := strconv.ParseInt(first, 10, 64)
+	if err != nil || start < 0 {
+		return 0, 0, false, false
+	}
+	end := size - 1
+	if last != "" {
+		if end, err = strconv.ParseInt(last, 10, 64); err != nil || end < start {
+			return 0, 0, false, false
+		}
+		end = min(end, size-1)
+	}
+	if start >= size {
+		return 0, 0, true, false
+	}
+	return start, end - start + 1, true, true
+}
@@ -0,0 +1,64 @@
+package httprange
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func TestEvaluate(t *testing.T) {
+	modified := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	res := Resource{Size: 1000, ETag: `"v1"`, LastModified: modified}
+	lastModified := modified.Format(http.TimeFormat)
+
+	convey.Convey("Evaluate", t, func() {
+		convey.Convey("no range", func() {
+			convey.So(Evaluate(Request{}, res), convey.ShouldResemble, Result{Status: http.StatusOK, Length: 1000})
+		})
+
+		convey.Convey("single ranges", func() {
+			cases := map[string]Result{
+				"bytes=0-99":     {Status: http.StatusPartialContent, Start: 0, Length: 100},
+				"bytes=900-":     {Status: http.StatusPartialContent, Start: 900, Length: 100},
+				"bytes=-100":     {Status: http.StatusPartialContent, Start: 900, Length: 100},
+				"bytes=-5000":    {Status: http.StatusPartialContent, Start: 0, Length: 1000},
+				"bytes=990-2000": {Status: http.StatusPartialContent, Start: 990, Length: 10},
+				"bytes=1000-":    {Status: http.StatusRequestedRangeNotSatisfiable},
+				"bytes=-0":       {Status: http.StatusRequestedRangeNotSatisfiable},
+			}
+			for header, expected := range cases {
+				convey.So(Evaluate(Request{Range: header}, res), convey.ShouldResemble, expected)
+			}
+		})
+
+		convey.Convey("ignored ranges", func() {
+			for _, header := range []string{"bytes=0-1,5-6", "items=0-1", "bytes=5-1", "bytes=abc", "bytes=-"} {
+				convey.So(Evaluate(Request{Range: header}, res).Status, convey.ShouldEqual, http.StatusOK)
+			}
+		})
+
+		convey.Convey("if-range", func() {
+			convey.So(Evaluate(Request{Range: "bytes=0-9", IfRange: `"v1"`}, res).Status, convey.ShouldEqual, http.StatusPartialContent)
+			convey.So(Evaluate(Request{Range: "bytes=0-9", IfRange: lastModified}, res).Status, convey.ShouldEqual, http.StatusPartialContent)
+			convey.So(Evaluate(Request{Range: "bytes=0-9", IfRange: `"v0"`}, res).Status, convey.ShouldEqual, http.StatusOK)
+			convey.So(Evaluate(Request{Range: "bytes=0-9", IfRange: `W/"v1"`}, res).Status, convey.ShouldEqual, http.StatusOK)
+			earlier := modified.Add(-time.Hour).Format(http.TimeFormat)
+			convey.So(Evaluate(Request{Range: "bytes=0-9", IfRange: earlier}, res).Status, convey.ShouldEqual, http.StatusOK)
+		})
+
+		convey.Convey("conditional requests", func() {
+			convey.So(Evaluate(Request{IfNoneMatch: `"v0", W/"v1"`}, res).Status, convey.ShouldEqual, http.StatusNotModified)
+			convey.So(Evaluate(Request{IfNoneMatch: `"v0"`, IfModifiedSince: lastModified}, res).Status, convey.ShouldEqual, http.StatusOK)
+			convey.So(Evaluate(Request{IfModifiedSince: lastModified}, res).Status, convey.ShouldEqual, http.StatusNotModified)
+			convey.So(Evaluate(Request{IfMatch: `"v0"`, Range: "bytes=0-9"}, res).Status, convey.ShouldEqual, http.StatusPreconditionFailed)
+			convey.So(Evaluate(Request{IfMatch: "*", Range: "bytes=0-9"}, res).Status, convey.ShouldEqual, http.StatusPartialContent)
+		})
+
+		convey.Convey("content range", func() {
+			convey.So(Evaluate(Request{Range: "bytes=10-19"}, res).ContentRange(res.Size), convey.ShouldEqual, "bytes 10-19/1000")
+			convey.So(Evaluate(Request{Range: "bytes=2000-"}, res).ContentRange(res.Size), convey.ShouldEqual, "bytes */1000")
+		})
+	})
+}
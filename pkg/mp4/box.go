@@ -0,0 +1,148 @@
+// Package mp4 解析 ISO-BMFF(MP4) 文件的 box 结构，不依赖 ffprobe 即可得到时长、分辨率和编码等元数据
+package mp4
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+const MaxMoovSize = 64 << 20 // moov 的大小上限，解析时整个读入内存
+
+var (
+	ErrMalformed   = errors.New("mp4: malformed file")
+	ErrUnsupported = errors.New("mp4: unsupported file")
+)
+
+func malformed(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrMalformed, fmt.Sprintf(format, args...))
+}
+
+func unsupported(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrUnsupported, fmt.Sprintf(format, args...))
+}
+
+// readAt 读满 p，ReaderAt 在恰好读到末尾时可能同时返回 io.EOF，这种情况不算错误
+func readAt(r io.ReaderAt, p []byte, off int64) error {
+	n, err := r.ReadAt(p, off)
+	if n == len(p) {
+		return nil
+	}
+	if err == nil {
+		err = io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+// readError 读到文件末尾说明文件被截断，其他错误来自底层的读取，原样返回便于调用方重试
+func readError(err error, format string, args ...interface{}) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return malformed("%s: %v", fmt.Sprintf(format, args...), err)
+	}
+	return fmt.Errorf("mp4: %s: %w", fmt.Sprintf(format, args...), err)
+}
+
+// box 是一个 box 的头部，offset 和 size 都包含头部
+type box struct {
+	typ        string
+	offset     int64
+	headerSize int64
+	size       int64
+}
+
+func (b box) payloadOffset() int64 {
+	return b.offset + b.headerSize
+}
+
+func (b box) end() int64 {
+	return b.offset + b.size
+}
+
+// readBoxHeader 读取 offset 处的 box 头部，end 是所在容器的结束位置
+// size 为 0 表示 box 一直延伸到容器末尾，为 1 表示使用 64 位的 largesize
+func readBoxHeader(r io.ReaderAt, offset, end int64) (box, error) {
+	var buf [16]byte
+	if end-offset < 8 {
+		return box{}, malformed("truncated box header at %d", offset)
+	}
+	if err := readAt(r, buf[:8], offset); err != nil {
+		return box{}, readError(err, "read box header at %d", offset)
+	}
+	b := box{typ: string(buf[4:8]), offset: offset, headerSize: 8}
+	size := int64(binary.BigEndian.Uint32(buf[:4]))
+	switch size {
+	case 0:
+		size = end - offset
+	case 1:
+		if end-offset < 16 {
+			return box{}, malformed("truncated largesize of box %q at %d", b.typ, offset)
+		}
+		if err := readAt(r, buf[8:16], offset+8); err != nil {
+			return box{}, readError(err, "read largesize of box %q at %d", b.typ, offset)
+		}
+		large := binary.BigEndian.Uint64(buf[8:16])
+		if large > math.MaxInt64 {
+			return box{}, malformed("box %q at %d is too large", b.typ, offset)
+		}
+		size = int64(large)
+		b.headerSize = 16
+	}
+	if size < b.headerSize || size > end-offset {
+		return box{}, malformed("box %q at %d has invalid size %d", b.typ, offset, size)
+	}
+	b.size = size
+	return b, nil
+}
+
+// readBoxes 依次读取 [start, end) 范围内的所有 box
+func readBoxes(r io.ReaderAt, start, end int64) ([]box, error) {
+	var boxes []box
+	for offset := start; offset < end; {
+		b, err := readBoxHeader(r, offset, end)
+		if err != nil {
+			return nil, err
+		}
+		boxes = append(boxes, b)
+		offset = b.end()
+	}
+	return boxes, nil
+}
+
+// children 解析内存中 parent 的子 box
+func children(data []byte, parent box) ([]box, error) {
+	return readBoxes(bytes.NewReader(data), parent.payloadOffset(), parent.end())
+}
+
+// findBox 返回第一个指定类型的 box
+func findBox(boxes []box, typ string) (box, bool) {
+	for _, b := range boxes {
+		if b.typ == typ {
+			return b, true
+		}
+	}
+	return box{}, false
+}
+
+// findPath 沿着类型路径逐层查找子 box，比如 findPath(data, trak, "mdia", "minf", "stbl")
+func findPath(data []byte, parent box, path ...string) (box, bool, error) {
+	for _, typ := range path {
+		boxes, err := children(data, parent)
+		if err != nil {
+			return box{}, false, err
+		}
+		b, ok := findBox(boxes, typ)
+		if !ok {
+			return box{}, false, nil
+		}
+		parent = b
+	}
+	return parent, true, nil
+}
+
+// payload 返回内存中 box 的内容（不含头部）
+func payload(data []byte, b box) []byte {
+	return data[b.payloadOffset():b.end()]
+}
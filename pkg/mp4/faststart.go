@@ -0,0 +1,154 @@
+package mp4
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+// FastStart 把 moov 移到第一个 mdat 之前，使文件可以边下载边播放
+// moov 前移后，位于原 moov 之前的 mdat 整体后移 moov 的长度，stco/co64 中指向这些 mdat 的偏移随之修正
+// moov 已经在 mdat 之前时原样返回 data
+func FastStart(data []byte) ([]byte, error) {
+	moov, mdat, newMoov, err := relocateMoov(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	if newMoov == nil {
+		return data, nil
+	}
+	out := make([]byte, 0, len(data))
+	out = append(out, data[:mdat.offset]...)
+	out = append(out, newMoov...)
+	out = append(out, data[mdat.offset:moov.offset]...)
+	out = append(out, data[moov.end():]...)
+	return out, nil
+}
+
+// FastStartReader 与 FastStart 相同，但只把 moov 读入内存，其余部分从 r 中按需读取，适合不方便整个读入内存的大文件
+// 返回的内容长度与原文件相同
+func FastStartReader(r io.ReaderAt, size int64) (io.Reader, error) {
+	moov, mdat, newMoov, err := relocateMoov(r, size)
+	if err != nil {
+		return nil, err
+	}
+	if newMoov == nil {
+		return io.NewSectionReader(r, 0, size), nil
+	}
+	return io.MultiReader(
+		io.NewSectionReader(r, 0, mdat.offset),
+		bytes.NewReader(newMoov),
+		io.NewSectionReader(r, mdat.offset, moov.offset-mdat.offset),
+		io.NewSectionReader(r, moov.end(), size-moov.end()),
+	), nil
+}
+
+// relocateMoov 找到 moov 和第一个 mdat，moov 在 mdat 之后时返回修正了 chunk 偏移的新 moov，否则 newMoov 为 nil
+func relocateMoov(r io.ReaderAt, size int64) (moov, mdat box, newMoov []byte, err error) {
+	top, err := readBoxes(r, 0, size)
+	if err != nil {
+		return box{}, box{}, nil, err
+	}
+	var hasMoov, hasMdat bool
+	for _, b := range top {
+		switch {
+		case b.typ == "moov" && !hasMoov:
+			moov, hasMoov = b, true
+		case b.typ == "mdat" && !hasMdat:
+			mdat, hasMdat = b, true
+		}
+	}
+	if !hasMoov {
+		return box{}, box{}, nil, malformed("moov is missing")
+	}
+	if !hasMdat {
+		return box{}, box{}, nil, malformed("mdat is missing")
+	}
+	if moov.offset < mdat.offset {
+		return moov, mdat, nil, nil
+	}
+	if moov.size > MaxMoovSize {
+		return box{}, box{}, nil, unsupported("moov of %d bytes exceeds the limit", moov.size)
+	}
+
+	newMoov = make([]byte, moov.size)
+	if err = readAt(r, newMoov, moov.offset); err != nil {
+		return box{}, box{}, nil, readError(err, "read moov")
+	}
+	if binary.BigEndian.Uint32(newMoov[:4]) == 0 {
+		// 原来的 moov 是最后一个 box 并且大小记为 0(延伸到文件末尾)，移动后需要写明大小
+		binary.BigEndian.PutUint32(newMoov[:4], uint32(moov.size))
+	}
+	root := box{typ: "moov", headerSize: moov.headerSize, size: moov.size}
+	boxes, err := children(newMoov, root)
+	if err != nil {
+		return box{}, box{}, nil, err
+	}
+	if _, ok := findBox(boxes, "mvex"); ok {
+		return box{}, box{}, nil, unsupported("fragmented mp4")
+	}
+	for _, b := range boxes {
+		if b.typ != "trak" {
+			continue
+		}
+		if err = shiftChunkOffsets(newMoov, b, mdat.offset, moov.offset, moov.size); err != nil {
+			return box{}, box{}, nil, err
+		}
+	}
+	return moov, mdat, newMoov, nil
+}
+
+// shiftChunkOffsets 把 trak 中落在 [from, to) 范围内的 chunk 偏移加上 shift，直接修改 data
+func shiftChunkOffsets(data []byte, trak box, from, to, shift int64) error {
+	stbl, ok, err := findPath(data, trak, "mdia", "minf", "stbl")
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return malformed("stbl is missing")
+	}
+	boxes, err := children(data, stbl)
+	if err != nil {
+		return err
+	}
+	for _, b := range boxes {
+		var width int
+		switch b.typ {
+		case "stco":
+			width = 4
+		case "co64":
+			width = 8
+		default:
+			continue
+		}
+		p := payload(data, b)
+		if len(p) < 8 {
+			return malformed("chunk offset table is too short")
+		}
+		count := uint64(binary.BigEndian.Uint32(p[4:8]))
+		if uint64(len(p)-8) < count*uint64(width) {
+			return malformed("chunk offset table is truncated")
+		}
+		for i := 0; i < int(count); i++ {
+			entry := p[8+i*width : 8+(i+1)*width]
+			if width == 4 {
+				offset := int64(binary.BigEndian.Uint32(entry))
+				if offset < from || offset >= to {
+					continue
+				}
+				if offset+shift > math.MaxUint32 {
+					return unsupported("chunk offset %d overflows stco after moving moov", offset+shift)
+				}
+				binary.BigEndian.PutUint32(entry, uint32(offset+shift))
+			} else {
+				offset := int64(binary.BigEndian.Uint64(entry))
+				if offset < from || offset >= to {
+					continue
+				}
+				binary.BigEndian.PutUint64(entry, uint64(offset+shift))
+			}
+		}
+	}
+	return nil
+}
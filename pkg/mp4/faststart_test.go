@@ -0,0 +1,89 @@
+package mp4
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+// chunkOffsets 返回文件中每个轨道的 chunk 偏移
+func chunkOffsets(data []byte) ([][]int64, error) {
+	top, err := readBoxes(bytes.NewReader(data), 0, int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	moov, _ := findBox(top, "moov")
+	moovData := data[moov.offset:moov.end()]
+	boxes, err := children(moovData, box{typ: "moov", headerSize: moov.headerSize, size: moov.size})
+	if err != nil {
+		return nil, err
+	}
+	var offsets [][]int64
+	for _, b := range boxes {
+		if b.typ != "trak" {
+			continue
+		}
+		t, err := parseTrack(moovData, b)
+		if err != nil {
+			return nil, err
+		}
+		offsets = append(offsets, t.chunkOffsets)
+	}
+	return offsets, nil
+}
+
+func TestFastStart(t *testing.T) {
+	convey.Convey("FastStart", t, func() {
+		file := testFile{videoSizes: []uint32{1000, 500, 500}, audioSize: 300}
+
+		convey.Convey("moves moov in front of mdat", func() {
+			data := file.build()
+			out, err := FastStart(data)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(out), convey.ShouldEqual, len(data))
+
+			info, err := probe(out)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(info.FastStart, convey.ShouldBeTrue)
+			convey.So(info.Duration, convey.ShouldEqual, 10)
+
+			before, err := chunkOffsets(data)
+			convey.So(err, convey.ShouldBeNil)
+			after, err := chunkOffsets(out)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(len(after), convey.ShouldEqual, len(before))
+			for i := range before {
+				for j := range before[i] {
+					convey.So(out[after[i][j]:after[i][j]+16], convey.ShouldResemble, data[before[i][j]:before[i][j]+16])
+				}
+			}
+		})
+
+		convey.Convey("streams the same bytes as FastStart", func() {
+			data := file.build()
+			out, err := FastStart(data)
+			convey.So(err, convey.ShouldBeNil)
+			reader, err := FastStartReader(bytes.NewReader(data), int64(len(data)))
+			convey.So(err, convey.ShouldBeNil)
+			streamed, err := io.ReadAll(reader)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(streamed, convey.ShouldResemble, out)
+		})
+
+		convey.Convey("leaves faststart files untouched", func() {
+			file.fastStart = true
+			data := file.build()
+			out, err := FastStart(data)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(out, convey.ShouldResemble, data)
+		})
+
+		convey.Convey("rejects files without moov", func() {
+			ftyp := mkbox("ftyp", []byte("isom"), u32(512))
+			_, err := FastStart(append(ftyp, mkbox("mdat", make([]byte, 16))...))
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
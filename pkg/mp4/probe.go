@@ -0,0 +1,381 @@
+package mp4
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+)
+
+// Info 是从 moov 中解析出的元数据
+type Info struct {
+	MajorBrand string  // ftyp 中的主品牌，如 isom、mp42
+	Duration   float64 // 时长（秒）
+	Width      int64
+	Height     int64
+	VideoCodec string // 没有视频轨时为空
+	AudioCodec string // 没有音频轨时为空
+	Bitrate    int64  // 按文件大小和时长计算的总码率 bit/s
+	Size       int64  // 文件大小（字节）
+	FastStart  bool   // moov 位于 mdat 之前，播放器不用下载完整个文件就能开始播放
+}
+
+// codecNames 把 stsd 中的条目类型转换成与 ffprobe 一致的编码名，未知类型原样返回
+var codecNames = map[string]string{
+	"avc1": "h264",
+	"avc3": "h264",
+	"hvc1": "hevc",
+	"hev1": "hevc",
+	"av01": "av1",
+	"vp09": "vp9",
+	"mp4v": "mpeg4",
+	"mp4a": "aac",
+	"Opus": "opus",
+	"ac-3": "ac3",
+	"ec-3": "eac3",
+	".mp3": "mp3",
+	"fLaC": "flac",
+}
+
+// track 是从 trak 中解析出的轨道信息
+type track struct {
+	handler      string // vide、soun 等
+	codec        string
+	width        int64
+	height       int64
+	chunkOffsets []int64
+	sampleBytes  int64 // 所有 sample 的总大小，使用 stz2 时为 0
+}
+
+// Probe 解析 MP4 文件并做一致性校验：box 大小不能越界，moov 和 mdat 必须存在，
+// 每个轨道的 chunk 偏移都要落在 mdat 内，所有 sample 的总大小不能超过 mdat
+// 文件损坏或被截断时返回 ErrMalformed，分片 MP4 等暂不支持的格式返回 ErrUnsupported
+func Probe(r io.ReaderAt, size int64) (*Info, error) {
+	top, err := readBoxes(r, 0, size)
+	if err != nil {
+		return nil, err
+	}
+	if len(top) == 0 || top[0].typ != "ftyp" {
+		return nil, malformed("file does not start with ftyp")
+	}
+	if top[0].size-top[0].headerSize < 8 {
+		return nil, malformed("ftyp is too short")
+	}
+	brand := make([]byte, 4)
+	if err = readAt(r, brand, top[0].payloadOffset()); err != nil {
+		return nil, readError(err, "read ftyp")
+	}
+
+	var moov box
+	var hasMoov bool
+	var mdats []box
+	for _, b := range top {
+		switch b.typ {
+		case "moov":
+			if hasMoov {
+				return nil, malformed("multiple moov boxes")
+			}
+			moov, hasMoov = b, true
+		case "mdat":
+			mdats = append(mdats, b)
+		}
+	}
+	if !hasMoov {
+		return nil, malformed("moov is missing")
+	}
+	if len(mdats) == 0 {
+		return nil, malformed("mdat is missing")
+	}
+	if moov.size > MaxMoovSize {
+		return nil, unsupported("moov of %d bytes exceeds the limit", moov.size)
+	}
+
+	data := make([]byte, moov.size)
+	if err = readAt(r, data, moov.offset); err != nil {
+		return nil, readError(err, "read moov")
+	}
+	root := box{typ: "moov", headerSize: moov.headerSize, size: moov.size}
+	boxes, err := children(data, root)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := findBox(boxes, "mvex"); ok {
+		return nil, unsupported("fragmented mp4")
+	}
+	mvhd, ok := findBox(boxes, "mvhd")
+	if !ok {
+		return nil, malformed("mvhd is missing")
+	}
+	timescale, duration, err := parseTimescale("mvhd", payload(data, mvhd))
+	if err != nil {
+		return nil, err
+	}
+
+	info := &Info{
+		MajorBrand: string(brand),
+		Duration:   float64(duration) / float64(timescale),
+		Size:       size,
+		FastStart:  moov.offset < mdats[0].offset,
+	}
+	if info.Duration <= 0 {
+		return nil, malformed("duration is zero")
+	}
+
+	var mdatBytes, sampleBytes int64
+	for _, m := range mdats {
+		mdatBytes += m.size - m.headerSize
+	}
+	var tracks int
+	for _, b := range boxes {
+		if b.typ != "trak" {
+			continue
+		}
+		tracks++
+		t, err := parseTrack(data, b)
+		if err != nil {
+			return nil, err
+		}
+		for _, offset := range t.chunkOffsets {
+			if !inMdat(mdats, offset) {
+				return nil, malformed("chunk offset %d of track %d is outside mdat", offset, tracks)
+			}
+		}
+		// 先比较再累加，多个轨道的 sample 总大小都接近上限时也不会溢出
+		if t.sampleBytes > mdatBytes-sampleBytes {
+			return nil, malformed("samples of track %d exceed mdat of %d bytes", tracks, mdatBytes)
+		}
+		sampleBytes += t.sampleBytes
+		switch t.handler {
+		case "vide":
+			if info.VideoCodec == "" {
+				info.VideoCodec, info.Width, info.Height = t.codec, t.width, t.height
+			}
+		case "soun":
+			if info.AudioCodec == "" {
+				info.AudioCodec = t.codec
+			}
+		}
+	}
+	if tracks == 0 {
+		return nil, malformed("no tracks")
+	}
+	info.Bitrate = int64(float64(size) * 8 / info.Duration)
+	return info, nil
+}
+
+func inMdat(mdats []box, offset int64) bool {
+	for _, m := range mdats {
+		if offset >= m.payloadOffset() && offset < m.end() {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTrack 解析 trak 中的 tkhd、mdhd、hdlr 和 stbl
+func parseTrack(data []byte, trak box) (*track, error) {
+	t := &track{}
+	tkhd, ok, err := findPath(data, trak, "tkhd")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, malformed("tkhd is missing")
+	}
+	if t.width, t.height, err = parseTkhd(payload(data, tkhd)); err != nil {
+		return nil, err
+	}
+
+	mdia, ok, err := findPath(data, trak, "mdia")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, malformed("mdia is missing")
+	}
+	mdiaBoxes, err := children(data, mdia)
+	if err != nil {
+		return nil, err
+	}
+	mdhd, ok := findBox(mdiaBoxes, "mdhd")
+	if !ok {
+		return nil, malformed("mdhd is missing")
+	}
+	if _, _, err = parseTimescale("mdhd", payload(data, mdhd)); err != nil {
+		return nil, err
+	}
+	hdlr, ok := findBox(mdiaBoxes, "hdlr")
+	if !ok {
+		return nil, malformed("hdlr is missing")
+	}
+	if p := payload(data, hdlr); len(p) >= 12 {
+		t.handler = string(p[8:12])
+	} else {
+		return nil, malformed("hdlr is too short")
+	}
+
+	stbl, ok, err := findPath(data, mdia, "minf", "stbl")
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, malformed("stbl is missing")
+	}
+	stblBoxes, err := children(data, stbl)
+	if err != nil {
+		return nil, err
+	}
+	stsd, ok := findBox(stblBoxes, "stsd")
+	if !ok {
+		return nil, malformed("stsd is missing")
+	}
+	if err = parseStsd(data, stsd, t); err != nil {
+		return nil, err
+	}
+	if stco, ok := findBox(stblBoxes, "stco"); ok {
+		t.chunkOffsets, err = parseChunkOffsets(payload(data, stco), 4)
+	} else if co64, ok := findBox(stblBoxes, "co64"); ok {
+		t.chunkOffsets, err = parseChunkOffsets(payload(data, co64), 8)
+	} else {
+		return nil, malformed("chunk offset table is missing")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if stsz, ok := findBox(stblBoxes, "stsz"); ok {
+		if t.sampleBytes, err = parseSampleBytes(payload(data, stsz)); err != nil {
+			return nil, err
+		}
+	} else if _, ok = findBox(stblBoxes, "stz2"); !ok {
+		return nil, malformed("sample size table is missing")
+	}
+	return t, nil
+}
+
+// parseTimescale 解析 mvhd 或 mdhd 中的时间刻度和时长，两者前几个字段的布局相同
+func parseTimescale(name string, p []byte) (timescale uint32, duration uint64, err error) {
+	if len(p) < 4 {
+		return 0, 0, malformed("%s is too short", name)
+	}
+	switch p[0] {
+	case 0:
+		if len(p) < 20 {
+			return 0, 0, malformed("%s is too short", name)
+		}
+		timescale = binary.BigEndian.Uint32(p[12:16])
+		duration = uint64(binary.BigEndian.Uint32(p[16:20]))
+	case 1:
+		if len(p) < 32 {
+			return 0, 0, malformed("%s is too short", name)
+		}
+		timescale = binary.BigEndian.Uint32(p[20:24])
+		duration = binary.BigEndian.Uint64(p[24:32])
+	default:
+		return 0, 0, unsupported("%s version %d", name, p[0])
+	}
+	if timescale == 0 {
+		return 0, 0, malformed("%s timescale is zero", name)
+	}
+	return timescale, duration, nil
+}
+
+// parseTkhd 解析轨道的显示宽高，宽高是 16.16 定点数
+func parseTkhd(p []byte) (width, height int64, err error) {
+	if len(p) < 4 {
+		return 0, 0, malformed("tkhd is too short")
+	}
+	var offset int
+	switch p[0] {
+	case 0:
+		offset = 76
+	case 1:
+		offset = 88
+	default:
+		return 0, 0, unsupported("tkhd version %d", p[0])
+	}
+	if len(p) < offset+8 {
+		return 0, 0, malformed("tkhd is too short")
+	}
+	width = int64(binary.BigEndian.Uint32(p[offset:offset+4]) >> 16)
+	height = int64(binary.BigEndian.Uint32(p[offset+4:offset+8]) >> 16)
+	return width, height, nil
+}
+
+// parseStsd 取第一个采样描述的类型作为编码，视频轨的 tkhd 没有宽高时使用采样描述中的编码宽高
+func parseStsd(data []byte, stsd box, t *track) error {
+	p := payload(data, stsd)
+	if len(p) < 8 {
+		return malformed("stsd is too short")
+	}
+	if binary.BigEndian.Uint32(p[4:8]) == 0 {
+		return malformed("stsd has no entries")
+	}
+	entries, err := readBoxes(bytes.NewReader(data), stsd.payloadOffset()+8, stsd.end())
+	if err != nil {
+		return err
+	}
+	if len(entries) == 0 {
+		return malformed("stsd has no entries")
+	}
+	entry := entries[0]
+	t.codec = entry.typ
+	if name, ok := codecNames[entry.typ]; ok {
+		t.codec = name
+	}
+	if t.handler == "vide" && (t.width == 0 || t.height == 0) {
+		// VisualSampleEntry: 6 字节保留 + 2 字节数据引用索引 + 16 字节保留，之后是 16 位的宽和高
+		ep := payload(data, entry)
+		if len(ep) < 28 {
+			return malformed("visual sample entry is too short")
+		}
+		t.width = int64(binary.BigEndian.Uint16(ep[24:26]))
+		t.height = int64(binary.BigEndian.Uint16(ep[26:28]))
+	}
+	return nil
+}
+
+// parseChunkOffsets 解析 stco(4 字节) 或 co64(8 字节) 中的 chunk 偏移
+func parseChunkOffsets(p []byte, width int) ([]int64, error) {
+	if len(p) < 8 {
+		return nil, malformed("chunk offset table is too short")
+	}
+	count := uint64(binary.BigEndian.Uint32(p[4:8]))
+	if uint64(len(p)-8) < count*uint64(width) {
+		return nil, malformed("chunk offset table is truncated")
+	}
+	offsets := make([]int64, count)
+	for i := range offsets {
+		start := 8 + i*width
+		if width == 4 {
+			offsets[i] = int64(binary.BigEndian.Uint32(p[start : start+4]))
+		} else {
+			offsets[i] = int64(binary.BigEndian.Uint64(p[start : start+8]))
+		}
+	}
+	return offsets, nil
+}
+
+// parseSampleBytes 计算 stsz 中所有 sample 的总大小
+func parseSampleBytes(p []byte) (int64, error) {
+	if len(p) < 12 {
+		return 0, malformed("stsz is too short")
+	}
+	sampleSize := int64(binary.BigEndian.Uint32(p[4:8]))
+	count := uint64(binary.BigEndian.Uint32(p[8:12]))
+	if sampleSize != 0 {
+		if count > uint64(math.MaxInt64/sampleSize) {
+			return 0, malformed("stsz of %d samples of %d bytes overflows", count, sampleSize)
+		}
+		return sampleSize * int64(count), nil
+	}
+	if uint64(len(p)-12) < count*4 {
+		return 0, malformed("stsz is truncated")
+	}
+	// count 受 moov 大小限制，逐个累加不会溢出
+	var total int64
+	for i := uint64(0); i < count; i++ {
+		start := 12 + i*4
+		total += int64(binary.BigEndian.Uint32(p[start : start+4]))
+	}
+	return total, nil
+}
@@ -0,0 +1,190 @@
+package mp4
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+func u16(v uint16) []byte {
+	return binary.BigEndian.AppendUint16(nil, v)
+}
+
+func u32(v uint32) []byte {
+	return binary.BigEndian.AppendUint32(nil, v)
+}
+
+func mkbox(typ string, parts ...[]byte) []byte {
+	body := bytes.Join(parts, nil)
+	return bytes.Join([][]byte{u32(uint32(8 + len(body))), []byte(typ), body}, nil)
+}
+
+func fullbox(typ string, parts ...[]byte) []byte {
+	return mkbox(typ, append([][]byte{u32(0)}, parts...)...)
+}
+
+// testFile 描述测试用的 MP4：一个 1280x720 的 h264 视频轨和一个 aac 音频轨，时长 10 秒
+type testFile struct {
+	fastStart   bool
+	videoSizes  []uint32 // 视频 sample 的大小，全部放在一个 chunk 里
+	audioSize   uint32   // 音频只有一个 sample，紧跟在视频 sample 后面
+	chunkOffset int64    // 非 0 时覆盖视频 chunk 的偏移
+}
+
+func testTrack(handler, entry string, entryPayload []byte, width, height uint32, sizes []uint32, offset uint32) []byte {
+	var sampleSizes [][]byte
+	for _, s := range sizes {
+		sampleSizes = append(sampleSizes, u32(s))
+	}
+	return mkbox("trak",
+		fullbox("tkhd", make([]byte, 72), u32(width<<16), u32(height<<16)),
+		mkbox("mdia",
+			fullbox("mdhd", u32(0), u32(0), u32(1000), u32(10000), make([]byte, 4)),
+			fullbox("hdlr", u32(0), []byte(handler), make([]byte, 13)),
+			mkbox("minf",
+				mkbox("stbl",
+					fullbox("stsd", u32(1), mkbox(entry, entryPayload)),
+					fullbox("stsz", u32(0), u32(uint32(len(sizes))), bytes.Join(sampleSizes, nil)),
+					fullbox("stco", u32(1), u32(offset)),
+				),
+			),
+		),
+	)
+}
+
+func (f testFile) moov(mdatPayload int64) []byte {
+	videoOffset := uint32(mdatPayload)
+	if f.chunkOffset != 0 {
+		videoOffset = uint32(f.chunkOffset)
+	}
+	var videoBytes uint32
+	for _, s := range f.videoSizes {
+		videoBytes += s
+	}
+	visual := bytes.Join([][]byte{make([]byte, 24), u16(1280), u16(720), make([]byte, 50)}, nil)
+	return mkbox("moov",
+		fullbox("mvhd", u32(0), u32(0), u32(1000), u32(10000), make([]byte, 80)),
+		testTrack("vide", "avc1", visual, 1280, 720, f.videoSizes, videoOffset),
+		testTrack("soun", "mp4a", make([]byte, 28), 0, 0, []uint32{f.audioSize}, uint32(mdatPayload)+videoBytes),
+	)
+}
+
+func (f testFile) build() []byte {
+	ftyp := mkbox("ftyp", []byte("isom"), u32(512), []byte("isomiso2avc1mp41"))
+	var samples uint32
+	for _, s := range f.videoSizes {
+		samples += s
+	}
+	// sample 数据按位置填充不同的字节，便于检查 chunk 偏移是否指向原来的数据
+	media := make([]byte, samples+f.audioSize)
+	for i := range media {
+		media[i] = byte(i % 251)
+	}
+	mdat := mkbox("mdat", media)
+	if f.fastStart {
+		moovLen := int64(len(f.moov(0)))
+		moov := f.moov(int64(len(ftyp)) + moovLen + 8)
+		return bytes.Join([][]byte{ftyp, moov, mdat}, nil)
+	}
+	moov := f.moov(int64(len(ftyp)) + 8)
+	return bytes.Join([][]byte{ftyp, mdat, moov}, nil)
+}
+
+func probe(data []byte) (*Info, error) {
+	return Probe(bytes.NewReader(data), int64(len(data)))
+}
+
+func TestProbe(t *testing.T) {
+	convey.Convey("Probe", t, func() {
+		file := testFile{videoSizes: []uint32{1000, 500, 500}, audioSize: 300}
+
+		convey.Convey("moov at the end", func() {
+			data := file.build()
+			info, err := probe(data)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(info.MajorBrand, convey.ShouldEqual, "isom")
+			convey.So(info.Duration, convey.ShouldEqual, 10)
+			convey.So(info.Width, convey.ShouldEqual, 1280)
+			convey.So(info.Height, convey.ShouldEqual, 720)
+			convey.So(info.VideoCodec, convey.ShouldEqual, "h264")
+			convey.So(info.AudioCodec, convey.ShouldEqual, "aac")
+			convey.So(info.Size, convey.ShouldEqual, len(data))
+			convey.So(info.Bitrate, convey.ShouldEqual, len(data)*8/10)
+			convey.So(info.FastStart, convey.ShouldBeFalse)
+		})
+
+		convey.Convey("moov at the front", func() {
+			file.fastStart = true
+			info, err := probe(file.build())
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(info.FastStart, convey.ShouldBeTrue)
+		})
+
+		convey.Convey("truncated file", func() {
+			data := file.build()
+			_, err := probe(data[:len(data)-10])
+			convey.So(errors.Is(err, ErrMalformed), convey.ShouldBeTrue)
+		})
+
+		convey.Convey("not an mp4", func() {
+			_, err := probe([]byte("definitely not a video file"))
+			convey.So(errors.Is(err, ErrMalformed), convey.ShouldBeTrue)
+		})
+
+		convey.Convey("chunk offset outside mdat", func() {
+			file.chunkOffset = 4
+			_, err := probe(file.build())
+			convey.So(errors.Is(err, ErrMalformed), convey.ShouldBeTrue)
+		})
+
+		convey.Convey("samples larger than mdat", func() {
+			data := file.build()
+			// 把 mdat 缩小到只容纳一半的 sample，剩下的数据变成一个 free box
+			mdatSize := binary.BigEndian.Uint32(data[32:36])
+			half := (mdatSize-8)/2 + 8
+			binary.BigEndian.PutUint32(data[32:36], half)
+			freeOffset := 32 + half
+			binary.BigEndian.PutUint32(data[freeOffset:], mdatSize-half)
+			copy(data[freeOffset+4:], "free")
+			_, err := probe(data)
+			convey.So(errors.Is(err, ErrMalformed), convey.ShouldBeTrue)
+		})
+
+		convey.Convey("missing moov", func() {
+			ftyp := mkbox("ftyp", []byte("isom"), u32(512))
+			_, err := probe(append(ftyp, mkbox("mdat", make([]byte, 16))...))
+			convey.So(errors.Is(err, ErrMalformed), convey.ShouldBeTrue)
+		})
+
+		convey.Convey("fragmented mp4", func() {
+			ftyp := mkbox("ftyp", []byte("isom"), u32(512))
+			moov := mkbox("moov", fullbox("mvhd", u32(0), u32(0), u32(1000), u32(0), make([]byte, 80)), mkbox("mvex"))
+			_, err := probe(bytes.Join([][]byte{ftyp, moov, mkbox("mdat", make([]byte, 16))}, nil))
+			convey.So(errors.Is(err, ErrUnsupported), convey.ShouldBeTrue)
+		})
+	})
+}
+
+func TestParseSampleBytes(t *testing.T) {
+	convey.Convey("parseSampleBytes", t, func() {
+		convey.Convey("uniform sample size", func() {
+			total, err := parseSampleBytes(bytes.Join([][]byte{u32(0), u32(100), u32(30)}, nil))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(total, convey.ShouldEqual, 3000)
+		})
+
+		convey.Convey("per-sample sizes", func() {
+			total, err := parseSampleBytes(bytes.Join([][]byte{u32(0), u32(0), u32(2), u32(7), u32(8)}, nil))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(total, convey.ShouldEqual, 15)
+		})
+
+		convey.Convey("uniform sample size that overflows int64", func() {
+			_, err := parseSampleBytes(bytes.Join([][]byte{u32(0), u32(0xFFFFFFFF), u32(0xFFFFFFFF)}, nil))
+			convey.So(errors.Is(err, ErrMalformed), convey.ShouldBeTrue)
+		})
+	})
+}
@@ -0,0 +1,262 @@
+// Package search 是一个进程内的倒排索引，支持多字段加权的 BM25 打分和前缀查询
+package search
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+const (
+	DefaultK1               = 1.2  // BM25 词频饱和参数
+	DefaultB                = 0.75 // BM25 文档长度归一化参数
+	DefaultMaxPrefixExpands = 50   // 一个前缀最多展开的词数，超出时保留文档频率最高的
+)
+
+// Field 是参与检索的文本字段，Boost 是字段权重，比如标题命中比描述命中更重要
+type Field struct {
+	Name  string
+	Boost float64
+}
+
+// Document 是待索引的文档，Fields 的键是字段名，未在索引中声明的字段会被忽略
+type Document struct {
+	ID     int64
+	Fields map[string]string
+}
+
+// Query 是一次检索请求
+type Query struct {
+	Text       string
+	PrefixLast bool                // 最后一个词按前缀匹配
+	Filter     func(id int64) bool // 返回 false 的文档不出现在结果中，可为 nil
+}
+
+// Hit 是一条命中结果
+type Hit struct {
+	ID    int64
+	Score float64
+}
+
+// DocStats 是文档在各字段上的长度(词元数)以及包含的所有词，删除文档时用来清理倒排表
+type DocStats struct {
+	Lengths []int32
+	Terms   []string
+}
+
+// Index 是并发安全的倒排索引
+type Index struct {
+	mu       sync.RWMutex
+	fields   []Field
+	k1       float64
+	b        float64
+	docs     map[int64]*DocStats
+	postings map[string]map[int64][]int32 // 词 -> 文档 -> 各字段的词频
+	totalLen []int64                      // 各字段的总长度，用于计算平均长度
+
+	terms      []string // 排好序的词典，用于前缀查询，索引变化后延迟重建
+	termsDirty bool
+}
+
+func NewIndex(fields []Field) *Index {
+	return &Index{
+		fields:   fields,
+		k1:       DefaultK1,
+		b:        DefaultB,
+		docs:     make(map[int64]*DocStats),
+		postings: make(map[string]map[int64][]int32),
+		totalLen: make([]int64, len(fields)),
+	}
+}
+
+// Len 返回索引中的文档数
+func (idx *Index) Len() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	return len(idx.docs)
+}
+
+// Add 添加文档，文档已存在时整体替换
+func (idx *Index) Add(doc Document) {
+	freqs := make(map[string][]int32)
+	lengths := make([]int32, len(idx.fields))
+	for i, field := range idx.fields {
+		for _, token := range Tokenize(doc.Fields[field.Name]) {
+			tf, ok := freqs[token]
+			if !ok {
+				tf = make([]int32, len(idx.fields))
+				freqs[token] = tf
+			}
+			tf[i]++
+			lengths[i]++
+		}
+	}
+	stats := &DocStats{Lengths: lengths, Terms: make([]string, 0, len(freqs))}
+
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(doc.ID)
+	for term, tf := range freqs {
+		posting, ok := idx.postings[term]
+		if !ok {
+			posting = make(map[int64][]int32)
+			idx.postings[term] = posting
+			idx.termsDirty = true
+		}
+		posting[doc.ID] = tf
+		stats.Terms = append(stats.Terms, term)
+	}
+	for i, l := range lengths {
+		idx.totalLen[i] += int64(l)
+	}
+	idx.docs[doc.ID] = stats
+}
+
+// Remove 删除文档，文档不存在时什么也不做
+func (idx *Index) Remove(id int64) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.remove(id)
+}
+
+func (idx *Index) remove(id int64) {
+	stats, ok := idx.docs[id]
+	if !ok {
+		return
+	}
+	for _, term := range stats.Terms {
+		posting := idx.postings[term]
+		delete(posting, id)
+		if len(posting) == 0 {
+			delete(idx.postings, term)
+			idx.termsDirty = true
+		}
+	}
+	for i, l := range stats.Lengths {
+		idx.totalLen[i] -= int64(l)
+	}
+	delete(idx.docs, id)
+}
+
+// Search 返回所有命中的文档，按分数从高到低排序，分数相同时 ID 大的在前
+// 查询中的词之间是"或"的关系，命中的词越多、越稀有，分数越高
+func (idx *Index) Search(q Query) []Hit {
+	terms := parseQuery(q.Text, q.PrefixLast)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	// 前缀查询依赖有序词典，需要写锁重建
+	for _, term := range terms {
+		if term.prefix {
+			idx.mu.Lock()
+			idx.rebuildTerms()
+			idx.mu.Unlock()
+			break
+		}
+	}
+
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	scores := make(map[int64]float64)
+	for _, term := range terms {
+		// 一个前缀可能展开成多个词，同一文档只取得分最高的那个，避免展开越多得分越高
+		best := make(map[int64]float64)
+		for _, expanded := range idx.expand(term) {
+			for id, score := range idx.scoreTerm(expanded) {
+				if q.Filter != nil && !q.Filter(id) {
+					continue
+				}
+				if score > best[id] {
+					best[id] = score
+				}
+			}
+		}
+		for id, score := range best {
+			scores[id] += score
+		}
+	}
+
+	hits := make([]Hit, 0, len(scores))
+	for id, score := range scores {
+		hits = append(hits, Hit{ID: id, Score: score})
+	}
+	sort.Slice(hits, func(i, j int) bool {
+		if hits[i].Score != hits[j].Score {
+			return hits[i].Score > hits[j].Score
+		}
+		return hits[i].ID > hits[j].ID
+	})
+	return hits
+}
+
+// expand 返回查询词对应的索引词，前缀查询展开为词典中所有以它开头的词
+func (idx *Index) expand(term queryTerm) []string {
+	if !term.prefix {
+		if _, ok := idx.postings[term.text]; ok {
+			return []string{term.text}
+		}
+		return nil
+	}
+	matched := make([]string, 0)
+	for i := sort.SearchStrings(idx.terms, term.text); i < len(idx.terms); i++ {
+		if !strings.HasPrefix(idx.terms[i], term.text) {
+			break
+		}
+		// 词典是延迟重建的，重建后到现在为止可能有词被删除
+		if _, ok := idx.postings[idx.terms[i]]; ok {
+			matched = append(matched, idx.terms[i])
+		}
+	}
+	if len(matched) > DefaultMaxPrefixExpands {
+		sort.SliceStable(matched, func(i, j int) bool {
+			return len(idx.postings[matched[i]]) > len(idx.postings[matched[j]])
+		})
+		matched = matched[:DefaultMaxPrefixExpands]
+	}
+	return matched
+}
+
+// scoreTerm 按 BM25F 计算包含 term 的每个文档的得分：
+// 先把各字段的词频按字段权重和字段长度归一化后累加，再套用 BM25 的饱和公式
+func (idx *Index) scoreTerm(term string) map[int64]float64 {
+	posting := idx.postings[term]
+	n := float64(len(idx.docs))
+	df := float64(len(posting))
+	idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+
+	avgLen := make([]float64, len(idx.fields))
+	for i, total := range idx.totalLen {
+		avgLen[i] = float64(total) / n
+	}
+
+	scores := make(map[int64]float64, len(posting))
+	for id, tf := range posting {
+		lengths := idx.docs[id].Lengths
+		weighted := 0.0
+		for i, f := range tf {
+			if f == 0 {
+				continue
+			}
+			norm := 1 - idx.b + idx.b*float64(lengths[i])/avgLen[i]
+			weighted += idx.fields[i].Boost * float64(f) / norm
+		}
+		scores[id] = idf * weighted * (idx.k1 + 1) / (weighted + idx.k1)
+	}
+	return scores
+}
+
+// rebuildTerms 在词典有变化时重新排序，需要持有写锁
+func (idx *Index) rebuildTerms() {
+	if !idx.termsDirty && idx.terms != nil {
+		return
+	}
+	terms := make([]string, 0, len(idx.postings))
+	for term := range idx.postings {
+		terms = append(terms, term)
+	}
+	sort.Strings(terms)
+	idx.terms = terms
+	idx.termsDirty = false
+}
@@ -0,0 +1,84 @@
+package search
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+)
+
+var testFields = []Field{
+	{Name: "title", Boost: 3},
+	{Name: "description", Boost: 1},
+}
+
+func TestTokenize(t *testing.T) {
+	convey.Convey("Tokenize", t, func() {
+		convey.So(Tokenize("Hello, World 2024!"), convey.ShouldResemble, []string{"hello", "world", "2024"})
+		convey.So(Tokenize("机器学习"), convey.ShouldResemble, []string{"机器", "器学", "学习"})
+		convey.So(Tokenize("Go语言入门"), convey.ShouldResemble, []string{"go", "语言", "言入", "入门"})
+		convey.So(Tokenize("猫 cat"), convey.ShouldResemble, []string{"猫", "cat"})
+		convey.So(Tokenize("  "), convey.ShouldBeEmpty)
+	})
+}
+
+func TestIndex_Search(t *testing.T) {
+	convey.Convey("Index", t, func() {
+		idx := NewIndex(testFields)
+		idx.Add(Document{ID: 1, Fields: map[string]string{"title": "golang tutorial", "description": "learn go step by step"}})
+		idx.Add(Document{ID: 2, Fields: map[string]string{"title": "cooking pasta", "description": "a golang developer cooks"}})
+		idx.Add(Document{ID: 3, Fields: map[string]string{"title": "机器学习入门", "description": "从零开始"}})
+
+		convey.Convey("title match ranks above description match", func() {
+			hits := idx.Search(Query{Text: "golang"})
+			convey.So(len(hits), convey.ShouldEqual, 2)
+			convey.So(hits[0].ID, convey.ShouldEqual, 1)
+			convey.So(hits[1].ID, convey.ShouldEqual, 2)
+		})
+
+		convey.Convey("cjk bigram match", func() {
+			hits := idx.Search(Query{Text: "学习"})
+			convey.So(len(hits), convey.ShouldEqual, 1)
+			convey.So(hits[0].ID, convey.ShouldEqual, 3)
+		})
+
+		convey.Convey("prefix query", func() {
+			convey.So(idx.Search(Query{Text: "gol"}), convey.ShouldBeEmpty)
+			convey.So(len(idx.Search(Query{Text: "gol*"})), convey.ShouldEqual, 2)
+			convey.So(len(idx.Search(Query{Text: "pas", PrefixLast: true})), convey.ShouldEqual, 1)
+		})
+
+		convey.Convey("filter", func() {
+			hits := idx.Search(Query{Text: "golang", Filter: func(id int64) bool { return id != 1 }})
+			convey.So(len(hits), convey.ShouldEqual, 1)
+			convey.So(hits[0].ID, convey.ShouldEqual, 2)
+		})
+
+		convey.Convey("update and remove", func() {
+			idx.Add(Document{ID: 1, Fields: map[string]string{"title": "rust tutorial"}})
+			convey.So(len(idx.Search(Query{Text: "golang"})), convey.ShouldEqual, 1)
+			convey.So(len(idx.Search(Query{Text: "rust"})), convey.ShouldEqual, 1)
+
+			idx.Remove(2)
+			convey.So(idx.Search(Query{Text: "golang"}), convey.ShouldBeEmpty)
+			convey.So(idx.Search(Query{Text: "gol*"}), convey.ShouldBeEmpty)
+			convey.So(idx.Len(), convey.ShouldEqual, 2)
+		})
+
+		convey.Convey("snapshot round trip", func() {
+			var buf bytes.Buffer
+			convey.So(gob.NewEncoder(&buf).Encode(idx.Snapshot()), convey.ShouldBeNil)
+			var snap Snapshot
+			convey.So(gob.NewDecoder(&buf).Decode(&snap), convey.ShouldBeNil)
+
+			restored, ok := Restore(&snap, testFields)
+			convey.So(ok, convey.ShouldBeTrue)
+			convey.So(restored.Search(Query{Text: "golang"}), convey.ShouldResemble, idx.Search(Query{Text: "golang"}))
+			convey.So(len(restored.Search(Query{Text: "机器*"})), convey.ShouldEqual, 1)
+
+			_, ok = Restore(&snap, []Field{{Name: "title", Boost: 1}})
+			convey.So(ok, convey.ShouldBeFalse)
+		})
+	})
+}
@@ -0,0 +1,57 @@
+package search
+
+// Snapshot 是索引的完整拷贝，可以直接用 gob 编码持久化，恢复时不需要重新分词
+type Snapshot struct {
+	Fields   []Field
+	Docs     map[int64]*DocStats
+	Postings map[string]map[int64][]int32
+	TotalLen []int64
+}
+
+// Snapshot 深拷贝当前索引，拷贝完成后索引的修改不会影响快照
+func (idx *Index) Snapshot() *Snapshot {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+	snap := &Snapshot{
+		Fields:   append([]Field(nil), idx.fields...),
+		Docs:     make(map[int64]*DocStats, len(idx.docs)),
+		Postings: make(map[string]map[int64][]int32, len(idx.postings)),
+		TotalLen: append([]int64(nil), idx.totalLen...),
+	}
+	// DocStats 和词频切片在写入索引后不会再被修改，可以共享
+	for id, stats := range idx.docs {
+		snap.Docs[id] = stats
+	}
+	for term, posting := range idx.postings {
+		copied := make(map[int64][]int32, len(posting))
+		for id, tf := range posting {
+			copied[id] = tf
+		}
+		snap.Postings[term] = copied
+	}
+	return snap
+}
+
+// Restore 从快照恢复索引，快照的字段定义与 fields 不一致时返回 false，需要重新建索引
+func Restore(snap *Snapshot, fields []Field) (*Index, bool) {
+	if snap == nil || len(snap.Fields) != len(fields) {
+		return nil, false
+	}
+	for i, field := range fields {
+		if snap.Fields[i].Name != field.Name {
+			return nil, false
+		}
+	}
+	idx := NewIndex(fields) // 字段权重以当前配置为准
+	if snap.Docs != nil {
+		idx.docs = snap.Docs
+	}
+	if snap.Postings != nil {
+		idx.postings = snap.Postings
+	}
+	if len(snap.TotalLen) == len(fields) {
+		idx.totalLen = snap.TotalLen
+	}
+	idx.termsDirty = true
+	return idx, true
+}
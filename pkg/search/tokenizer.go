@@ -0,0 +1,84 @@
+package search
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Tokenize 把文本切分为词元
+// 连续的字母、数字组成一个词并转为小写；中日韩文字没有空格分词，按相邻两个字切分(bigram)，
+// 单独出现的一个字作为一个词元。其余字符都视为分隔符
+func Tokenize(text string) []string {
+	tokens := make([]string, 0)
+	var (
+		word []rune
+		cjk  []rune
+	)
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+	flushCJK := func() {
+		tokens = append(tokens, bigrams(cjk)...)
+		cjk = cjk[:0]
+	}
+	for _, r := range text {
+		switch {
+		case isCJK(r):
+			flushWord()
+			cjk = append(cjk, r)
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			flushCJK()
+			word = append(word, r)
+		default:
+			flushWord()
+			flushCJK()
+		}
+	}
+	flushWord()
+	flushCJK()
+	return tokens
+}
+
+// queryTerm 是查询中的一个词元，prefix 为 true 时匹配所有以它开头的词
+type queryTerm struct {
+	text   string
+	prefix bool
+}
+
+// parseQuery 对查询文本分词，以 * 结尾的词按前缀匹配
+// prefixLast 为 true 时最后一个词也按前缀匹配，用于边输入边搜索
+func parseQuery(text string, prefixLast bool) []queryTerm {
+	terms := make([]queryTerm, 0)
+	for _, segment := range strings.Fields(text) {
+		prefix := strings.HasSuffix(segment, "*")
+		tokens := Tokenize(strings.TrimRight(segment, "*"))
+		for i, token := range tokens {
+			terms = append(terms, queryTerm{text: token, prefix: prefix && i == len(tokens)-1})
+		}
+	}
+	if prefixLast && len(terms) > 0 {
+		terms[len(terms)-1].prefix = true
+	}
+	return terms
+}
+
+func bigrams(runes []rune) []string {
+	switch len(runes) {
+	case 0:
+		return nil
+	case 1:
+		return []string{string(runes)}
+	}
+	tokens := make([]string, 0, len(runes)-1)
+	for i := 0; i+1 < len(runes); i++ {
+		tokens = append(tokens, string(runes[i:i+2]))
+	}
+	return tokens
+}
+
+func isCJK(r rune) bool {
+	return unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul)
+}
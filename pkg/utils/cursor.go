@@ -0,0 +1,73 @@
+package utils
+
+// 分页游标签名组件
+// 游标内容序列化为 JSON 后附上 HMAC 签名，客户端只能原样传回，无法伪造或篡改游标中的位置和快照
+// 游标格式: {base64url(JSON)}.{base64url(HMAC-SHA256)}
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+
+	"github.com/LingeringAutumn/Yijie/config"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// CursorSigner 负责分页游标的签发和校验
+type CursorSigner struct {
+	key []byte
+}
+
+// NewCursorSigner 根据 config.Search.CursorKey 创建游标签名组件
+func NewCursorSigner() (*CursorSigner, error) {
+	if config.Search == nil {
+		return nil, errno.NewErrNo(errno.InternalServiceErrorCode, "search config is nil")
+	}
+	return NewCursorSignerWithKey(config.Search.CursorKey)
+}
+
+// NewCursorSignerWithKey 使用 base64 编码的密钥创建游标签名组件
+func NewCursorSignerWithKey(key string) (*CursorSigner, error) {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil || len(raw) == 0 {
+		return nil, errno.Errorf(errno.InternalServiceErrorCode, "invalid cursor key: %v", err)
+	}
+	return &CursorSigner{key: raw}, nil
+}
+
+// Sign 把 payload 编码为带签名的游标
+func (s *CursorSigner) Sign(payload interface{}) (string, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return "", errno.Errorf(errno.InternalServiceErrorCode, "marshal cursor failed: %v", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data) + "." + base64.RawURLEncoding.EncodeToString(s.mac(data)), nil
+}
+
+// Parse 校验游标的签名并解码到 payload，签名不符或格式错误时返回参数错误
+func (s *CursorSigner) Parse(cursor string, payload interface{}) error {
+	invalid := errno.ParamVerifyError.WithMessage("invalid cursor")
+	encoded, signature, ok := strings.Cut(cursor, ".")
+	if !ok {
+		return invalid
+	}
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return invalid
+	}
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(mac, s.mac(data)) {
+		return invalid
+	}
+	if err = json.Unmarshal(data, payload); err != nil {
+		return invalid
+	}
+	return nil
+}
+
+func (s *CursorSigner) mac(data []byte) []byte {
+	h := hmac.New(sha256.New, s.key)
+	h.Write(data)
+	return h.Sum(nil)
+}
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+type testCursor struct {
+	Offset int64  `json:"o"`
+	Scope  string `json:"q"`
+}
+
+func TestCursorSigner(t *testing.T) {
+	convey.Convey("CursorSigner", t, func() {
+		signer, err := NewCursorSignerWithKey(testKey('c'))
+		convey.So(err, convey.ShouldBeNil)
+		cursor, err := signer.Sign(&testCursor{Offset: 20, Scope: "trend"})
+		convey.So(err, convey.ShouldBeNil)
+		payload, signature, _ := strings.Cut(cursor, ".")
+
+		convey.Convey("round trip", func() {
+			var c testCursor
+			convey.So(signer.Parse(cursor, &c), convey.ShouldBeNil)
+			convey.So(c, convey.ShouldResemble, testCursor{Offset: 20, Scope: "trend"})
+		})
+
+		forged := base64.RawURLEncoding.EncodeToString([]byte(`{"o":90,"q":"trend"}`)) + "." + signature
+		otherSigner, err := NewCursorSignerWithKey(testKey('d'))
+		convey.So(err, convey.ShouldBeNil)
+		otherCursor, _ := otherSigner.Sign(&testCursor{Offset: 20, Scope: "trend"})
+
+		tests := []struct {
+			name   string
+			cursor string
+		}{
+			{"tampered payload", forged},
+			{"tampered signature", payload + "." + base64.RawURLEncoding.EncodeToString([]byte("forged"))},
+			{"signed with another key", otherCursor},
+			{"missing signature", payload},
+			{"not base64", "!!!." + signature},
+			{"empty", ""},
+		}
+		for _, tt := range tests {
+			convey.Convey(tt.name, func() {
+				var c testCursor
+				err := signer.Parse(tt.cursor, &c)
+				convey.So(errno.ConvertErr(err).ErrorCode, convey.ShouldEqual, errno.ParamVerifyErrorCode)
+			})
+		}
+
+		convey.Convey("invalid keys", func() {
+			_, err := NewCursorSignerWithKey("")
+			convey.So(err, convey.ShouldNotBeNil)
+			_, err = NewCursorSignerWithKey("not base64!")
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}
@@ -4,6 +4,7 @@ package utils
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"time"
@@ -78,6 +79,163 @@ func (m *MinioClient) UploadFile(bucketName, objectName, Location, ContentType s
 	return nil
 }
 
+// FUploadFile 用于将本地文件上传到 MinIO 存储桶，适合转码产物这类较大的文件，不需要整个读入内存
+// 参数 bucketName 是存储桶的名称
+// 参数 objectName 是文件在存储桶中的对象名称
+// 参数 Location 是存储桶的地理位置
+// 参数 ContentType 是文件的内容类型
+// 参数 filePath 是本地文件的路径
+// 返回值为上传的字节数和错误信息，如果上传成功则错误信息为 nil
+func (m *MinioClient) FUploadFile(bucketName, objectName, Location, ContentType, filePath string) (int64, error) {
+	exist, err := m.Client.BucketExists(bucketName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to check if bucket %s exists: %w", bucketName, err)
+	}
+	if !exist {
+		if err = m.Client.MakeBucket(bucketName, Location); err != nil {
+			return 0, fmt.Errorf("failed to create bucket %s: %w", bucketName, err)
+		}
+	}
+	n, err := m.Client.FPutObject(bucketName, objectName, filePath, minio.PutObjectOptions{ContentType: ContentType})
+	if err != nil {
+		return 0, fmt.Errorf("failed to upload %s: %w", objectName, err)
+	}
+	return n, nil
+}
+
+// ensureBucket 检查存储桶是否存在，不存在时创建
+func (m *MinioClient) ensureBucket(bucketName, location string) error {
+	exist, err := m.Client.BucketExists(bucketName)
+	if err != nil {
+		return fmt.Errorf("failed to check if bucket %s exists: %w", bucketName, err)
+	}
+	if !exist {
+		if err = m.Client.MakeBucket(bucketName, location); err != nil {
+			return fmt.Errorf("failed to create bucket %s: %w", bucketName, err)
+		}
+	}
+	return nil
+}
+
+// NewMultipartUpload 发起分片上传，存储桶不存在时先创建
+// 返回值为 MinIO 分配的 upload id 和错误信息
+func (m *MinioClient) NewMultipartUpload(bucketName, objectName, location, contentType string) (string, error) {
+	if err := m.ensureBucket(bucketName, location); err != nil {
+		return "", err
+	}
+	core := minio.Core{Client: m.Client}
+	uploadID, err := core.NewMultipartUpload(bucketName, objectName, minio.PutObjectOptions{ContentType: contentType})
+	if err != nil {
+		return "", fmt.Errorf("failed to start multipart upload of %s: %w", objectName, err)
+	}
+	return uploadID, nil
+}
+
+// PutObjectPart 上传一个分片，sha256Hex 非空时 MinIO 会校验分片内容
+// 返回值为分片的 ETag 和错误信息，合并分片时需要用到 ETag
+func (m *MinioClient) PutObjectPart(bucketName, objectName, uploadID string, partNumber int, data []byte, sha256Hex string) (string, error) {
+	core := minio.Core{Client: m.Client}
+	part, err := core.PutObjectPart(bucketName, objectName, uploadID, partNumber, bytes.NewReader(data), int64(len(data)), "", sha256Hex, nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to upload part %d of %s: %w", partNumber, objectName, err)
+	}
+	return part.ETag, nil
+}
+
+// CompleteMultipartUpload 合并分片，parts 需要按分片编号升序排列
+func (m *MinioClient) CompleteMultipartUpload(bucketName, objectName, uploadID string, parts []minio.CompletePart) error {
+	core := minio.Core{Client: m.Client}
+	if _, err := core.CompleteMultipartUpload(bucketName, objectName, uploadID, parts); err != nil {
+		return fmt.Errorf("failed to complete multipart upload of %s: %w", objectName, err)
+	}
+	return nil
+}
+
+// AbortMultipartUpload 取消分片上传并删除已经上传的分片
+func (m *MinioClient) AbortMultipartUpload(bucketName, objectName, uploadID string) error {
+	core := minio.Core{Client: m.Client}
+	if err := core.AbortMultipartUpload(bucketName, objectName, uploadID); err != nil {
+		return fmt.Errorf("failed to abort multipart upload of %s: %w", objectName, err)
+	}
+	return nil
+}
+
+// OpenFile 打开文件用于随机读取，调用方负责关闭返回的对象
+// 返回值为对象、对象信息和错误信息，文件不存在时返回 os.ErrNotExist
+func (m *MinioClient) OpenFile(bucketName, objectName string) (*minio.Object, minio.ObjectInfo, error) {
+	object, err := m.Client.GetObject(bucketName, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, minio.ObjectInfo{}, fmt.Errorf("failed to get %s from bucket %s: %w", objectName, bucketName, err)
+	}
+	info, err := object.Stat()
+	if err != nil {
+		object.Close()
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, minio.ObjectInfo{}, fmt.Errorf("%s not found in bucket %s: %w", objectName, bucketName, os.ErrNotExist)
+		}
+		return nil, minio.ObjectInfo{}, fmt.Errorf("failed to stat %s in bucket %s: %w", objectName, bucketName, err)
+	}
+	return object, info, nil
+}
+
+// StatFile 获取文件的大小、ETag 等信息，文件不存在时返回 os.ErrNotExist
+func (m *MinioClient) StatFile(bucketName, objectName string) (minio.ObjectInfo, error) {
+	info, err := m.Client.StatObject(bucketName, objectName, minio.StatObjectOptions{})
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return minio.ObjectInfo{}, fmt.Errorf("%s not found in bucket %s: %w", objectName, bucketName, os.ErrNotExist)
+		}
+		return minio.ObjectInfo{}, fmt.Errorf("failed to stat %s in bucket %s: %w", objectName, bucketName, err)
+	}
+	return info, nil
+}
+
+// GetFileRange 读取文件从 offset 开始的 length 个字节，length 必须大于 0，调用方负责关闭返回的 reader
+// etag 非空时要求文件的 ETag 与之相同，避免读到的内容与之前获取的文件信息不一致
+func (m *MinioClient) GetFileRange(bucketName, objectName string, offset, length int64, etag string) (io.ReadCloser, error) {
+	opts := minio.GetObjectOptions{}
+	if err := opts.SetRange(offset, offset+length-1); err != nil {
+		return nil, err
+	}
+	if etag != "" {
+		if err := opts.SetMatchETag(etag); err != nil {
+			return nil, err
+		}
+	}
+	core := minio.Core{Client: m.Client}
+	body, _, err := core.GetObject(bucketName, objectName, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s from bucket %s: %w", objectName, bucketName, err)
+	}
+	return body, nil
+}
+
+// PutFile 把 reader 中大小为 size 的内容上传到存储桶，不需要整个读入内存
+func (m *MinioClient) PutFile(bucketName, objectName, location, contentType string, reader io.Reader, size int64) error {
+	if err := m.ensureBucket(bucketName, location); err != nil {
+		return err
+	}
+	if _, err := m.Client.PutObject(bucketName, objectName, reader, size, minio.PutObjectOptions{ContentType: contentType}); err != nil {
+		return fmt.Errorf("failed to upload %s: %w", objectName, err)
+	}
+	return nil
+}
+
+// CopyFile 在 MinIO 服务端复制文件，保留原文件的内容类型等元数据
+func (m *MinioClient) CopyFile(srcBucket, srcObject, dstBucket, dstObject, location string) error {
+	if err := m.ensureBucket(dstBucket, location); err != nil {
+		return err
+	}
+	dst, err := minio.NewDestinationInfo(dstBucket, dstObject, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build copy destination %s: %w", dstObject, err)
+	}
+	if err = m.Client.CopyObject(dst, minio.NewSourceInfo(srcBucket, srcObject, nil)); err != nil {
+		return fmt.Errorf("failed to copy %s/%s to %s/%s: %w", srcBucket, srcObject, dstBucket, dstObject, err)
+	}
+	return nil
+}
+
 // DownloadFile 下载文件
 // 参数 bucketName 是存储桶的名称
 // 参数 objectName 是文件在存储桶中的对象名称
@@ -105,6 +263,28 @@ func (m *MinioClient) DownloadFile(bucketName, objectName, filePath string) erro
 	return nil
 }
 
+// GetFile 读取文件的全部内容
+// 参数 bucketName 是存储桶的名称
+// 参数 objectName 是文件在存储桶中的对象名称
+// 返回值为文件内容和错误信息，文件不存在时返回 os.ErrNotExist
+func (m *MinioClient) GetFile(bucketName, objectName string) ([]byte, error) {
+	object, err := m.Client.GetObject(bucketName, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s from bucket %s: %w", objectName, bucketName, err)
+	}
+	defer object.Close()
+
+	// GetObject 不会发出请求，读取时才能知道对象是否存在
+	data, err := io.ReadAll(object)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchKey" {
+			return nil, fmt.Errorf("%s not found in bucket %s: %w", objectName, bucketName, os.ErrNotExist)
+		}
+		return nil, fmt.Errorf("failed to read %s from bucket %s: %w", objectName, bucketName, err)
+	}
+	return data, nil
+}
+
 // DeleteFile 删除文件
 // 参数 bucketName 是存储桶的名称
 // 参数 objectName 是文件在存储桶中的对象名称
@@ -154,3 +334,33 @@ func (m *MinioClient) GetPresignedGetObject(bucketName string, objectName string
 	// 返回预签名 URL 的字符串形式
 	return object.String(), nil
 }
+
+// PresignedPostPolicy 生成浏览器表单直传的地址和表单字段，存储桶不存在时先创建
+// 上传的文件必须是 contentType 类型，大小在 [minSize, maxSize] 之间，否则 MinIO 会拒绝
+// 返回值为上传地址、需要放进表单的字段和错误信息
+func (m *MinioClient) PresignedPostPolicy(bucketName, objectName, location, contentType string, minSize, maxSize int64, expires time.Time) (string, map[string]string, error) {
+	if err := m.ensureBucket(bucketName, location); err != nil {
+		return "", nil, err
+	}
+	policy := minio.NewPostPolicy()
+	if err := policy.SetBucket(bucketName); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetKey(objectName); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetExpires(expires.UTC()); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetContentType(contentType); err != nil {
+		return "", nil, err
+	}
+	if err := policy.SetContentLengthRange(minSize, maxSize); err != nil {
+		return "", nil, err
+	}
+	u, formData, err := m.Client.PresignedPostPolicy(policy)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to generate presigned post policy for %s in bucket %s: %w", objectName, bucketName, err)
+	}
+	return u.String(), formData, nil
+}
@@ -0,0 +1,204 @@
+package utils
+
+// 个人敏感信息(PII)加密组件
+// 采用信封加密: 每条数据随机生成一个数据密钥(DEK)用 AES-GCM 加密明文，
+// DEK 再用配置中的主密钥(KEK)加密后和密文存放在一起，主密钥带版本号以支持轮换。
+// 因为密文是随机的，唯一性校验和按邮箱查询使用 HMAC 计算的确定性盲索引。
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/LingeringAutumn/Yijie/config"
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+	"github.com/LingeringAutumn/Yijie/pkg/errno"
+)
+
+// PIICipher 负责 PII 字段的加解密和盲索引计算
+type PIICipher struct {
+	activeVersion int
+	masterKeys    map[int]cipher.AEAD
+	blindIndexKey []byte
+}
+
+// NewPIICipher 根据 config.PII 创建加密组件
+func NewPIICipher() (*PIICipher, error) {
+	if config.PII == nil {
+		return nil, errno.NewErrNo(errno.InternalServiceErrorCode, "pii config is nil")
+	}
+	masterKeys := make(map[int]string, len(config.PII.MasterKeys))
+	for _, k := range config.PII.MasterKeys {
+		masterKeys[k.Version] = k.Key
+	}
+	return NewPIICipherWithKeys(config.PII.ActiveVersion, masterKeys, config.PII.BlindIndexKey)
+}
+
+// NewPIICipherWithKeys 使用给定的密钥创建加密组件，masterKeys 的 key 为主密钥版本，密钥均为 base64 编码
+func NewPIICipherWithKeys(activeVersion int, masterKeys map[int]string, blindIndexKey string) (*PIICipher, error) {
+	aeads := make(map[int]cipher.AEAD, len(masterKeys))
+	for version, key := range masterKeys {
+		raw, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return nil, errno.Errorf(errno.InternalServiceErrorCode, "decode pii master key v%d failed: %v", version, err)
+		}
+		if len(raw) != constants.PIIKeySize {
+			return nil, errno.Errorf(errno.InternalServiceErrorCode, "pii master key v%d should be %d bytes", version, constants.PIIKeySize)
+		}
+		aead, err := newGCM(raw)
+		if err != nil {
+			return nil, err
+		}
+		aeads[version] = aead
+	}
+	if _, ok := aeads[activeVersion]; !ok {
+		return nil, errno.Errorf(errno.InternalServiceErrorCode, "pii active master key v%d not found", activeVersion)
+	}
+	rawBlindIndexKey, err := base64.StdEncoding.DecodeString(blindIndexKey)
+	if err != nil || len(rawBlindIndexKey) == 0 {
+		return nil, errno.Errorf(errno.InternalServiceErrorCode, "invalid pii blind index key: %v", err)
+	}
+	return &PIICipher{
+		activeVersion: activeVersion,
+		masterKeys:    aeads,
+		blindIndexKey: rawBlindIndexKey,
+	}, nil
+}
+
+// Encrypt 使用当前版本的主密钥加密 field 字段的明文，空字符串不加密
+// field 会作为附加数据参与认证，防止把一个字段的密文挪到另一个字段上
+// 密文格式: pii:v{主密钥版本}:{base64(加密后的 DEK)}:{base64(密文)}
+func (p *PIICipher) Encrypt(field, plaintext string) (string, error) {
+	if plaintext == "" {
+		return "", nil
+	}
+	dek := make([]byte, constants.PIIKeySize)
+	if _, err := rand.Read(dek); err != nil {
+		return "", errno.Errorf(errno.InternalServiceErrorCode, "generate pii data key failed: %v", err)
+	}
+	wrappedKey, err := seal(p.masterKeys[p.activeVersion], dek, []byte(constants.PIIDataKeyAAD))
+	if err != nil {
+		return "", err
+	}
+	dataAEAD, err := newGCM(dek)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := seal(dataAEAD, []byte(plaintext), []byte(field))
+	if err != nil {
+		return "", err
+	}
+	return strings.Join([]string{
+		constants.PIICiphertextPrefix,
+		"v" + strconv.Itoa(p.activeVersion),
+		base64.RawStdEncoding.EncodeToString(wrappedKey),
+		base64.RawStdEncoding.EncodeToString(ciphertext),
+	}, constants.PIICiphertextSeparator), nil
+}
+
+// Decrypt 解密 Encrypt 生成的密文
+// 迁移完成之前库里还会有明文数据，不是密文格式的值原样返回
+func (p *PIICipher) Decrypt(field, value string) (string, error) {
+	version, wrappedKey, ciphertext, ok := parseCiphertext(value)
+	if !ok {
+		return value, nil
+	}
+	master, exist := p.masterKeys[version]
+	if !exist {
+		return "", errno.Errorf(errno.InternalServiceErrorCode, "pii master key v%d not found", version)
+	}
+	dek, err := open(master, wrappedKey, []byte(constants.PIIDataKeyAAD))
+	if err != nil {
+		return "", err
+	}
+	dataAEAD, err := newGCM(dek)
+	if err != nil {
+		return "", err
+	}
+	plaintext, err := open(dataAEAD, ciphertext, []byte(field))
+	if err != nil {
+		return "", err
+	}
+	return string(plaintext), nil
+}
+
+// NeedsReEncrypt 判断一个值是否需要用当前主密钥重新加密: 明文或者使用旧版本主密钥加密的密文
+func (p *PIICipher) NeedsReEncrypt(value string) bool {
+	if value == "" {
+		return false
+	}
+	version, _, _, ok := parseCiphertext(value)
+	return !ok || version != p.activeVersion
+}
+
+// BlindIndex 计算 field 字段的盲索引，相同的值总是得到相同的结果，空字符串返回空
+// 邮箱不区分大小写，计算前统一转成小写
+func (p *PIICipher) BlindIndex(field, value string) string {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return ""
+	}
+	if field == constants.PIIFieldEmail {
+		value = strings.ToLower(value)
+	}
+	mac := hmac.New(sha256.New, p.blindIndexKey)
+	mac.Write([]byte(field + constants.PIICiphertextSeparator + value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func parseCiphertext(value string) (version int, wrappedKey, ciphertext []byte, ok bool) {
+	parts := strings.Split(value, constants.PIICiphertextSeparator)
+	if len(parts) != 4 || parts[0] != constants.PIICiphertextPrefix || !strings.HasPrefix(parts[1], "v") {
+		return 0, nil, nil, false
+	}
+	version, err := strconv.Atoi(parts[1][1:])
+	if err != nil {
+		return 0, nil, nil, false
+	}
+	if wrappedKey, err = base64.RawStdEncoding.DecodeString(parts[2]); err != nil {
+		return 0, nil, nil, false
+	}
+	if ciphertext, err = base64.RawStdEncoding.DecodeString(parts[3]); err != nil {
+		return 0, nil, nil, false
+	}
+	return version, wrappedKey, ciphertext, true
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalServiceErrorCode, "new aes cipher failed: %v", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errno.Errorf(errno.InternalServiceErrorCode, "new gcm failed: %v", err)
+	}
+	return aead, nil
+}
+
+// seal 加密后返回 nonce + 密文
+func seal(aead cipher.AEAD, plaintext, additionalData []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errno.Errorf(errno.InternalServiceErrorCode, "generate nonce failed: %v", err)
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+func open(aead cipher.AEAD, data, additionalData []byte) ([]byte, error) {
+	if len(data) < aead.NonceSize() {
+		return nil, errno.NewErrNo(errno.InternalServiceErrorCode, "pii ciphertext too short")
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		return nil, errno.NewErrNo(errno.InternalServiceErrorCode, fmt.Sprintf("decrypt pii failed: %v", err))
+	}
+	return plaintext, nil
+}
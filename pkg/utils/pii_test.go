@@ -0,0 +1,111 @@
+package utils
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/smartystreets/goconvey/convey"
+
+	"github.com/LingeringAutumn/Yijie/pkg/constants"
+)
+
+func testKey(c byte) string {
+	return base64.StdEncoding.EncodeToString([]byte(strings.Repeat(string(c), constants.PIIKeySize)))
+}
+
+func newTestCipher(activeVersion int, versions ...int) *PIICipher {
+	keys := make(map[int]string, len(versions))
+	for _, v := range versions {
+		keys[v] = testKey(byte('a' + v))
+	}
+	p, err := NewPIICipherWithKeys(activeVersion, keys, testKey('x'))
+	convey.So(err, convey.ShouldBeNil)
+	return p
+}
+
+func TestPIICipher(t *testing.T) {
+	convey.Convey("PIICipher", t, func() {
+		p := newTestCipher(1, 1)
+
+		convey.Convey("round trip", func() {
+			enc, err := p.Encrypt(constants.PIIFieldEmail, "alice@example.com")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(enc, convey.ShouldStartWith, "pii:v1:")
+			convey.So(enc, convey.ShouldNotContainSubstring, "alice")
+			convey.So(p.NeedsReEncrypt(enc), convey.ShouldBeFalse)
+
+			dec, err := p.Decrypt(constants.PIIFieldEmail, enc)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(dec, convey.ShouldEqual, "alice@example.com")
+
+			again, _ := p.Encrypt(constants.PIIFieldEmail, "alice@example.com")
+			convey.So(again, convey.ShouldNotEqual, enc)
+		})
+
+		convey.Convey("empty and plaintext values", func() {
+			enc, err := p.Encrypt(constants.PIIFieldPhone, "")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(enc, convey.ShouldBeEmpty)
+			convey.So(p.NeedsReEncrypt(""), convey.ShouldBeFalse)
+
+			dec, err := p.Decrypt(constants.PIIFieldPhone, "13800000000")
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(dec, convey.ShouldEqual, "13800000000")
+			convey.So(p.NeedsReEncrypt("13800000000"), convey.ShouldBeTrue)
+		})
+
+		convey.Convey("unknown master key version", func() {
+			enc, err := newTestCipher(2, 2).Encrypt(constants.PIIFieldEmail, "alice@example.com")
+			convey.So(err, convey.ShouldBeNil)
+			_, err = p.Decrypt(constants.PIIFieldEmail, enc)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("key rotation", func() {
+			old, _ := p.Encrypt(constants.PIIFieldEmail, "alice@example.com")
+			rotated := newTestCipher(2, 1, 2)
+			convey.So(rotated.NeedsReEncrypt(old), convey.ShouldBeTrue)
+			dec, err := rotated.Decrypt(constants.PIIFieldEmail, old)
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(dec, convey.ShouldEqual, "alice@example.com")
+		})
+
+		convey.Convey("tampered ciphertext", func() {
+			enc, _ := p.Encrypt(constants.PIIFieldEmail, "alice@example.com")
+			parts := strings.Split(enc, constants.PIICiphertextSeparator)
+			raw, _ := base64.RawStdEncoding.DecodeString(parts[3])
+			raw[len(raw)-1] ^= 1
+			parts[3] = base64.RawStdEncoding.EncodeToString(raw)
+			_, err := p.Decrypt(constants.PIIFieldEmail, strings.Join(parts, constants.PIICiphertextSeparator))
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("ciphertext moved to another field", func() {
+			enc, _ := p.Encrypt(constants.PIIFieldEmail, "alice@example.com")
+			_, err := p.Decrypt(constants.PIIFieldPhone, enc)
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+
+		convey.Convey("blind index", func() {
+			idx := p.BlindIndex(constants.PIIFieldEmail, "alice@example.com")
+			convey.So(idx, convey.ShouldHaveLength, 64)
+			convey.So(p.BlindIndex(constants.PIIFieldEmail, " Alice@Example.com "), convey.ShouldEqual, idx)
+			convey.So(p.BlindIndex(constants.PIIFieldPhone, "alice@example.com"), convey.ShouldNotEqual, idx)
+			convey.So(p.BlindIndex(constants.PIIFieldPhone, "  "), convey.ShouldBeEmpty)
+
+			other, err := NewPIICipherWithKeys(1, map[int]string{1: testKey('b')}, testKey('y'))
+			convey.So(err, convey.ShouldBeNil)
+			convey.So(other.BlindIndex(constants.PIIFieldEmail, "alice@example.com"), convey.ShouldNotEqual, idx)
+		})
+
+		convey.Convey("invalid keys", func() {
+			_, err := NewPIICipherWithKeys(1, map[int]string{1: base64.StdEncoding.EncodeToString([]byte("short"))}, testKey('x'))
+			convey.So(err, convey.ShouldNotBeNil)
+			_, err = NewPIICipherWithKeys(2, map[int]string{1: testKey('b')}, testKey('x'))
+			convey.So(err, convey.ShouldNotBeNil)
+			_, err = NewPIICipherWithKeys(1, map[int]string{1: testKey('b')}, "")
+			convey.So(err, convey.ShouldNotBeNil)
+		})
+	})
+}